@@ -171,6 +171,8 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		WithHeaderHash(req.Hash).
 		WithConsensusParams(app.GetConsensusParams(app.deliverState.ctx))
 
+	app.resetMsgQuotas(app.deliverState.ctx)
+
 	// we also set block gas meter to checkState in case the application needs to
 	// verify gas consumption during (Re)CheckTx
 	if app.checkState != nil {
@@ -261,8 +263,16 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
-	gInfo := sdk.GasInfo{}
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
+	return app.deliverTxResponse(req, gInfo, result, anteEvents, err)
+}
+
+// deliverTxResponse builds the ResponseDeliverTx of a tx executed in
+// DeliverTx mode, reporting it to telemetry and to the ABCI listeners.
+func (app *BaseApp) deliverTxResponse(
+	req abci.RequestDeliverTx, gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error,
+) (res abci.ResponseDeliverTx) {
 	resultStr := "successful"
 
 	defer func() {
@@ -280,7 +290,6 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(anteEvents, app.indexEvents), app.trace)
@@ -635,6 +644,16 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 			)
 	}
 
+	// the multistore loads an empty state for the heights it does not have on
+	// disk, fail explicitly if it can tell that the height was pruned.
+	if vs, ok := qms.(interface{ VersionExists(int64) bool }); ok && height > 0 && !vs.VersionExists(height) {
+		return sdk.Context{},
+			sdkerrors.Wrapf(
+				sdkerrors.ErrHeightPruned,
+				"state at height %d is not available, it was pruned or precedes the initial height (latest height: %d)", height, lastBlockHeight,
+			)
+	}
+
 	cacheMS, err := qms.CacheMultiStoreWithVersion(height)
 	if err != nil {
 		return sdk.Context{},
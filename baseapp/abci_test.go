@@ -14,6 +14,7 @@ import (
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestGetBlockRentionHeight(t *testing.T) {
@@ -167,6 +168,25 @@ func TestBaseAppCreateQueryContext(t *testing.T) {
 	}
 }
 
+func TestBaseAppCreateQueryContextPrunedHeight(t *testing.T) {
+	t.Parallel()
+
+	app := NewBaseApp(t.Name(), defaultLogger(), dbm.NewMemDB(), nil, SetPruning(pruningtypes.NewCustomPruningOptions(2, 10)))
+	app.MountStores(sdk.NewKVStoreKey("main"))
+	require.NoError(t, app.LoadLatestVersion())
+
+	for h := int64(1); h <= 10; h++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: h}})
+		app.Commit()
+	}
+
+	_, err := app.createQueryContext(3, false)
+	require.ErrorIs(t, err, sdkerrors.ErrHeightPruned)
+
+	_, err = app.createQueryContext(9, false)
+	require.NoError(t, err)
+}
+
 type paramStore struct {
 	db *dbm.MemDB
 }
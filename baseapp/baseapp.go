@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -52,6 +53,16 @@ type BaseApp struct { // nolint: maligned
 	anteHandler sdk.AnteHandler // ante handler for fee and auth
 	postHandler sdk.AnteHandler // post handler, optional, e.g. for tips
 
+	mempool         Mempool                // optional application-side mempool
+	prepareProposal PrepareProposalHandler // builds block proposals, optional
+
+	// parallelTxWorkers is the number of goroutines DeliverTxs executes txs
+	// with, 0 disables parallel tx execution
+	parallelTxWorkers int
+
+	// msgQuotas tracks the usage of the block quotas of the message types
+	msgQuotas *msgQuotaMeter
+
 	appStore
 	baseappVersions
 	peerFilters
@@ -181,6 +192,7 @@ func NewBaseApp(
 			msgServiceRouter: NewMsgServiceRouter(),
 		},
 		txDecoder: txDecoder,
+		msgQuotas: newMsgQuotaMeter(),
 	}
 
 	for _, option := range options {
@@ -570,7 +582,13 @@ func (app *BaseApp) getState(mode runTxMode) *state {
 
 // retrieve the context for the tx w/ txBytes and other memoized values.
 func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context {
-	ctx := app.getState(mode).ctx.
+	return app.newTxContext(app.getState(mode).ctx, mode, txBytes)
+}
+
+// newTxContext derives the context for the tx w/ txBytes from the given
+// state context.
+func (app *BaseApp) newTxContext(stateCtx sdk.Context, mode runTxMode, txBytes []byte) sdk.Context {
+	ctx := stateCtx.
 		WithTxBytes(txBytes).
 		WithVoteInfos(app.voteInfos)
 
@@ -606,6 +624,19 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 	return ctx.WithMultiStore(msCache), msCache
 }
 
+// removeFromMempool removes a tx from the application-side mempool, if any. A
+// failure to remove the tx is logged rather than returned, as it must not change
+// the result of the tx.
+func (app *BaseApp) removeFromMempool(tx sdk.Tx) {
+	if app.mempool == nil {
+		return
+	}
+
+	if err := app.mempool.Remove(tx); err != nil && !errors.Is(err, ErrTxNotFound) {
+		app.logger.Error("failed to remove tx from the mempool", "err", err)
+	}
+}
+
 // runTx processes a transaction within a given execution mode, encoded transaction
 // bytes, and the decoded transaction itself. All state transitions occur through
 // a cached Context depending on the mode provided. State only gets persisted
@@ -614,12 +645,25 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+	var msgQuotas *msgQuotaMeter
+	if mode == runTxModeDeliver {
+		msgQuotas = app.msgQuotas
+	}
+
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, msgQuotas)
+}
+
+// runTxWithContext is runTx executing the tx within the given context, which
+// must have been derived from the state context of mode, and enforcing the
+// message quotas of msgQuotas, if any.
+func (app *BaseApp) runTxWithContext(
+	ctx sdk.Context, mode runTxMode, txBytes []byte, msgQuotas *msgQuotaMeter,
+) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -700,6 +744,13 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
+			// a tx that no longer passes the AnteHandler on re-check, or that has
+			// been included in a block, must not linger in the application-side
+			// mempool
+			if mode == runTxModeReCheck || mode == runTxModeDeliver {
+				app.removeFromMempool(tx)
+			}
+
 			return gInfo, nil, nil, 0, err
 		}
 
@@ -708,6 +759,22 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		anteEvents = events.ToABCIEvents()
 	}
 
+	if app.mempool != nil {
+		switch mode {
+		case runTxModeCheck:
+			// NOTE: ctx carries the priority set by the AnteHandler, which the
+			// mempool uses to order the tx.
+			if err := app.mempool.Insert(ctx, tx); err != nil {
+				return gInfo, nil, anteEvents, priority, err
+			}
+
+		case runTxModeDeliver:
+			// the tx has been included in a block, hence it must be removed from
+			// the mempool regardless of the execution result
+			app.removeFromMempool(tx)
+		}
+	}
+
 	// Create a new Context based off of the existing Context with a MultiStore branch
 	// in case message processing fails. At this point, the MultiStore
 	// is a branch of a branch.
@@ -716,7 +783,8 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	// Attempt to execute all messages and only update state if all messages pass
 	// and we're in DeliverTx. Note, runMsgs will never return a reference to a
 	// Result if any single message fails or does not have a registered Handler.
-	result, err = app.runMsgs(runMsgCtx, msgs, mode)
+	txMsgQuotas := msgQuotas.newTx(txBytes)
+	result, err = app.runMsgs(runMsgCtx, msgs, mode, txMsgQuotas)
 	if err == nil {
 		// Run optional postHandlers.
 		//
@@ -740,6 +808,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 			consumeBlockGas()
 
 			msCache.Write()
+			txMsgQuotas.commit()
 		}
 
 		if len(anteEvents) > 0 && (mode == runTxModeDeliver || mode == runTxModeSimulate) {
@@ -756,7 +825,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 // and DeliverTx. An error is returned if any single message fails or if a
 // Handler does not exist for a given message route. Otherwise, a reference to a
 // Result is returned. The caller must not commit state if an error is returned.
-func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*sdk.Result, error) {
+func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode, txMsgQuotas *txMsgQuotas) (*sdk.Result, error) {
 	msgLogs := make(sdk.ABCIMessageLogs, 0, len(msgs))
 	events := sdk.EmptyEvents()
 	var msgResponses []*codectypes.Any
@@ -774,10 +843,17 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			err          error
 		)
 
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		if err := txMsgQuotas.consumeMsg(msgTypeURL); err != nil {
+			return nil, sdkerrors.Wrapf(err, "message index: %d", i)
+		}
+
+		gasBefore := ctx.GasMeter().GasConsumed()
+
 		if handler := app.msgServiceRouter.Handler(msg); handler != nil {
 			// ADR 031 request type routing
 			msgResult, err = handler(ctx, msg)
-			eventMsgName = sdk.MsgTypeURL(msg)
+			eventMsgName = msgTypeURL
 		} else if legacyMsg, ok := msg.(legacytx.LegacyMsg); ok {
 			// legacy sdk.Msg routing
 			// Assuming that the app developer has migrated all their Msgs to
@@ -800,6 +876,10 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
 
+		if err := txMsgQuotas.consumeGas(msgTypeURL, ctx.GasMeter().GasConsumed()-gasBefore); err != nil {
+			return nil, sdkerrors.Wrapf(err, "message index: %d", i)
+		}
+
 		msgEvents := sdk.Events{
 			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, eventMsgName)),
 		}
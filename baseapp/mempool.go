@@ -0,0 +1,135 @@
+package baseapp
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var (
+	// ErrTxNotFound is returned by Mempool.Remove when the tx is not in the mempool.
+	ErrTxNotFound = errors.New("tx not found in mempool")
+
+	// ErrTxReplacementUnderpriced is returned when a tx with the same sender and
+	// sequence is already in the mempool and the new tx does not pay a higher
+	// priority.
+	ErrTxReplacementUnderpriced = errors.New("tx replacement underpriced")
+)
+
+// Mempool defines an application-side mempool. BaseApp inserts every tx that
+// passes CheckTx, removes txs once they are delivered in a block or fail a
+// re-check, and proposers select txs from it when building blocks. When
+// parallel tx execution is enabled txs are removed concurrently, hence the
+// implementation must be safe for concurrent use.
+type Mempool interface {
+	// Insert attempts to insert a tx into the mempool. The context is the one
+	// returned by the AnteHandler, so ctx.Priority() holds the tx priority.
+	Insert(ctx sdk.Context, tx sdk.Tx) error
+
+	// Select returns an iterator over the txs in the mempool in the order they
+	// should be included in a block. It returns nil if the mempool is empty.
+	Select(ctx sdk.Context) MempoolIterator
+
+	// CountTx returns the number of txs currently in the mempool.
+	CountTx() int
+
+	// Remove removes a tx from the mempool. It returns ErrTxNotFound if the tx
+	// is not in the mempool.
+	Remove(tx sdk.Tx) error
+}
+
+// MempoolIterator iterates over the txs selected from a Mempool.
+type MempoolIterator interface {
+	// Next returns the next iterator state, or nil once the iterator is
+	// exhausted.
+	Next() MempoolIterator
+
+	// Tx returns the tx at the current position of the iterator.
+	Tx() sdk.Tx
+}
+
+// PrepareProposalHandler builds the list of raw txs a proposer includes in its
+// next block, given the maximum number of tx bytes allowed in the block.
+type PrepareProposalHandler func(ctx sdk.Context, maxTxBytes int64) [][]byte
+
+// PrepareProposal returns the txs a proposer should include in the next block
+// using the handler set with SetPrepareProposal. It is evaluated against the
+// latest committed state and returns nil if no handler has been set.
+//
+// NOTE: Tendermint v0.34 builds blocks from its own mempool, so this is meant
+// for proposers that assemble blocks themselves.
+func (app *BaseApp) PrepareProposal(maxTxBytes int64) [][]byte {
+	if app.prepareProposal == nil || app.checkState == nil {
+		return nil
+	}
+
+	return app.prepareProposal(app.checkState.ctx, maxTxBytes)
+}
+
+// NewDefaultPrepareProposalHandler returns a PrepareProposalHandler which
+// selects txs from the given mempool in order until either the block tx bytes
+// limit or the block max gas of the consensus params is reached.
+func NewDefaultPrepareProposalHandler(mempool Mempool, txEncoder sdk.TxEncoder) PrepareProposalHandler {
+	return func(ctx sdk.Context, maxTxBytes int64) [][]byte {
+		var maxBlockGas int64
+		if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil {
+			maxBlockGas = cp.Block.MaxGas
+		}
+
+		var (
+			txs        [][]byte
+			totalBytes int64
+			totalGas   uint64
+		)
+
+		for it := mempool.Select(ctx); it != nil; it = it.Next() {
+			tx := it.Tx()
+
+			bz, err := txEncoder(tx)
+			if err != nil {
+				// the tx can never be proposed, drop it from the mempool
+				_ = mempool.Remove(tx)
+				continue
+			}
+
+			totalBytes += int64(len(bz))
+			if maxTxBytes > 0 && totalBytes > maxTxBytes {
+				break
+			}
+
+			if feeTx, ok := tx.(sdk.FeeTx); ok && maxBlockGas > 0 {
+				totalGas += feeTx.GetGas()
+				if totalGas > uint64(maxBlockGas) {
+					break
+				}
+			}
+
+			txs = append(txs, bz)
+		}
+
+		return txs
+	}
+}
+
+// txSenderNonce returns the address of the first signer of a tx along with the
+// sequence of its signature, which identify the tx lane in the mempool.
+func txSenderNonce(tx sdk.Tx) (string, uint64, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return "", 0, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "tx must implement SigVerifiableTx, got %T", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return "", 0, err
+	}
+
+	signers := sigTx.GetSigners()
+	if len(sigs) == 0 || len(signers) == 0 {
+		return "", 0, sdkerrors.ErrNoSignatures
+	}
+
+	return signers[0].String(), sigs[0].Sequence, nil
+}
@@ -0,0 +1,263 @@
+package baseapp
+
+import (
+	"container/heap"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Mempool = (*PriorityNonceMempool)(nil)
+
+// PriorityNonceMempool is a Mempool ordering txs by priority while keeping the
+// txs of each sender in sequence (nonce) order. The priority of a tx is the one
+// set on the context by the AnteHandler, which for the x/auth DeductFeeDecorator
+// is the fee paid per unit of gas.
+//
+// Each sender has a lane holding its txs sorted by sequence. When selecting
+// txs, the heads of all lanes are compared by priority, ties being broken by
+// insertion order, so that a sender's tx is never selected before the txs of
+// the same sender with a lower sequence.
+//
+// A tx with the same sender and sequence as a tx already in the mempool
+// replaces it only if it has a strictly higher priority. When the mempool is
+// full, a new tx evicts the lowest priority tx among the last txs of each lane
+// if it has a strictly higher priority, otherwise it is rejected.
+type PriorityNonceMempool struct {
+	mtx sync.RWMutex
+
+	maxTx   int
+	count   int
+	order   uint64
+	senders map[string][]*mempoolTx
+}
+
+type mempoolTx struct {
+	tx       sdk.Tx
+	sender   string
+	nonce    uint64
+	priority int64
+	order    uint64
+}
+
+// PriorityNonceMempoolOption defines an option of the PriorityNonceMempool.
+type PriorityNonceMempoolOption func(*PriorityNonceMempool)
+
+// PriorityNonceWithMaxTx sets the maximum number of txs held by the mempool.
+// A value of zero or less means the mempool is unbounded.
+func PriorityNonceWithMaxTx(maxTx int) PriorityNonceMempoolOption {
+	return func(mp *PriorityNonceMempool) { mp.maxTx = maxTx }
+}
+
+// NewPriorityNonceMempool returns a new, unbounded by default, PriorityNonceMempool.
+func NewPriorityNonceMempool(opts ...PriorityNonceMempoolOption) *PriorityNonceMempool {
+	mp := &PriorityNonceMempool{
+		senders: make(map[string][]*mempoolTx),
+	}
+
+	for _, opt := range opts {
+		opt(mp)
+	}
+
+	return mp
+}
+
+// Insert implements Mempool.
+func (mp *PriorityNonceMempool) Insert(ctx sdk.Context, tx sdk.Tx) error {
+	sender, nonce, err := txSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.order++
+	mtx := &mempoolTx{
+		tx:       tx,
+		sender:   sender,
+		nonce:    nonce,
+		priority: ctx.Priority(),
+		order:    mp.order,
+	}
+
+	lane := mp.senders[sender]
+	i := sort.Search(len(lane), func(i int) bool { return lane[i].nonce >= nonce })
+
+	// replace-by-fee
+	if i < len(lane) && lane[i].nonce == nonce {
+		if mtx.priority <= lane[i].priority {
+			return sdkerrors.Wrapf(
+				ErrTxReplacementUnderpriced,
+				"sender %s sequence %d: priority %d must be greater than %d", sender, nonce, mtx.priority, lane[i].priority,
+			)
+		}
+
+		lane[i] = mtx
+		return nil
+	}
+
+	if mp.maxTx > 0 && mp.count >= mp.maxTx {
+		victim := mp.lowestPriorityTail()
+		if victim == nil || victim.priority >= mtx.priority || (victim.sender == sender && victim.nonce < nonce) {
+			return sdkerrors.Wrapf(sdkerrors.ErrMempoolIsFull, "max tx capacity %d reached", mp.maxTx)
+		}
+
+		mp.remove(victim.sender, victim.nonce)
+		// the victim may have been the last tx of this sender
+		lane = mp.senders[sender]
+		i = sort.Search(len(lane), func(i int) bool { return lane[i].nonce >= nonce })
+	}
+
+	lane = append(lane, nil)
+	copy(lane[i+1:], lane[i:])
+	lane[i] = mtx
+	mp.senders[sender] = lane
+	mp.count++
+
+	return nil
+}
+
+// Select implements Mempool.
+func (mp *PriorityNonceMempool) Select(_ sdk.Context) MempoolIterator {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	if mp.count == 0 {
+		return nil
+	}
+
+	it := &priorityNonceIterator{
+		lanes: make([][]*mempoolTx, 0, len(mp.senders)),
+	}
+	for _, lane := range mp.senders {
+		// copy the lanes so that the iterator is not affected by later updates
+		it.lanes = append(it.lanes, append([]*mempoolTx(nil), lane...))
+	}
+
+	for i := range it.lanes {
+		it.heads = append(it.heads, i)
+	}
+	heap.Init(it)
+
+	return it.Next()
+}
+
+// CountTx implements Mempool.
+func (mp *PriorityNonceMempool) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.count
+}
+
+// Remove implements Mempool.
+func (mp *PriorityNonceMempool) Remove(tx sdk.Tx) error {
+	sender, nonce, err := txSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if !mp.remove(sender, nonce) {
+		return ErrTxNotFound
+	}
+
+	return nil
+}
+
+// remove deletes the tx of the given sender and nonce, returning whether it
+// was found.
+func (mp *PriorityNonceMempool) remove(sender string, nonce uint64) bool {
+	lane := mp.senders[sender]
+	i := sort.Search(len(lane), func(i int) bool { return lane[i].nonce >= nonce })
+	if i == len(lane) || lane[i].nonce != nonce {
+		return false
+	}
+
+	if len(lane) == 1 {
+		delete(mp.senders, sender)
+	} else {
+		mp.senders[sender] = append(lane[:i], lane[i+1:]...)
+	}
+
+	mp.count--
+	return true
+}
+
+// lowestPriorityTail returns the lowest priority tx among the last txs of each
+// sender lane, preferring the most recently inserted one on ties.
+func (mp *PriorityNonceMempool) lowestPriorityTail() *mempoolTx {
+	var victim *mempoolTx
+	for _, lane := range mp.senders {
+		tail := lane[len(lane)-1]
+		if victim == nil || tail.priority < victim.priority ||
+			(tail.priority == victim.priority && tail.order > victim.order) {
+			victim = tail
+		}
+	}
+
+	return victim
+}
+
+var (
+	_ MempoolIterator = (*priorityNonceIterator)(nil)
+	_ heap.Interface  = (*priorityNonceIterator)(nil)
+)
+
+// priorityNonceIterator is a max-heap over the heads of the sender lanes.
+type priorityNonceIterator struct {
+	lanes [][]*mempoolTx
+	heads []int // indexes into lanes
+	tx    *mempoolTx
+}
+
+// Next implements MempoolIterator.
+func (it *priorityNonceIterator) Next() MempoolIterator {
+	if it.Len() == 0 {
+		return nil
+	}
+
+	i := it.heads[0]
+	it.tx = it.lanes[i][0]
+	it.lanes[i] = it.lanes[i][1:]
+
+	if len(it.lanes[i]) == 0 {
+		heap.Pop(it)
+	} else {
+		heap.Fix(it, 0)
+	}
+
+	return it
+}
+
+// Tx implements MempoolIterator.
+func (it *priorityNonceIterator) Tx() sdk.Tx {
+	return it.tx.tx
+}
+
+func (it *priorityNonceIterator) Len() int { return len(it.heads) }
+
+func (it *priorityNonceIterator) Less(i, j int) bool {
+	a, b := it.lanes[it.heads[i]][0], it.lanes[it.heads[j]][0]
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+
+	return a.order < b.order
+}
+
+func (it *priorityNonceIterator) Swap(i, j int) { it.heads[i], it.heads[j] = it.heads[j], it.heads[i] }
+
+func (it *priorityNonceIterator) Push(x interface{}) { it.heads = append(it.heads, x.(int)) }
+
+func (it *priorityNonceIterator) Pop() interface{} {
+	n := len(it.heads)
+	x := it.heads[n-1]
+	it.heads = it.heads[:n-1]
+	return x
+}
@@ -0,0 +1,335 @@
+package baseapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var _ authsigning.SigVerifiableTx = mempoolTestTx{}
+
+// mempoolTestTx is a tx signed by a single sender with the given sequence,
+// which the test ante handler assigns the given priority.
+type mempoolTestTx struct {
+	sender     sdk.AccAddress
+	nonce      uint64
+	priority   int64
+	gas        uint64
+	failOnAnte bool
+}
+
+func (tx mempoolTestTx) GetMsgs() []sdk.Msg           { return []sdk.Msg{msgCounter{}} }
+func (tx mempoolTestTx) ValidateBasic() error         { return nil }
+func (tx mempoolTestTx) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{tx.sender} }
+func (tx mempoolTestTx) GetPubKeys() ([]cryptotypes.PubKey, error) {
+	return []cryptotypes.PubKey{nil}, nil
+}
+func (tx mempoolTestTx) GetGas() uint64             { return tx.gas }
+func (tx mempoolTestTx) GetFee() sdk.Coins          { return nil }
+func (tx mempoolTestTx) FeePayer() sdk.AccAddress   { return tx.sender }
+func (tx mempoolTestTx) FeeGranter() sdk.AccAddress { return nil }
+func (tx mempoolTestTx) GetSignaturesV2() ([]txsigning.SignatureV2, error) {
+	return []txsigning.SignatureV2{{Sequence: tx.nonce}}, nil
+}
+
+func (tx mempoolTestTx) String() string {
+	return fmt.Sprintf("%s/%d/%d", tx.sender, tx.nonce, tx.priority)
+}
+
+func newMempoolTestSenders(n int) []sdk.AccAddress {
+	senders := make([]sdk.AccAddress, n)
+	for i := range senders {
+		senders[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+	return senders
+}
+
+func insertMempoolTestTx(mp Mempool, tx mempoolTestTx) error {
+	ctx := sdk.Context{}.WithPriority(tx.priority)
+	return mp.Insert(ctx, tx)
+}
+
+func selectMempoolTestTxs(mp Mempool) []mempoolTestTx {
+	var txs []mempoolTestTx
+	for it := mp.Select(sdk.Context{}); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx().(mempoolTestTx))
+	}
+	return txs
+}
+
+func TestPriorityNonceMempoolOrdering(t *testing.T) {
+	sa := newMempoolTestSenders(2)
+
+	testCases := []struct {
+		name     string
+		txs      []mempoolTestTx
+		expected []int // indexes into txs
+	}{
+		{
+			"priority order across senders",
+			[]mempoolTestTx{
+				{sender: sa[0], nonce: 0, priority: 10},
+				{sender: sa[1], nonce: 0, priority: 20},
+			},
+			[]int{1, 0},
+		},
+		{
+			"nonce order within a sender",
+			[]mempoolTestTx{
+				{sender: sa[0], nonce: 1, priority: 30},
+				{sender: sa[0], nonce: 0, priority: 10},
+				{sender: sa[1], nonce: 0, priority: 20},
+			},
+			[]int{2, 1, 0},
+		},
+		{
+			"equal priorities in insertion order",
+			[]mempoolTestTx{
+				{sender: sa[1], nonce: 0, priority: 10},
+				{sender: sa[0], nonce: 0, priority: 10},
+				{sender: sa[0], nonce: 1, priority: 10},
+			},
+			[]int{0, 1, 2},
+		},
+		{
+			"interleaved senders",
+			[]mempoolTestTx{
+				{sender: sa[0], nonce: 0, priority: 50},
+				{sender: sa[0], nonce: 1, priority: 5},
+				{sender: sa[1], nonce: 0, priority: 20},
+				{sender: sa[1], nonce: 1, priority: 15},
+			},
+			[]int{0, 2, 3, 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := NewPriorityNonceMempool()
+			for _, tx := range tc.txs {
+				require.NoError(t, insertMempoolTestTx(mp, tx))
+			}
+			require.Equal(t, len(tc.txs), mp.CountTx())
+
+			selected := selectMempoolTestTxs(mp)
+			require.Len(t, selected, len(tc.expected))
+			for i, idx := range tc.expected {
+				require.Equal(t, tc.txs[idx], selected[i], "position %d", i)
+			}
+		})
+	}
+}
+
+func TestPriorityNonceMempoolReplaceByFee(t *testing.T) {
+	sa := newMempoolTestSenders(1)
+	mp := NewPriorityNonceMempool()
+
+	original := mempoolTestTx{sender: sa[0], nonce: 3, priority: 10}
+	require.NoError(t, insertMempoolTestTx(mp, original))
+
+	// same or lower priority is rejected
+	err := insertMempoolTestTx(mp, mempoolTestTx{sender: sa[0], nonce: 3, priority: 10, gas: 1})
+	require.ErrorIs(t, err, ErrTxReplacementUnderpriced)
+	err = insertMempoolTestTx(mp, mempoolTestTx{sender: sa[0], nonce: 3, priority: 5})
+	require.ErrorIs(t, err, ErrTxReplacementUnderpriced)
+	require.Equal(t, []mempoolTestTx{original}, selectMempoolTestTxs(mp))
+
+	// higher priority replaces the tx in place
+	replacement := mempoolTestTx{sender: sa[0], nonce: 3, priority: 11}
+	require.NoError(t, insertMempoolTestTx(mp, replacement))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []mempoolTestTx{replacement}, selectMempoolTestTxs(mp))
+
+	// removing by sender and nonce drops the replacement
+	require.NoError(t, mp.Remove(original))
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(sdk.Context{}))
+	require.ErrorIs(t, mp.Remove(original), ErrTxNotFound)
+}
+
+func TestPriorityNonceMempoolEviction(t *testing.T) {
+	sa := newMempoolTestSenders(3)
+	mp := NewPriorityNonceMempool(PriorityNonceWithMaxTx(3))
+
+	txs := []mempoolTestTx{
+		{sender: sa[0], nonce: 0, priority: 5},
+		{sender: sa[0], nonce: 1, priority: 20},
+		{sender: sa[1], nonce: 0, priority: 10},
+	}
+	for _, tx := range txs {
+		require.NoError(t, insertMempoolTestTx(mp, tx))
+	}
+
+	// a tx not paying more than the cheapest tail is rejected
+	err := insertMempoolTestTx(mp, mempoolTestTx{sender: sa[2], nonce: 0, priority: 10})
+	require.ErrorIs(t, err, sdkerrors.ErrMempoolIsFull)
+	require.Equal(t, 3, mp.CountTx())
+
+	// the lowest priority tail is evicted, never a tx other txs depend on
+	newTx := mempoolTestTx{sender: sa[2], nonce: 0, priority: 15}
+	require.NoError(t, insertMempoolTestTx(mp, newTx))
+	require.Equal(t, 3, mp.CountTx())
+	require.ErrorIs(t, mp.Remove(txs[2]), ErrTxNotFound)
+	require.Equal(t, []mempoolTestTx{newTx, txs[0], txs[1]}, selectMempoolTestTxs(mp))
+
+	// a sender cannot evict its own lower sequence
+	mp = NewPriorityNonceMempool(PriorityNonceWithMaxTx(1))
+	require.NoError(t, insertMempoolTestTx(mp, txs[0]))
+	err = insertMempoolTestTx(mp, txs[1])
+	require.ErrorIs(t, err, sdkerrors.ErrMempoolIsFull)
+
+	// replacement is allowed even when the mempool is full
+	require.NoError(t, insertMempoolTestTx(mp, mempoolTestTx{sender: sa[0], nonce: 0, priority: 6}))
+	require.Equal(t, 1, mp.CountTx())
+}
+
+func TestPriorityNonceMempoolInvalidTx(t *testing.T) {
+	mp := NewPriorityNonceMempool()
+	err := mp.Insert(sdk.Context{}, *newTxCounter(0, 0))
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestBaseAppMempool(t *testing.T) {
+	sa := newMempoolTestSenders(2)
+	registry := map[string]mempoolTestTx{}
+
+	txDecoder := func(bz []byte) (sdk.Tx, error) {
+		tx, ok := registry[string(bz)]
+		if !ok {
+			return nil, sdkerrors.ErrTxDecode
+		}
+		return tx, nil
+	}
+	txEncoder := func(tx sdk.Tx) ([]byte, error) {
+		bz := []byte(tx.(mempoolTestTx).String())
+		registry[string(bz)] = tx.(mempoolTestTx)
+		return bz, nil
+	}
+	mustEncode := func(tx mempoolTestTx) []byte {
+		bz, err := txEncoder(tx)
+		require.NoError(t, err)
+		return bz
+	}
+
+	mp := NewPriorityNonceMempool()
+	app := NewBaseApp(t.Name(), defaultLogger(), dbm.NewMemDB(), txDecoder, SetMempool(mp))
+	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		mtx := tx.(mempoolTestTx)
+		if mtx.failOnAnte {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+		}
+		return ctx.WithPriority(mtx.priority), nil
+	})
+	app.SetPrepareProposal(NewDefaultPrepareProposalHandler(mp, txEncoder))
+	app.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		return &sdk.Result{}, nil
+	}))
+	app.MountStores(capKey1)
+	app.SetParamStore(&paramStore{db: dbm.NewMemDB()})
+	require.NoError(t, app.LoadLatestVersion())
+	app.InitChain(abci.RequestInitChain{})
+
+	txs := []mempoolTestTx{
+		{sender: sa[0], nonce: 0, priority: 10, gas: 10},
+		{sender: sa[0], nonce: 1, priority: 10, gas: 10},
+		{sender: sa[1], nonce: 0, priority: 20, gas: 10},
+	}
+	for _, tx := range txs {
+		res := app.CheckTx(abci.RequestCheckTx{Tx: mustEncode(tx)})
+		require.True(t, res.IsOK(), res.Log)
+		require.Equal(t, tx.priority, res.Priority)
+	}
+	require.Equal(t, 3, mp.CountTx())
+
+	// underpriced replacement is rejected by CheckTx
+	res := app.CheckTx(abci.RequestCheckTx{Tx: mustEncode(mempoolTestTx{sender: sa[0], nonce: 0, priority: 9, gas: 10})})
+	require.False(t, res.IsOK())
+	require.Equal(t, 3, mp.CountTx())
+
+	// proposals are built in priority-nonce order and honour the bytes limit
+	proposal := app.PrepareProposal(0)
+	require.Equal(t, [][]byte{mustEncode(txs[2]), mustEncode(txs[0]), mustEncode(txs[1])}, proposal)
+	limit := int64(len(proposal[0]) + len(proposal[1]))
+	require.Equal(t, proposal[:2], app.PrepareProposal(limit))
+
+	// delivered txs are removed from the mempool
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	for _, bz := range proposal[:2] {
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: bz})
+		require.True(t, res.IsOK(), res.Log)
+	}
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+	require.Equal(t, 1, mp.CountTx())
+
+	// a tx failing the re-check is removed from the mempool
+	failing := txs[1]
+	failing.failOnAnte = true
+	registry[string(proposal[2])] = failing
+	res = app.CheckTx(abci.RequestCheckTx{Tx: proposal[2], Type: abci.CheckTxType_Recheck})
+	require.False(t, res.IsOK())
+	require.Equal(t, 0, mp.CountTx())
+	require.Empty(t, app.PrepareProposal(0))
+
+	// a delivered tx failing the AnteHandler is removed from the mempool too
+	next := mempoolTestTx{sender: sa[1], nonce: 1, priority: 20, gas: 10}
+	res = app.CheckTx(abci.RequestCheckTx{Tx: mustEncode(next)})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, 1, mp.CountTx())
+
+	next.failOnAnte = true
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}})
+	deliverRes := app.DeliverTx(abci.RequestDeliverTx{Tx: mustEncode(next)})
+	require.False(t, deliverRes.IsOK())
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+	require.Equal(t, 0, mp.CountTx())
+}
+
+// faultyMempool is a Mempool failing to remove any tx.
+type faultyMempool struct {
+	Mempool
+}
+
+func (faultyMempool) Remove(sdk.Tx) error {
+	return fmt.Errorf("remove failure")
+}
+
+func TestBaseAppMempoolRemoveFailure(t *testing.T) {
+	sa := newMempoolTestSenders(1)
+	tx := mempoolTestTx{sender: sa[0], nonce: 0, priority: 10, gas: 10}
+	txBytes := []byte(tx.String())
+
+	txDecoder := func(bz []byte) (sdk.Tx, error) {
+		return tx, nil
+	}
+
+	mp := faultyMempool{NewPriorityNonceMempool()}
+	app := NewBaseApp(t.Name(), defaultLogger(), dbm.NewMemDB(), txDecoder, SetMempool(mp))
+	app.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		return &sdk.Result{}, nil
+	}))
+	app.MountStores(capKey1)
+	app.SetParamStore(&paramStore{db: dbm.NewMemDB()})
+	require.NoError(t, app.LoadLatestVersion())
+	app.InitChain(abci.RequestInitChain{})
+
+	// a failure to remove the tx from the mempool doesn't fail its delivery
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+}
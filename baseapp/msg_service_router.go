@@ -17,6 +17,21 @@ import (
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	circuitBreaker    CircuitBreaker
+	msgRestriction    MsgRestriction
+}
+
+// CircuitBreaker decides whether the messages of a type can be executed,
+// allowing an app to disable some message types at runtime.
+type CircuitBreaker interface {
+	IsAllowed(ctx sdk.Context, typeURL string) bool
+}
+
+// MsgRestriction decides whether a message can be executed in the given
+// context, allowing a module to restrict some messages whichever path they are
+// routed through, e.g. a tx, an authz grant or a governance proposal.
+type MsgRestriction interface {
+	CheckMsg(ctx sdk.Context, msg sdk.Msg) error
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -107,6 +122,16 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 		}
 
 		msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+			if msr.circuitBreaker != nil && !msr.circuitBreaker.IsAllowed(ctx, requestTypeName) {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrMsgDisabled, requestTypeName)
+			}
+
+			if msr.msgRestriction != nil {
+				if err := msr.msgRestriction.CheckMsg(ctx, req); err != nil {
+					return nil, err
+				}
+			}
+
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
@@ -143,6 +168,16 @@ func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.I
 	msr.interfaceRegistry = interfaceRegistry
 }
 
+// SetCircuit sets the circuit breaker checked before executing any message.
+func (msr *MsgServiceRouter) SetCircuit(cb CircuitBreaker) {
+	msr.circuitBreaker = cb
+}
+
+// SetMsgRestriction sets the restriction checked before executing any message.
+func (msr *MsgServiceRouter) SetMsgRestriction(r MsgRestriction) {
+	msr.msgRestriction = r
+}
+
 func noopDecoder(_ interface{}) error { return nil }
 func noopInterceptor(_ context.Context, _ interface{}, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
 	return nil, nil
@@ -14,6 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -120,3 +122,36 @@ func TestMsgService(t *testing.T) {
 	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
 	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
 }
+
+type disabledMsgs map[string]bool
+
+func (d disabledMsgs) IsAllowed(_ sdk.Context, typeURL string) bool {
+	return !d[typeURL]
+}
+
+func TestMsgServiceCircuitBreaker(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	app := baseapp.NewBaseApp("test", log.NewNopLogger(), dbm.NewMemDB(), encCfg.TxConfig.TxDecoder())
+	app.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+	_ = app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	handler := app.MsgServiceRouter().Handler(msg)
+	_, err := handler(ctx, msg)
+	require.NoError(t, err)
+
+	disabled := disabledMsgs{sdk.MsgTypeURL(msg): true}
+	app.MsgServiceRouter().SetCircuit(disabled)
+	_, err = handler(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrMsgDisabled)
+
+	disabled[sdk.MsgTypeURL(msg)] = false
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+}
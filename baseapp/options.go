@@ -80,6 +80,17 @@ func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOpti
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
 }
 
+// SetMempool sets the application-side mempool on BaseApp.
+func SetMempool(mempool Mempool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetMempool(mempool) }
+}
+
+// SetParallelTxExecution sets the number of workers DeliverTxs executes txs
+// with in parallel. A value of 0 disables parallel tx execution.
+func SetParallelTxExecution(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetParallelTxExecution(workers) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -166,6 +177,41 @@ func (app *BaseApp) SetPostHandler(ph sdk.AnteHandler) {
 	app.postHandler = ph
 }
 
+// SetMempool sets the application-side mempool. Txs passing CheckTx are
+// inserted into it and removed once they are delivered in a block.
+func (app *BaseApp) SetMempool(mempool Mempool) {
+	if app.sealed {
+		panic("SetMempool() on sealed BaseApp")
+	}
+
+	app.mempool = mempool
+}
+
+// SetParallelTxExecution sets the number of workers DeliverTxs executes txs
+// with in parallel. A value of 0 disables parallel tx execution, in which case
+// DeliverTxs executes txs sequentially.
+func (app *BaseApp) SetParallelTxExecution(workers int) {
+	if app.sealed {
+		panic("SetParallelTxExecution() on sealed BaseApp")
+	}
+
+	if workers < 0 {
+		panic("parallel tx execution workers must not be negative")
+	}
+
+	app.parallelTxWorkers = workers
+}
+
+// SetPrepareProposal sets the handler used by PrepareProposal to build block
+// proposals.
+func (app *BaseApp) SetPrepareProposal(handler PrepareProposalHandler) {
+	if app.sealed {
+		panic("SetPrepareProposal() on sealed BaseApp")
+	}
+
+	app.prepareProposal = handler
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
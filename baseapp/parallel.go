@@ -0,0 +1,205 @@
+package baseapp
+
+import (
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/rwset"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// parallelTx is the outcome of the execution of a single tx of a batch on its
+// own branch of the deliver state.
+type parallelTx struct {
+	// ms is the branch of the deliver state the tx executed on, it holds the
+	// state transitions of the tx until it is written.
+	ms cachemulti.Store
+	// recorders record the read and write sets of the tx per store.
+	recorders map[storetypes.StoreKey]*rwset.Store
+	// blockGas is the gas the tx consumed from the block gas meter.
+	blockGas sdk.Gas
+	// msgQuotas is the branch of the block message quotas the tx used.
+	msgQuotas *msgQuotaMeter
+
+	gInfo      sdk.GasInfo
+	result     *sdk.Result
+	anteEvents []abci.Event
+	err        error
+}
+
+// DeliverTxs executes a batch of txs in DeliverTx mode, in the order given,
+// and returns their responses. It is equivalent to calling DeliverTx for each
+// request: the resulting state, gas and events are the same.
+//
+// Unless parallel tx execution is enabled, see SetParallelTxExecution, the
+// txs are simply executed sequentially. Otherwise they are first executed
+// optimistically and concurrently, each on its own branch of the deliver
+// state, while recording the keys each tx reads and writes. The results are
+// then committed in order: a tx whose reads do not intersect with the writes
+// of the txs committed before it is committed as is, any other tx is executed
+// again on top of the committed state.
+//
+// Note, Tendermint delivers txs one by one, so DeliverTxs is meant for callers
+// holding a whole block of txs, e.g. block replay and simulations.
+func (app *BaseApp) DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	responses := make([]abci.ResponseDeliverTx, len(reqs))
+
+	stores, ok := app.parallelTxStores()
+	if !ok || len(reqs) < 2 {
+		for i, req := range reqs {
+			responses[i] = app.DeliverTx(req)
+		}
+
+		return responses
+	}
+
+	txs := make([]*parallelTx, len(reqs))
+
+	var wg sync.WaitGroup
+	indexes := make(chan int)
+	for w := 0; w < app.parallelTxWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				// The tx consumes from a private block gas meter and a branch of
+				// the message quotas, the block limits are enforced when the
+				// result gets committed.
+				blockGasMeter := sdk.NewInfiniteGasMeter()
+				msgQuotas := app.msgQuotas.branch()
+				txs[i] = app.executeParallelTx(stores, reqs[i].Tx, blockGasMeter, msgQuotas)
+				txs[i].blockGas = blockGasMeter.GasConsumed()
+				txs[i].msgQuotas = msgQuotas
+			}
+		}()
+	}
+
+	for i := range reqs {
+		indexes <- i
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	blockGasMeter := app.deliverState.ctx.BlockGasMeter()
+	committed := make(map[storetypes.StoreKey]rwset.KeySet, len(stores))
+	for key := range stores {
+		committed[key] = make(rwset.KeySet)
+	}
+
+	for i, req := range reqs {
+		tx := txs[i]
+		if tx.conflictsWith(committed) || !blockGasFits(blockGasMeter, tx.blockGas) || !app.msgQuotasFit(tx) {
+			tx = app.executeParallelTx(stores, req.Tx, blockGasMeter, app.msgQuotas)
+		} else {
+			blockGasMeter.ConsumeGas(tx.blockGas, "block gas meter")
+			app.msgQuotas.add(tx.msgQuotas.consumed)
+		}
+
+		tx.ms.Write()
+		for key, recorder := range tx.recorders {
+			committed[key].Merge(recorder.Writes())
+		}
+
+		responses[i] = app.deliverTxResponse(req, tx.gInfo, tx.result, tx.anteEvents, tx.err)
+	}
+
+	return responses
+}
+
+// parallelTxStores returns the stores of the deliver state wrapped to be
+// shared by the txs executed in parallel. It returns false if parallel tx
+// execution is disabled or not supported by the app.
+func (app *BaseApp) parallelTxStores() (map[storetypes.StoreKey]*rwset.SyncStore, bool) {
+	// Without an AnteHandler the txs would share the gas meter and the event
+	// manager of the deliver state.
+	if app.parallelTxWorkers <= 0 || app.anteHandler == nil {
+		return nil, false
+	}
+
+	cms, ok := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return nil, false
+	}
+
+	keys := cms.StoreKeysByName()
+	stores := make(map[storetypes.StoreKey]*rwset.SyncStore, len(keys))
+	for _, key := range keys {
+		stores[key] = rwset.NewSyncStore(app.deliverState.ms.GetKVStore(key))
+	}
+
+	return stores, true
+}
+
+// executeParallelTx executes a tx in DeliverTx mode on a new branch of the
+// given stores, consuming block gas from blockGasMeter and message quotas
+// from msgQuotas. The branch is not written.
+func (app *BaseApp) executeParallelTx(
+	stores map[storetypes.StoreKey]*rwset.SyncStore, txBytes []byte, blockGasMeter sdk.GasMeter, msgQuotas *msgQuotaMeter,
+) *parallelTx {
+	tx := &parallelTx{
+		recorders: make(map[storetypes.StoreKey]*rwset.Store, len(stores)),
+	}
+
+	parents := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(stores))
+	for key, store := range stores {
+		recorder := rwset.NewStore(store)
+		tx.recorders[key] = recorder
+		parents[key] = recorder
+	}
+
+	tx.ms = cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, parents, nil, nil, nil)
+
+	// the tx gets its own copy of the deliver state gas meter, which is only
+	// used until the AnteHandler sets up the tx gas meter
+	gasMeter := sdk.NewInfiniteGasMeter()
+	gasMeter.ConsumeGas(app.deliverState.ctx.GasMeter().GasConsumed(), "deliver state gas")
+
+	ctx := app.deliverState.ctx.
+		WithMultiStore(tx.ms).
+		WithGasMeter(gasMeter).
+		WithBlockGasMeter(blockGasMeter).
+		WithEventManager(sdk.NewEventManager())
+	ctx = app.newTxContext(ctx, runTxModeDeliver, txBytes)
+
+	tx.gInfo, tx.result, tx.anteEvents, _, tx.err = app.runTxWithContext(ctx, runTxModeDeliver, txBytes, msgQuotas)
+
+	return tx
+}
+
+// conflictsWith returns true if the tx read any of the keys written per store
+// in writes.
+func (tx *parallelTx) conflictsWith(writes map[storetypes.StoreKey]rwset.KeySet) bool {
+	for key, recorder := range tx.recorders {
+		if recorder.ConflictsWith(writes[key]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// msgQuotasFit returns true if the result of the tx does not depend on the
+// usage of the block message quotas, or if the messages of the successful tx
+// fit within the remaining quotas.
+func (app *BaseApp) msgQuotasFit(tx *parallelTx) bool {
+	if !tx.msgQuotas.wasTouched() {
+		return true
+	}
+
+	return tx.err == nil && app.msgQuotas.fits(tx.msgQuotas.consumed)
+}
+
+// blockGasFits returns true if gas can be consumed from the block gas meter
+// without running out of gas.
+func blockGasFits(meter sdk.GasMeter, gas sdk.Gas) bool {
+	return !meter.IsOutOfGas() && gas <= meter.Limit()-meter.GasConsumed()
+}
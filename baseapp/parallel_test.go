@@ -0,0 +1,168 @@
+package baseapp
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// setupParallelTestApp returns a BaseApp whose txs append their msgKeyValue
+// values to the stored ones and count the stored keys, so that the outcome of
+// a tx depends on the txs executed before it.
+func setupParallelTestApp(t *testing.T, workers int) *BaseApp {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			newCtx = ctx.WithGasMeter(sdk.NewGasMeter(100000))
+
+			if tx.(txTest).FailOnAnte {
+				return newCtx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+			}
+
+			newCtx.KVStore(capKey2).Set([]byte("last"), sdk.Uint64ToBigEndian(uint64(tx.(txTest).Counter)))
+			newCtx.EventManager().EmitEvents(counterEvent("ante_handler", tx.(txTest).Counter))
+
+			return newCtx, nil
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			kv := msg.(*msgKeyValue)
+			store := ctx.KVStore(capKey1)
+
+			value := append(append([]byte{}, store.Get(kv.Key)...), kv.Value...)
+			if bytes.Equal(kv.Value, []byte("fail")) {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+			}
+			store.Set(kv.Key, value)
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent("kv", sdk.NewAttribute("value", string(value))))
+			return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+		}))
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			store := ctx.KVStore(capKey1)
+
+			iter := store.Iterator(nil, nil)
+			defer iter.Close()
+
+			var count uint64
+			for ; iter.Valid(); iter.Next() {
+				count++
+			}
+
+			ctx.KVStore(capKey2).Set([]byte("count"), sdk.Uint64ToBigEndian(count))
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetParallelTxExecution(workers))
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{
+			Block: &abci.BlockParams{
+				MaxGas: 40000,
+			},
+		},
+	})
+
+	return app
+}
+
+func newTxKeyValue(counter int64, key, value string) *txTest {
+	return &txTest{Msgs: []sdk.Msg{msgKeyValue{Key: []byte(key), Value: []byte(value)}}, Counter: counter}
+}
+
+func TestDeliverTxsParallel(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	sequentialApp := setupParallelTestApp(t, 0)
+	parallelApp := setupParallelTestApp(t, 4)
+
+	nBlocks := 3
+	txPerHeight := 40
+
+	for blockN := 0; blockN < nBlocks; blockN++ {
+		var reqs []abci.RequestDeliverTx
+		for i := 0; i < txPerHeight; i++ {
+			counter := int64(blockN*txPerHeight + i)
+
+			var tx *txTest
+			switch {
+			case i%10 == 0:
+				// iterates over all the keys
+				tx = newTxCounter(counter, counter)
+			case i%7 == 0:
+				tx = newTxKeyValue(counter, fmt.Sprintf("key%d", i%5), "fail")
+			case i%11 == 0:
+				tx = newTxKeyValue(counter, "key", "value")
+				tx.setFailOnAnte(true)
+			default:
+				// every fifth tx writes to the same key
+				tx = newTxKeyValue(counter, fmt.Sprintf("key%d", i%5), fmt.Sprintf("%d,", counter))
+			}
+
+			txBytes, err := cdc.Marshal(tx)
+			require.NoError(t, err)
+
+			reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+		}
+
+		header := tmproto.Header{Height: int64(blockN) + 1}
+		sequentialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+		parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		var expected []abci.ResponseDeliverTx
+		for _, req := range reqs {
+			expected = append(expected, sequentialApp.DeliverTx(req))
+		}
+
+		responses := parallelApp.DeliverTxs(reqs)
+		require.Equal(t, expected, responses)
+
+		// the block gas limit is hit within the block
+		require.False(t, expected[0].IsErr())
+		require.True(t, expected[len(expected)-1].IsErr())
+		require.Equal(t,
+			sequentialApp.deliverState.ctx.BlockGasMeter().GasConsumed(),
+			parallelApp.deliverState.ctx.BlockGasMeter().GasConsumed(),
+		)
+
+		sequentialApp.EndBlock(abci.RequestEndBlock{})
+		parallelApp.EndBlock(abci.RequestEndBlock{})
+
+		require.Equal(t, sequentialApp.Commit(), parallelApp.Commit())
+	}
+}
+
+func TestDeliverTxsSequential(t *testing.T) {
+	app := setupParallelTestApp(t, 0)
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	var reqs []abci.RequestDeliverTx
+	for i := 0; i < 3; i++ {
+		txBytes, err := cdc.Marshal(newTxKeyValue(int64(i), "key", fmt.Sprintf("%d,", i)))
+		require.NoError(t, err)
+
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+	}
+
+	responses := app.DeliverTxs(reqs)
+	require.Len(t, responses, 3)
+	for _, res := range responses {
+		require.True(t, res.IsOK(), res.Log)
+	}
+
+	require.Equal(t, []byte("0,1,2,"), app.deliverState.ctx.KVStore(capKey1).Get([]byte("key")))
+}
@@ -18,6 +18,7 @@ var (
 	ParamStoreKeyBlockParams     = []byte("BlockParams")
 	ParamStoreKeyEvidenceParams  = []byte("EvidenceParams")
 	ParamStoreKeyValidatorParams = []byte("ValidatorParams")
+	ParamStoreKeyMsgQuotas       = []byte("MsgQuotas")
 )
 
 // ParamStore defines the interface the parameter store used by the BaseApp must
@@ -84,3 +85,27 @@ func ValidateValidatorParams(i interface{}) error {
 
 	return nil
 }
+
+// ValidateMsgQuotas defines a stateless validation on the block quotas of the
+// message types. This function is called whenever the parameters are updated
+// or stored.
+func ValidateMsgQuotas(i interface{}) error {
+	v, ok := i.([]MsgQuota)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, q := range v {
+		if err := q.Validate(); err != nil {
+			return err
+		}
+
+		if seen[q.MsgTypeURL] {
+			return fmt.Errorf("duplicate block quota for message type %s", q.MsgTypeURL)
+		}
+		seen[q.MsgTypeURL] = true
+	}
+
+	return nil
+}
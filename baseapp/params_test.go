@@ -64,3 +64,21 @@ func TestValidateValidatorParams(t *testing.T) {
 		require.Equal(t, tc.expectErr, baseapp.ValidateValidatorParams(tc.arg) != nil)
 	}
 }
+
+func TestValidateMsgQuotas(t *testing.T) {
+	testCases := []struct {
+		arg       interface{}
+		expectErr bool
+	}{
+		{nil, true},
+		{[]baseapp.MsgQuota{}, false},
+		{[]baseapp.MsgQuota{{MsgTypeURL: ""}}, true},
+		{[]baseapp.MsgQuota{{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend", MaxGasPercent: 101}}, true},
+		{[]baseapp.MsgQuota{{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend"}, {MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend"}}, true},
+		{[]baseapp.MsgQuota{{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend", MaxMsgs: 10, MaxGasPercent: 100, MaxBytes: 1000}}, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expectErr, baseapp.ValidateMsgQuotas(tc.arg) != nil)
+	}
+}
@@ -0,0 +1,286 @@
+package baseapp
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgQuota defines how much of a block the messages of a type may use, so
+// that a single message type cannot crowd out all the others. A limit of 0 is
+// not enforced.
+//
+// Quotas only account for the messages of successful txs: a message which
+// would exceed a quota fails with ErrMsgQuotaExceeded, failing its tx.
+type MsgQuota struct {
+	// MsgTypeURL is the type URL of the message, e.g.
+	// "/cosmos.bank.v1beta1.MsgMultiSend".
+	MsgTypeURL string `json:"msg_type_url" yaml:"msg_type_url"`
+	// MaxMsgs is the maximum number of messages per block.
+	MaxMsgs uint64 `json:"max_msgs" yaml:"max_msgs"`
+	// MaxGasPercent is the maximum percentage of the block gas limit the
+	// messages may consume while executing. It is not enforced if the block
+	// gas is unlimited.
+	MaxGasPercent uint64 `json:"max_gas_percent" yaml:"max_gas_percent"`
+	// MaxBytes is the maximum size of the txs containing the messages per
+	// block, each tx being counted once per message type it contains.
+	MaxBytes uint64 `json:"max_bytes" yaml:"max_bytes"`
+}
+
+// Validate performs a stateless validation of the quota.
+func (q MsgQuota) Validate() error {
+	if q.MsgTypeURL == "" {
+		return errors.New("block quota message type URL cannot be empty")
+	}
+
+	if q.MaxGasPercent > 100 {
+		return fmt.Errorf("block quota gas percentage of %s must not exceed 100: %d", q.MsgTypeURL, q.MaxGasPercent)
+	}
+
+	return nil
+}
+
+// MsgQuotaUsage defines the usage of a MsgQuota within a block.
+type MsgQuotaUsage struct {
+	MsgQuota
+
+	// MaxGas is the maximum gas resulting from MaxGasPercent, 0 if the block
+	// gas is unlimited.
+	MaxGas uint64
+	// Msgs, Gas and Bytes are the amounts used so far.
+	Msgs  uint64
+	Gas   uint64
+	Bytes uint64
+}
+
+// msgQuotaConsumption is the amount of a quota used by some messages.
+type msgQuotaConsumption struct {
+	msgs  uint64
+	gas   uint64
+	bytes uint64
+}
+
+// msgQuotaMeter tracks the usage of the message quotas within a block. It is
+// safe for concurrent use.
+type msgQuotaMeter struct {
+	mtx sync.Mutex
+
+	height int64
+	quotas map[string]MsgQuotaUsage
+	// touched is set once a tx executes a message with a quota.
+	touched bool
+	// consumed accumulates the usage added to a branch, it is nil otherwise.
+	consumed map[string]msgQuotaConsumption
+}
+
+func newMsgQuotaMeter() *msgQuotaMeter {
+	return &msgQuotaMeter{quotas: make(map[string]MsgQuotaUsage)}
+}
+
+// reset starts tracking the usage of quotas for the block at height.
+func (m *msgQuotaMeter) reset(height int64, quotas []MsgQuota, maxBlockGas uint64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.height = height
+	m.quotas = make(map[string]MsgQuotaUsage, len(quotas))
+	m.touched = false
+	for _, q := range quotas {
+		m.quotas[q.MsgTypeURL] = MsgQuotaUsage{
+			MsgQuota: q,
+			MaxGas:   maxBlockGas * q.MaxGasPercent / 100,
+		}
+	}
+}
+
+// branch returns a copy of the meter, which does not affect the original one.
+func (m *msgQuotaMeter) branch() *msgQuotaMeter {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	b := &msgQuotaMeter{
+		height:   m.height,
+		quotas:   make(map[string]MsgQuotaUsage, len(m.quotas)),
+		consumed: make(map[string]msgQuotaConsumption),
+	}
+	for typeURL, usage := range m.quotas {
+		b.quotas[typeURL] = usage
+	}
+
+	return b
+}
+
+// usage returns the height of the block and the usage of its quotas, sorted
+// by message type URL.
+func (m *msgQuotaMeter) usage() (int64, []MsgQuotaUsage) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	res := make([]MsgQuotaUsage, 0, len(m.quotas))
+	for _, usage := range m.quotas {
+		res = append(res, usage)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].MsgTypeURL < res[j].MsgTypeURL })
+
+	return m.height, res
+}
+
+// wasTouched returns true if a message with a quota was executed.
+func (m *msgQuotaMeter) wasTouched() bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.touched
+}
+
+// check returns an error if consuming c would exceed the quota of typeURL. It
+// returns false if the message type has no quota.
+func (m *msgQuotaMeter) check(typeURL string, c msgQuotaConsumption) (bool, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	usage, ok := m.quotas[typeURL]
+	if !ok {
+		return false, nil
+	}
+	m.touched = true
+
+	switch {
+	case usage.MaxMsgs > 0 && usage.Msgs+c.msgs > usage.MaxMsgs:
+		return true, sdkerrors.ErrMsgQuotaExceeded.Wrapf("%s exceeds the block quota of %d messages", typeURL, usage.MaxMsgs)
+
+	case usage.MaxGas > 0 && usage.Gas+c.gas > usage.MaxGas:
+		return true, sdkerrors.ErrMsgQuotaExceeded.Wrapf("%s exceeds the block quota of %d gas", typeURL, usage.MaxGas)
+
+	case usage.MaxBytes > 0 && usage.Bytes+c.bytes > usage.MaxBytes:
+		return true, sdkerrors.ErrMsgQuotaExceeded.Wrapf("%s exceeds the block quota of %d bytes", typeURL, usage.MaxBytes)
+	}
+
+	return true, nil
+}
+
+// fits returns true if consumed can be added to the usage of the quotas
+// without exceeding any of them.
+func (m *msgQuotaMeter) fits(consumed map[string]msgQuotaConsumption) bool {
+	for typeURL, c := range consumed {
+		if _, err := m.check(typeURL, c); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// add adds consumed to the usage of the quotas.
+func (m *msgQuotaMeter) add(consumed map[string]msgQuotaConsumption) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	for typeURL, c := range consumed {
+		usage, ok := m.quotas[typeURL]
+		if !ok {
+			continue
+		}
+
+		usage.Msgs += c.msgs
+		usage.Gas += c.gas
+		usage.Bytes += c.bytes
+		m.quotas[typeURL] = usage
+
+		if m.consumed != nil {
+			total := m.consumed[typeURL]
+			total.msgs += c.msgs
+			total.gas += c.gas
+			total.bytes += c.bytes
+			m.consumed[typeURL] = total
+		}
+	}
+}
+
+// txMsgQuotas tracks the usage of the quotas by the messages of a single tx,
+// which is only added to the block usage once the tx succeeds. A nil
+// txMsgQuotas does not enforce any quota.
+type txMsgQuotas struct {
+	meter    *msgQuotaMeter
+	txBytes  uint64
+	consumed map[string]msgQuotaConsumption
+}
+
+func (m *msgQuotaMeter) newTx(txBytes []byte) *txMsgQuotas {
+	if m == nil {
+		return nil
+	}
+
+	return &txMsgQuotas{
+		meter:    m,
+		txBytes:  uint64(len(txBytes)),
+		consumed: make(map[string]msgQuotaConsumption),
+	}
+}
+
+// consumeMsg accounts for the execution of a message of type typeURL. The tx
+// bytes are accounted for with the first message of each type.
+func (t *txMsgQuotas) consumeMsg(typeURL string) error {
+	if t == nil {
+		return nil
+	}
+
+	c, seen := t.consumed[typeURL]
+	c.msgs++
+	if !seen {
+		c.bytes = t.txBytes
+	}
+
+	return t.consume(typeURL, c)
+}
+
+// consumeGas accounts for the gas consumed by a message of type typeURL.
+func (t *txMsgQuotas) consumeGas(typeURL string, gas sdk.Gas) error {
+	if t == nil {
+		return nil
+	}
+
+	c := t.consumed[typeURL]
+	c.gas += gas
+
+	return t.consume(typeURL, c)
+}
+
+func (t *txMsgQuotas) consume(typeURL string, c msgQuotaConsumption) error {
+	ok, err := t.meter.check(typeURL, c)
+	if ok {
+		t.consumed[typeURL] = c
+	}
+
+	return err
+}
+
+// commit adds the usage of the tx to the block usage.
+func (t *txMsgQuotas) commit() {
+	if t == nil {
+		return
+	}
+
+	t.meter.add(t.consumed)
+}
+
+// BlockQuotaUsage returns the height of the block being executed, or of the
+// last block executed, along with the usage of the message quotas within it.
+func (app *BaseApp) BlockQuotaUsage() (int64, []MsgQuotaUsage) {
+	return app.msgQuotas.usage()
+}
+
+// resetMsgQuotas starts tracking the message quotas stored in the param store
+// for the block of ctx.
+func (app *BaseApp) resetMsgQuotas(ctx sdk.Context) {
+	var quotas []MsgQuota
+	if app.paramStore != nil && app.paramStore.Has(ctx, ParamStoreKeyMsgQuotas) {
+		app.paramStore.Get(ctx, ParamStoreKeyMsgQuotas, &quotas)
+	}
+
+	app.msgQuotas.reset(ctx.BlockHeight(), quotas, app.getMaximumBlockGas(ctx))
+}
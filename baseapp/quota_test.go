@@ -0,0 +1,204 @@
+package baseapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// setupMsgQuotaTestApp returns the app of setupParallelTestApp with the given
+// message quotas.
+func setupMsgQuotaTestApp(t *testing.T, workers int, quotas ...MsgQuota) *BaseApp {
+	app := setupParallelTestApp(t, workers)
+	app.paramStore.Set(app.deliverState.ctx, ParamStoreKeyMsgQuotas, quotas)
+
+	return app
+}
+
+func newKeyValueTxs(t *testing.T, cdc *codec.LegacyAmino, n int) [][]byte {
+	txs := make([][]byte, n)
+	for i := range txs {
+		txBytes, err := cdc.Marshal(newTxKeyValue(int64(i), fmt.Sprintf("key%d", i), "value"))
+		require.NoError(t, err)
+
+		txs[i] = txBytes
+	}
+
+	return txs
+}
+
+func deliverKeyValueTxs(t *testing.T, app *BaseApp, cdc *codec.LegacyAmino, height int64, n int) []abci.ResponseDeliverTx {
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+
+	var responses []abci.ResponseDeliverTx
+	for _, txBytes := range newKeyValueTxs(t, cdc, n) {
+		responses = append(responses, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}))
+	}
+
+	return responses
+}
+
+func TestMsgQuotas(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	typeURL := sdk.MsgTypeURL(msgKeyValue{})
+
+	testCases := []struct {
+		name  string
+		quota MsgQuota
+		// expOK is the number of txs expected to succeed out of 5
+		expOK int
+	}{
+		{"no limit", MsgQuota{MsgTypeURL: typeURL}, 5},
+		{"other message type", MsgQuota{MsgTypeURL: "/other.Msg", MaxMsgs: 1}, 5},
+		{"max messages", MsgQuota{MsgTypeURL: typeURL, MaxMsgs: 3}, 3},
+		// each message consumes a little less than 4000 gas, i.e. 10% of the
+		// block gas
+		{"max gas", MsgQuota{MsgTypeURL: typeURL, MaxGasPercent: 21}, 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := setupMsgQuotaTestApp(t, 0, tc.quota)
+
+			for height := int64(1); height <= 2; height++ {
+				responses := deliverKeyValueTxs(t, app, cdc, height, 5)
+				for i, res := range responses {
+					if i < tc.expOK {
+						require.True(t, res.IsOK(), res.Log)
+					} else {
+						require.Equal(t, sdkerrors.ErrMsgQuotaExceeded.ABCICode(), res.Code, res.Log)
+					}
+				}
+
+				// the usage is reset at every block
+				usageHeight, usage := app.BlockQuotaUsage()
+				require.Equal(t, height, usageHeight)
+				require.Len(t, usage, 1)
+				require.Equal(t, tc.quota, usage[0].MsgQuota)
+				if tc.quota.MsgTypeURL == typeURL {
+					require.Equal(t, uint64(tc.expOK), usage[0].Msgs)
+				}
+				if usage[0].MaxGas > 0 {
+					require.LessOrEqual(t, usage[0].Gas, usage[0].MaxGas)
+				}
+
+				app.EndBlock(abci.RequestEndBlock{})
+				app.Commit()
+			}
+		})
+	}
+}
+
+func TestMsgQuotasMaxBytes(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	txs := newKeyValueTxs(t, cdc, 3)
+	maxBytes := uint64(len(txs[0]) + len(txs[1]))
+
+	app := setupMsgQuotaTestApp(t, 0, MsgQuota{MsgTypeURL: sdk.MsgTypeURL(msgKeyValue{}), MaxBytes: maxBytes})
+
+	responses := deliverKeyValueTxs(t, app, cdc, 1, 3)
+	require.True(t, responses[0].IsOK(), responses[0].Log)
+	require.True(t, responses[1].IsOK(), responses[1].Log)
+	require.Equal(t, sdkerrors.ErrMsgQuotaExceeded.ABCICode(), responses[2].Code, responses[2].Log)
+
+	_, usage := app.BlockQuotaUsage()
+	require.Equal(t, maxBytes, usage[0].Bytes)
+}
+
+func TestMsgQuotasFailedTx(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	app := setupMsgQuotaTestApp(t, 0, MsgQuota{MsgTypeURL: sdk.MsgTypeURL(msgKeyValue{}), MaxMsgs: 1})
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	// the messages of failed txs do not count towards the quota
+	for i, value := range []string{"fail", "value", "value"} {
+		txBytes, err := cdc.Marshal(newTxKeyValue(int64(i), "key", value))
+		require.NoError(t, err)
+
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		switch i {
+		case 0:
+			require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.Code, res.Log)
+		case 1:
+			require.True(t, res.IsOK(), res.Log)
+		case 2:
+			require.Equal(t, sdkerrors.ErrMsgQuotaExceeded.ABCICode(), res.Code, res.Log)
+		}
+	}
+
+	// quotas are not enforced outside of DeliverTx
+	txBytes, err := cdc.Marshal(newTxKeyValue(3, "key", "value"))
+	require.NoError(t, err)
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes}).IsOK())
+
+	_, usage := app.BlockQuotaUsage()
+	require.Equal(t, uint64(1), usage[0].Msgs)
+}
+
+func TestDeliverTxsParallelMsgQuotas(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	// all the test messages share the same type URL
+	quota := MsgQuota{MsgTypeURL: sdk.MsgTypeURL(msgKeyValue{}), MaxMsgs: 6, MaxGasPercent: 50}
+	sequentialApp := setupMsgQuotaTestApp(t, 0, quota)
+	parallelApp := setupMsgQuotaTestApp(t, 4, quota)
+
+	var reqs []abci.RequestDeliverTx
+	for i := 0; i < 40; i++ {
+		var tx *txTest
+		switch {
+		case i%10 == 0:
+			tx = newTxCounter(int64(i), int64(i))
+		case i%7 == 0:
+			tx = newTxKeyValue(int64(i), fmt.Sprintf("key%d", i%5), "fail")
+		default:
+			tx = newTxKeyValue(int64(i), fmt.Sprintf("key%d", i%5), fmt.Sprintf("%d,", i))
+		}
+
+		txBytes, err := cdc.Marshal(tx)
+		require.NoError(t, err)
+
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+	}
+
+	header := tmproto.Header{Height: 1}
+	sequentialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	var expected []abci.ResponseDeliverTx
+	for _, req := range reqs {
+		expected = append(expected, sequentialApp.DeliverTx(req))
+	}
+	require.Equal(t, expected, parallelApp.DeliverTxs(reqs))
+
+	// the quota is hit within the block
+	var exceeded int
+	for _, res := range expected {
+		if res.Code == sdkerrors.ErrMsgQuotaExceeded.ABCICode() {
+			exceeded++
+		}
+	}
+	require.NotZero(t, exceeded)
+
+	_, sequentialUsage := sequentialApp.BlockQuotaUsage()
+	_, parallelUsage := parallelApp.BlockQuotaUsage()
+	require.Equal(t, sequentialUsage, parallelUsage)
+
+	sequentialApp.EndBlock(abci.RequestEndBlock{})
+	parallelApp.EndBlock(abci.RequestEndBlock{})
+	require.Equal(t, sequentialApp.Commit(), parallelApp.Commit())
+}
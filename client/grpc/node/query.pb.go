@@ -6,6 +6,7 @@ package node
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -110,212 +111,2429 @@ func (m *ConfigResponse) GetMinimumGasPrice() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*ConfigRequest)(nil), "cosmos.base.node.v1beta1.ConfigRequest")
-	proto.RegisterType((*ConfigResponse)(nil), "cosmos.base.node.v1beta1.ConfigResponse")
+// VersionsRequest defines the request structure for the Versions gRPC query.
+type VersionsRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("cosmos/base/node/v1beta1/query.proto", fileDescriptor_8324226a07064341)
+func (m *VersionsRequest) Reset()         { *m = VersionsRequest{} }
+func (m *VersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*VersionsRequest) ProtoMessage()    {}
+func (*VersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{2}
+}
+func (m *VersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionsRequest.Merge(m, src)
+}
+func (m *VersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionsRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_8324226a07064341 = []byte{
-	// 282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xb1, 0x4b, 0xc3, 0x40,
-	0x18, 0xc5, 0x73, 0x0e, 0x15, 0x0f, 0xb4, 0x98, 0xa9, 0x14, 0x39, 0x4a, 0x10, 0x0c, 0x42, 0xef,
-	0xa8, 0xae, 0x4e, 0x3a, 0x74, 0x95, 0xba, 0xb9, 0x94, 0xcb, 0xf5, 0xf3, 0x3c, 0x6c, 0xee, 0x4b,
-	0x73, 0x97, 0x82, 0xab, 0xe0, 0xae, 0xf8, 0x4f, 0x39, 0x16, 0x5c, 0x1c, 0x25, 0xf1, 0x0f, 0x91,
-	0x24, 0xed, 0xe0, 0x50, 0x3a, 0x1d, 0xbc, 0xfb, 0xbd, 0xf7, 0x3d, 0x1e, 0x3d, 0x55, 0xe8, 0x52,
-	0x74, 0x22, 0x91, 0x0e, 0x84, 0xc5, 0x19, 0x88, 0xe5, 0x28, 0x01, 0x2f, 0x47, 0x62, 0x51, 0x40,
-	0xfe, 0xcc, 0xb3, 0x1c, 0x3d, 0x86, 0xbd, 0x96, 0xe2, 0x35, 0xc5, 0x6b, 0x8a, 0xaf, 0xa9, 0xfe,
-	0x89, 0x46, 0xd4, 0x73, 0x10, 0x32, 0x33, 0x42, 0x5a, 0x8b, 0x5e, 0x7a, 0x83, 0xd6, 0xb5, 0xbe,
-	0xa8, 0x4b, 0x0f, 0x6f, 0xd0, 0x3e, 0x18, 0x3d, 0x81, 0x45, 0x01, 0xce, 0x47, 0x57, 0xf4, 0x68,
-	0x23, 0xb8, 0x0c, 0xad, 0x83, 0xf0, 0x9c, 0x1e, 0xa7, 0xc6, 0x9a, 0xb4, 0x48, 0xa7, 0x5a, 0xba,
-	0x69, 0x96, 0x1b, 0x05, 0x3d, 0x32, 0x20, 0xf1, 0xc1, 0xa4, 0xbb, 0xfe, 0x18, 0x4b, 0x77, 0x5b,
-	0xcb, 0x17, 0xef, 0x84, 0xee, 0xdf, 0x41, 0xbe, 0x34, 0x0a, 0xc2, 0x57, 0x42, 0x3b, 0x6d, 0x54,
-	0x78, 0xc6, 0xb7, 0xd5, 0xe3, 0xff, 0xae, 0xf7, 0xe3, 0xdd, 0x60, 0xdb, 0x2a, 0x8a, 0x5f, 0xbe,
-	0x7e, 0x3f, 0xf6, 0xa2, 0x70, 0x20, 0xb6, 0xee, 0xa3, 0x1a, 0xc7, 0xf5, 0xf8, 0xb3, 0x64, 0x64,
-	0x55, 0x32, 0xf2, 0x53, 0x32, 0xf2, 0x56, 0xb1, 0x60, 0x55, 0xb1, 0xe0, 0xbb, 0x62, 0xc1, 0xfd,
-	0x50, 0x1b, 0xff, 0x58, 0x24, 0x5c, 0x61, 0xba, 0x49, 0x69, 0x9f, 0xa1, 0x9b, 0x3d, 0x09, 0x35,
-	0x37, 0x60, 0xbd, 0xd0, 0x79, 0xa6, 0x9a, 0xdc, 0xa4, 0xd3, 0x4c, 0x76, 0xf9, 0x17, 0x00, 0x00,
-	0xff, 0xff, 0x7d, 0x46, 0xb4, 0x93, 0x92, 0x01, 0x00, 0x00,
+var xxx_messageInfo_VersionsRequest proto.InternalMessageInfo
+
+// VersionsResponse defines the response structure for the Versions gRPC query.
+type VersionsResponse struct {
+	// stores are the versions available for every store, sorted by store name.
+	Stores []StoreVersions `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores"`
+	// pinned are the ranges of heights pinned with PinVersions.
+	Pinned []HeightRange `protobuf:"bytes,2,rep,name=pinned,proto3" json:"pinned"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *VersionsResponse) Reset()         { *m = VersionsResponse{} }
+func (m *VersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*VersionsResponse) ProtoMessage()    {}
+func (*VersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{3}
+}
+func (m *VersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionsResponse.Merge(m, src)
+}
+func (m *VersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionsResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_VersionsResponse proto.InternalMessageInfo
 
-// ServiceClient is the client API for Service service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ServiceClient interface {
-	// Config queries for the operator configuration.
-	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
+func (m *VersionsResponse) GetStores() []StoreVersions {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
 }
 
-type serviceClient struct {
-	cc grpc1.ClientConn
+func (m *VersionsResponse) GetPinned() []HeightRange {
+	if m != nil {
+		return m.Pinned
+	}
+	return nil
 }
 
-func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
-	return &serviceClient{cc}
+// StoreVersions defines the versions available on disk for a store.
+type StoreVersions struct {
+	StoreName string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// earliest is the lowest available height.
+	Earliest int64 `protobuf:"varint,2,opt,name=earliest,proto3" json:"earliest,omitempty"`
+	// latest is the highest available height.
+	Latest int64 `protobuf:"varint,3,opt,name=latest,proto3" json:"latest,omitempty"`
+	// gaps are the ranges of heights between earliest and latest that are not
+	// available, e.g. because they were pruned while snapshot heights were kept.
+	Gaps []HeightRange `protobuf:"bytes,4,rep,name=gaps,proto3" json:"gaps"`
 }
 
-func (c *serviceClient) Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error) {
-	out := new(ConfigResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.base.node.v1beta1.Service/Config", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StoreVersions) Reset()         { *m = StoreVersions{} }
+func (m *StoreVersions) String() string { return proto.CompactTextString(m) }
+func (*StoreVersions) ProtoMessage()    {}
+func (*StoreVersions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{4}
+}
+func (m *StoreVersions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreVersions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreVersions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *StoreVersions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreVersions.Merge(m, src)
+}
+func (m *StoreVersions) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreVersions) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreVersions.DiscardUnknown(m)
 }
 
-// ServiceServer is the server API for Service service.
-type ServiceServer interface {
-	// Config queries for the operator configuration.
-	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
+var xxx_messageInfo_StoreVersions proto.InternalMessageInfo
+
+func (m *StoreVersions) GetStoreName() string {
+	if m != nil {
+		return m.StoreName
+	}
+	return ""
 }
 
-// UnimplementedServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedServiceServer struct {
+func (m *StoreVersions) GetEarliest() int64 {
+	if m != nil {
+		return m.Earliest
+	}
+	return 0
 }
 
-func (*UnimplementedServiceServer) Config(ctx context.Context, req *ConfigRequest) (*ConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
+func (m *StoreVersions) GetLatest() int64 {
+	if m != nil {
+		return m.Latest
+	}
+	return 0
 }
 
-func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
-	s.RegisterService(&_Service_serviceDesc, srv)
+func (m *StoreVersions) GetGaps() []HeightRange {
+	if m != nil {
+		return m.Gaps
+	}
+	return nil
 }
 
-func _Service_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// HeightRange defines an inclusive range of heights.
+type HeightRange struct {
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *HeightRange) Reset()         { *m = HeightRange{} }
+func (m *HeightRange) String() string { return proto.CompactTextString(m) }
+func (*HeightRange) ProtoMessage()    {}
+func (*HeightRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{5}
+}
+func (m *HeightRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeightRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeightRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(ServiceServer).Config(ctx, in)
+}
+func (m *HeightRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeightRange.Merge(m, src)
+}
+func (m *HeightRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeightRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeightRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeightRange proto.InternalMessageInfo
+
+func (m *HeightRange) GetStart() int64 {
+	if m != nil {
+		return m.Start
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.base.node.v1beta1.Service/Config",
+	return 0
+}
+
+func (m *HeightRange) GetEnd() int64 {
+	if m != nil {
+		return m.End
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).Config(ctx, req.(*ConfigRequest))
+	return 0
+}
+
+// PinVersionsRequest defines the request structure for the PinVersions gRPC method.
+type PinVersionsRequest struct {
+	Range HeightRange `protobuf:"bytes,1,opt,name=range,proto3" json:"range"`
+}
+
+func (m *PinVersionsRequest) Reset()         { *m = PinVersionsRequest{} }
+func (m *PinVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*PinVersionsRequest) ProtoMessage()    {}
+func (*PinVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{6}
+}
+func (m *PinVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PinVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PinVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *PinVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinVersionsRequest.Merge(m, src)
+}
+func (m *PinVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PinVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinVersionsRequest.DiscardUnknown(m)
 }
 
-var _Service_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.base.node.v1beta1.Service",
-	HandlerType: (*ServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Config",
-			Handler:    _Service_Config_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/base/node/v1beta1/query.proto",
+var xxx_messageInfo_PinVersionsRequest proto.InternalMessageInfo
+
+func (m *PinVersionsRequest) GetRange() HeightRange {
+	if m != nil {
+		return m.Range
+	}
+	return HeightRange{}
 }
 
-func (m *ConfigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// PinVersionsResponse defines the response structure for the PinVersions gRPC method.
+type PinVersionsResponse struct {
+}
+
+func (m *PinVersionsResponse) Reset()         { *m = PinVersionsResponse{} }
+func (m *PinVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*PinVersionsResponse) ProtoMessage()    {}
+func (*PinVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{7}
+}
+func (m *PinVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PinVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PinVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *PinVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinVersionsResponse.Merge(m, src)
+}
+func (m *PinVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PinVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinVersionsResponse.DiscardUnknown(m)
 }
 
-func (m *ConfigRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PinVersionsResponse proto.InternalMessageInfo
+
+// UnpinVersionsRequest defines the request structure for the UnpinVersions gRPC method.
+type UnpinVersionsRequest struct {
+	Range HeightRange `protobuf:"bytes,1,opt,name=range,proto3" json:"range"`
 }
 
-func (m *ConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *UnpinVersionsRequest) Reset()         { *m = UnpinVersionsRequest{} }
+func (m *UnpinVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*UnpinVersionsRequest) ProtoMessage()    {}
+func (*UnpinVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{8}
+}
+func (m *UnpinVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpinVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpinVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnpinVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpinVersionsRequest.Merge(m, src)
+}
+func (m *UnpinVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpinVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpinVersionsRequest.DiscardUnknown(m)
 }
 
-func (m *ConfigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UnpinVersionsRequest proto.InternalMessageInfo
+
+func (m *UnpinVersionsRequest) GetRange() HeightRange {
+	if m != nil {
+		return m.Range
 	}
-	return dAtA[:n], nil
+	return HeightRange{}
 }
 
-func (m *ConfigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnpinVersionsResponse defines the response structure for the UnpinVersions gRPC method.
+type UnpinVersionsResponse struct {
 }
 
-func (m *ConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MinimumGasPrice) > 0 {
-		i -= len(m.MinimumGasPrice)
-		copy(dAtA[i:], m.MinimumGasPrice)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinimumGasPrice)))
-		i--
-		dAtA[i] = 0xa
+func (m *UnpinVersionsResponse) Reset()         { *m = UnpinVersionsResponse{} }
+func (m *UnpinVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*UnpinVersionsResponse) ProtoMessage()    {}
+func (*UnpinVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{9}
+}
+func (m *UnpinVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpinVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpinVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnpinVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpinVersionsResponse.Merge(m, src)
+}
+func (m *UnpinVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpinVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpinVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpinVersionsResponse proto.InternalMessageInfo
+
+// BlockQuotasRequest defines the request structure for the BlockQuotas gRPC query.
+type BlockQuotasRequest struct {
+}
+
+func (m *BlockQuotasRequest) Reset()         { *m = BlockQuotasRequest{} }
+func (m *BlockQuotasRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockQuotasRequest) ProtoMessage()    {}
+func (*BlockQuotasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{10}
+}
+func (m *BlockQuotasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockQuotasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockQuotasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockQuotasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockQuotasRequest.Merge(m, src)
+}
+func (m *BlockQuotasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockQuotasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockQuotasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockQuotasRequest proto.InternalMessageInfo
+
+// BlockQuotasResponse defines the response structure for the BlockQuotas gRPC query.
+type BlockQuotasResponse struct {
+	// height is the height of the block the usage is reported for.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// quotas are the usages of the quotas, sorted by message type URL.
+	Quotas []MsgQuotaUsage `protobuf:"bytes,2,rep,name=quotas,proto3" json:"quotas"`
+}
+
+func (m *BlockQuotasResponse) Reset()         { *m = BlockQuotasResponse{} }
+func (m *BlockQuotasResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockQuotasResponse) ProtoMessage()    {}
+func (*BlockQuotasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{11}
+}
+func (m *BlockQuotasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockQuotasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockQuotasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockQuotasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockQuotasResponse.Merge(m, src)
+}
+func (m *BlockQuotasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockQuotasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockQuotasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockQuotasResponse proto.InternalMessageInfo
+
+func (m *BlockQuotasResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockQuotasResponse) GetQuotas() []MsgQuotaUsage {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
+// MsgQuotaUsage defines the block quota of a message type and its usage. A
+// limit of 0 is not enforced.
+type MsgQuotaUsage struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// max_msgs is the maximum number of messages per block.
+	MaxMsgs uint64 `protobuf:"varint,2,opt,name=max_msgs,json=maxMsgs,proto3" json:"max_msgs,omitempty"`
+	// max_gas_percent is the maximum percentage of the block gas limit the
+	// messages may consume.
+	MaxGasPercent uint64 `protobuf:"varint,3,opt,name=max_gas_percent,json=maxGasPercent,proto3" json:"max_gas_percent,omitempty"`
+	// max_gas is the maximum gas resulting from max_gas_percent, 0 if the block
+	// gas is unlimited.
+	MaxGas uint64 `protobuf:"varint,4,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+	// max_bytes is the maximum size of the txs containing the messages per block.
+	MaxBytes uint64 `protobuf:"varint,5,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	// msgs, gas and bytes are the amounts used in the block.
+	Msgs  uint64 `protobuf:"varint,6,opt,name=msgs,proto3" json:"msgs,omitempty"`
+	Gas   uint64 `protobuf:"varint,7,opt,name=gas,proto3" json:"gas,omitempty"`
+	Bytes uint64 `protobuf:"varint,8,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (m *MsgQuotaUsage) Reset()         { *m = MsgQuotaUsage{} }
+func (m *MsgQuotaUsage) String() string { return proto.CompactTextString(m) }
+func (*MsgQuotaUsage) ProtoMessage()    {}
+func (*MsgQuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{12}
+}
+func (m *MsgQuotaUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgQuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgQuotaUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgQuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgQuotaUsage.Merge(m, src)
+}
+func (m *MsgQuotaUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgQuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgQuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgQuotaUsage proto.InternalMessageInfo
+
+func (m *MsgQuotaUsage) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgQuotaUsage) GetMaxMsgs() uint64 {
+	if m != nil {
+		return m.MaxMsgs
+	}
+	return 0
+}
+
+func (m *MsgQuotaUsage) GetMaxGasPercent() uint64 {
+	if m != nil {
+		return m.MaxGasPercent
+	}
+	return 0
+}
+
+func (m *MsgQuotaUsage) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
+func (m *MsgQuotaUsage) GetMaxBytes() uint64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *MsgQuotaUsage) GetMsgs() uint64 {
+	if m != nil {
+		return m.Msgs
+	}
+	return 0
+}
+
+func (m *MsgQuotaUsage) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *MsgQuotaUsage) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ConfigRequest)(nil), "cosmos.base.node.v1beta1.ConfigRequest")
+	proto.RegisterType((*ConfigResponse)(nil), "cosmos.base.node.v1beta1.ConfigResponse")
+	proto.RegisterType((*VersionsRequest)(nil), "cosmos.base.node.v1beta1.VersionsRequest")
+	proto.RegisterType((*VersionsResponse)(nil), "cosmos.base.node.v1beta1.VersionsResponse")
+	proto.RegisterType((*StoreVersions)(nil), "cosmos.base.node.v1beta1.StoreVersions")
+	proto.RegisterType((*HeightRange)(nil), "cosmos.base.node.v1beta1.HeightRange")
+	proto.RegisterType((*PinVersionsRequest)(nil), "cosmos.base.node.v1beta1.PinVersionsRequest")
+	proto.RegisterType((*PinVersionsResponse)(nil), "cosmos.base.node.v1beta1.PinVersionsResponse")
+	proto.RegisterType((*UnpinVersionsRequest)(nil), "cosmos.base.node.v1beta1.UnpinVersionsRequest")
+	proto.RegisterType((*UnpinVersionsResponse)(nil), "cosmos.base.node.v1beta1.UnpinVersionsResponse")
+	proto.RegisterType((*BlockQuotasRequest)(nil), "cosmos.base.node.v1beta1.BlockQuotasRequest")
+	proto.RegisterType((*BlockQuotasResponse)(nil), "cosmos.base.node.v1beta1.BlockQuotasResponse")
+	proto.RegisterType((*MsgQuotaUsage)(nil), "cosmos.base.node.v1beta1.MsgQuotaUsage")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/node/v1beta1/query.proto", fileDescriptor_8324226a07064341)
+}
+
+var fileDescriptor_8324226a07064341 = []byte{
+	// 784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xfa, 0xc7, 0xda, 0x79, 0xc6, 0xb8, 0x9d, 0xba, 0xed, 0xb2, 0x80, 0xb1, 0x56, 0xa5,
+	0x98, 0x08, 0xef, 0xaa, 0x45, 0xdc, 0x90, 0x10, 0xae, 0x50, 0xb9, 0x14, 0x95, 0x2d, 0x01, 0xc1,
+	0xc5, 0x1a, 0xaf, 0x87, 0xc9, 0x52, 0xef, 0xcc, 0x66, 0x67, 0x1c, 0xc5, 0x57, 0x24, 0x8e, 0x48,
+	0x48, 0xdc, 0x10, 0xe2, 0xc2, 0x3f, 0xd3, 0x63, 0x24, 0x2e, 0x9c, 0x10, 0x4a, 0x10, 0x7f, 0x07,
+	0x9a, 0x1f, 0x4e, 0xe2, 0x84, 0x4d, 0x9c, 0x43, 0x4f, 0x9e, 0x79, 0xef, 0x7b, 0xdf, 0xf7, 0x76,
+	0xe6, 0x7b, 0x63, 0xb8, 0x97, 0x70, 0x91, 0x71, 0x11, 0x4d, 0xb1, 0x20, 0x11, 0xe3, 0x33, 0x12,
+	0xed, 0x3f, 0x98, 0x12, 0x89, 0x1f, 0x44, 0x7b, 0x0b, 0x52, 0x2c, 0xc3, 0xbc, 0xe0, 0x92, 0x23,
+	0xcf, 0xa0, 0x42, 0x85, 0x0a, 0x15, 0x2a, 0xb4, 0x28, 0xbf, 0x47, 0x39, 0xe5, 0x1a, 0x14, 0xa9,
+	0x95, 0xc1, 0xfb, 0x6f, 0x50, 0xce, 0xe9, 0x9c, 0x44, 0x38, 0x4f, 0x23, 0xcc, 0x18, 0x97, 0x58,
+	0xa6, 0x9c, 0x09, 0x93, 0x0d, 0xba, 0xd0, 0x79, 0xc4, 0xd9, 0xb7, 0x29, 0x8d, 0xc9, 0xde, 0x82,
+	0x08, 0x19, 0x7c, 0x08, 0xaf, 0xae, 0x02, 0x22, 0xe7, 0x4c, 0x10, 0xb4, 0x0d, 0x37, 0xb3, 0x94,
+	0xa5, 0xd9, 0x22, 0x9b, 0x50, 0x2c, 0x26, 0x79, 0x91, 0x26, 0xc4, 0x73, 0x06, 0xce, 0x70, 0x2b,
+	0xee, 0xda, 0xc4, 0x63, 0x2c, 0x9e, 0xaa, 0x70, 0x70, 0x13, 0xba, 0x5f, 0x92, 0x42, 0x28, 0x81,
+	0x15, 0xe1, 0x6f, 0x0e, 0xdc, 0x38, 0x8d, 0x59, 0xce, 0x4f, 0xc0, 0x15, 0x92, 0x17, 0x44, 0x78,
+	0xce, 0xa0, 0x36, 0x6c, 0x3f, 0x7c, 0x27, 0x2c, 0xfb, 0xaa, 0xf0, 0x99, 0xc2, 0xad, 0x08, 0xc6,
+	0xf5, 0x17, 0x7f, 0xbd, 0x55, 0x89, 0x6d, 0x31, 0x7a, 0x04, 0x6e, 0x9e, 0x32, 0x46, 0x66, 0x5e,
+	0x55, 0xd3, 0xbc, 0x5d, 0x4e, 0xf3, 0x29, 0x49, 0xe9, 0xae, 0x8c, 0x31, 0xa3, 0x64, 0x45, 0x62,
+	0x4a, 0x83, 0xdf, 0x1d, 0xe8, 0xac, 0x89, 0xa0, 0x37, 0x01, 0xb4, 0xc0, 0x84, 0xe1, 0x6c, 0xf5,
+	0xa9, 0x5b, 0x3a, 0xf2, 0x19, 0xce, 0x08, 0xf2, 0xa1, 0x45, 0x70, 0x31, 0x4f, 0x89, 0x90, 0x5e,
+	0x75, 0xe0, 0x0c, 0x6b, 0xf1, 0xc9, 0x1e, 0xdd, 0x01, 0x77, 0x8e, 0xa5, 0xca, 0xd4, 0x74, 0xc6,
+	0xee, 0xd0, 0x47, 0x50, 0xa7, 0x38, 0x17, 0x5e, 0xfd, 0xfa, 0x7d, 0xea, 0xc2, 0xe0, 0x03, 0x68,
+	0x9f, 0x49, 0xa1, 0x1e, 0x34, 0x84, 0xc4, 0x85, 0xd4, 0xdd, 0xd5, 0x62, 0xb3, 0x41, 0x37, 0xa0,
+	0x46, 0xd8, 0xcc, 0x36, 0xa5, 0x96, 0xc1, 0x57, 0x80, 0x9e, 0xa6, 0xec, 0xdc, 0x9d, 0xa0, 0x8f,
+	0xa1, 0x51, 0x28, 0x1a, 0x5d, 0x7d, 0xcd, 0x76, 0x4c, 0x65, 0x70, 0x1b, 0x6e, 0xad, 0x11, 0x9b,
+	0x8b, 0x0d, 0xbe, 0x86, 0xde, 0x0e, 0xcb, 0x5f, 0x8a, 0xe2, 0x5d, 0xb8, 0x7d, 0x8e, 0xda, 0x6a,
+	0xf6, 0x00, 0x8d, 0xe7, 0x3c, 0x79, 0xfe, 0xf9, 0x82, 0x4b, 0x7c, 0xe2, 0x3b, 0x09, 0xb7, 0xd6,
+	0xa2, 0xd6, 0x79, 0x77, 0xc0, 0xdd, 0xd5, 0x0a, 0xf6, 0xe4, 0xec, 0x4e, 0x39, 0x72, 0x4f, 0x23,
+	0xbd, 0xea, 0x55, 0x8e, 0x7c, 0x22, 0xa8, 0x26, 0xdd, 0x11, 0xf8, 0xd4, 0x4c, 0xa6, 0x38, 0xf8,
+	0xd7, 0x81, 0xce, 0x5a, 0x1e, 0x0d, 0xe0, 0x95, 0x4c, 0xd0, 0x89, 0x5c, 0xe6, 0x64, 0xb2, 0x28,
+	0xe6, 0xd6, 0x4e, 0x90, 0x09, 0xfa, 0xc5, 0x32, 0x27, 0x3b, 0xc5, 0x1c, 0xbd, 0x06, 0xad, 0x0c,
+	0x1f, 0x4c, 0x32, 0x41, 0x85, 0xbe, 0xba, 0x7a, 0xdc, 0xcc, 0xf0, 0xc1, 0x13, 0x41, 0x05, 0xba,
+	0x0f, 0x5d, 0x95, 0xd2, 0x73, 0x47, 0x8a, 0x84, 0x30, 0xe3, 0xab, 0x7a, 0xdc, 0xc9, 0xf0, 0x81,
+	0x9a, 0x3a, 0x13, 0x44, 0x77, 0xa1, 0x69, 0x71, 0x5e, 0x5d, 0xe7, 0x5d, 0x93, 0x47, 0xaf, 0xc3,
+	0x96, 0x4a, 0x4c, 0x97, 0x92, 0x08, 0xaf, 0xa1, 0x53, 0x4a, 0x6c, 0xac, 0xf6, 0x08, 0x41, 0x5d,
+	0x8b, 0xba, 0x3a, 0xae, 0xd7, 0xca, 0x42, 0x8a, 0xa5, 0xa9, 0x43, 0x6a, 0xa9, 0xac, 0x66, 0xca,
+	0x5b, 0x3a, 0x66, 0x36, 0x0f, 0x7f, 0x6d, 0x40, 0xf3, 0x19, 0x29, 0xf6, 0xd3, 0x84, 0xa0, 0x1f,
+	0x1c, 0x70, 0xcd, 0xa3, 0x81, 0x2e, 0x39, 0xb6, 0xb5, 0x77, 0xc6, 0x1f, 0x5e, 0x0d, 0xb4, 0xd7,
+	0x3b, 0xfc, 0xfe, 0x8f, 0x7f, 0x7e, 0xae, 0x06, 0x68, 0x10, 0x95, 0xbe, 0x8f, 0x89, 0x11, 0xff,
+	0xd1, 0x81, 0xd6, 0xc9, 0x10, 0xbf, 0x5b, 0x2e, 0x70, 0xce, 0x9c, 0xfe, 0xf6, 0x26, 0x50, 0xdb,
+	0xcd, 0xb6, 0xee, 0xe6, 0x1e, 0x0a, 0xca, 0xbb, 0xd9, 0x5f, 0xb5, 0xf0, 0x1d, 0xb4, 0xcf, 0xcc,
+	0x08, 0x7a, 0xaf, 0x5c, 0xe6, 0xe2, 0x8c, 0xfa, 0xa3, 0x0d, 0xd1, 0xd6, 0xd7, 0x39, 0x74, 0xd6,
+	0xa6, 0x03, 0x85, 0xe5, 0xf5, 0xff, 0x37, 0xa1, 0x7e, 0xb4, 0x31, 0xde, 0x2a, 0xfe, 0xe2, 0x40,
+	0xfb, 0xcc, 0x84, 0x5d, 0xf6, 0x79, 0x17, 0xc7, 0xd3, 0x1f, 0x6d, 0x88, 0xb6, 0xc7, 0x1e, 0xea,
+	0x63, 0x1f, 0xa2, 0xfb, 0xe5, 0xc7, 0x3e, 0x55, 0x65, 0x13, 0x33, 0x87, 0xe3, 0xc7, 0x2f, 0x8e,
+	0xfa, 0xce, 0xe1, 0x51, 0xdf, 0xf9, 0xfb, 0xa8, 0xef, 0xfc, 0x74, 0xdc, 0xaf, 0x1c, 0x1e, 0xf7,
+	0x2b, 0x7f, 0x1e, 0xf7, 0x2b, 0xdf, 0x8c, 0x68, 0x2a, 0x77, 0x17, 0xd3, 0x30, 0xe1, 0xd9, 0x8a,
+	0xcb, 0xfc, 0x8c, 0xc4, 0xec, 0x79, 0x94, 0xcc, 0x53, 0xc2, 0x64, 0x44, 0x8b, 0x3c, 0xd1, 0xec,
+	0x53, 0x57, 0xff, 0x4f, 0xbe, 0xff, 0xdf, 0x00, 0x30, 0xcf, 0xb6, 0x02, 0x9d, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// Config queries for the operator configuration.
+	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
+	// Versions queries for the heights of the application state available on
+	// disk per store and for the pinned heights.
+	Versions(ctx context.Context, in *VersionsRequest, opts ...grpc.CallOption) (*VersionsResponse, error)
+	// PinVersions prevents a range of heights from being pruned until it is
+	// unpinned. It is only available if enabled in the operator configuration.
+	PinVersions(ctx context.Context, in *PinVersionsRequest, opts ...grpc.CallOption) (*PinVersionsResponse, error)
+	// UnpinVersions removes a range of heights pinned with PinVersions. It is
+	// only available if enabled in the operator configuration.
+	UnpinVersions(ctx context.Context, in *UnpinVersionsRequest, opts ...grpc.CallOption) (*UnpinVersionsResponse, error)
+	// BlockQuotas queries for the usage of the block quotas of the message types
+	// within the block being executed, or the last block executed.
+	BlockQuotas(ctx context.Context, in *BlockQuotasRequest, opts ...grpc.CallOption) (*BlockQuotasResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error) {
+	out := new(ConfigResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.node.v1beta1.Service/Config", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Versions(ctx context.Context, in *VersionsRequest, opts ...grpc.CallOption) (*VersionsResponse, error) {
+	out := new(VersionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.node.v1beta1.Service/Versions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) PinVersions(ctx context.Context, in *PinVersionsRequest, opts ...grpc.CallOption) (*PinVersionsResponse, error) {
+	out := new(PinVersionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.node.v1beta1.Service/PinVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UnpinVersions(ctx context.Context, in *UnpinVersionsRequest, opts ...grpc.CallOption) (*UnpinVersionsResponse, error) {
+	out := new(UnpinVersionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.node.v1beta1.Service/UnpinVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) BlockQuotas(ctx context.Context, in *BlockQuotasRequest, opts ...grpc.CallOption) (*BlockQuotasResponse, error) {
+	out := new(BlockQuotasResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.node.v1beta1.Service/BlockQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// Config queries for the operator configuration.
+	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
+	// Versions queries for the heights of the application state available on
+	// disk per store and for the pinned heights.
+	Versions(context.Context, *VersionsRequest) (*VersionsResponse, error)
+	// PinVersions prevents a range of heights from being pruned until it is
+	// unpinned. It is only available if enabled in the operator configuration.
+	PinVersions(context.Context, *PinVersionsRequest) (*PinVersionsResponse, error)
+	// UnpinVersions removes a range of heights pinned with PinVersions. It is
+	// only available if enabled in the operator configuration.
+	UnpinVersions(context.Context, *UnpinVersionsRequest) (*UnpinVersionsResponse, error)
+	// BlockQuotas queries for the usage of the block quotas of the message types
+	// within the block being executed, or the last block executed.
+	BlockQuotas(context.Context, *BlockQuotasRequest) (*BlockQuotasResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) Config(ctx context.Context, req *ConfigRequest) (*ConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
+}
+func (*UnimplementedServiceServer) Versions(ctx context.Context, req *VersionsRequest) (*VersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Versions not implemented")
+}
+func (*UnimplementedServiceServer) PinVersions(ctx context.Context, req *PinVersionsRequest) (*PinVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinVersions not implemented")
+}
+func (*UnimplementedServiceServer) UnpinVersions(ctx context.Context, req *UnpinVersionsRequest) (*UnpinVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinVersions not implemented")
+}
+func (*UnimplementedServiceServer) BlockQuotas(ctx context.Context, req *BlockQuotasRequest) (*BlockQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockQuotas not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Config(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.node.v1beta1.Service/Config",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Config(ctx, req.(*ConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Versions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Versions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.node.v1beta1.Service/Versions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Versions(ctx, req.(*VersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_PinVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PinVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.node.v1beta1.Service/PinVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PinVersions(ctx, req.(*PinVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UnpinVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UnpinVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.node.v1beta1.Service/UnpinVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UnpinVersions(ctx, req.(*UnpinVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_BlockQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BlockQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.node.v1beta1.Service/BlockQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BlockQuotas(ctx, req.(*BlockQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.node.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Config",
+			Handler:    _Service_Config_Handler,
+		},
+		{
+			MethodName: "Versions",
+			Handler:    _Service_Versions_Handler,
+		},
+		{
+			MethodName: "PinVersions",
+			Handler:    _Service_PinVersions_Handler,
+		},
+		{
+			MethodName: "UnpinVersions",
+			Handler:    _Service_UnpinVersions_Handler,
+		},
+		{
+			MethodName: "BlockQuotas",
+			Handler:    _Service_BlockQuotas_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/node/v1beta1/query.proto",
+}
+
+func (m *ConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinimumGasPrice) > 0 {
+		i -= len(m.MinimumGasPrice)
+		copy(dAtA[i:], m.MinimumGasPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinimumGasPrice)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pinned) > 0 {
+		for iNdEx := len(m.Pinned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pinned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Stores) > 0 {
+		for iNdEx := len(m.Stores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreVersions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreVersions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreVersions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Gaps) > 0 {
+		for iNdEx := len(m.Gaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Latest != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Latest))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Earliest != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Earliest))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StoreName) > 0 {
+		i -= len(m.StoreName)
+		copy(dAtA[i:], m.StoreName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StoreName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeightRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeightRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeightRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PinVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PinVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PinVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Range.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PinVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PinVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PinVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpinVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpinVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpinVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Range.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpinVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpinVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpinVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockQuotasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockQuotasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockQuotasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockQuotasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockQuotasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockQuotasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for iNdEx := len(m.Quotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Quotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgQuotaUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgQuotaUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgQuotaUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Msgs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Msgs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxGasPercent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxGasPercent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxMsgs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxMsgs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MinimumGasPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *VersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stores) > 0 {
+		for _, e := range m.Stores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Pinned) > 0 {
+		for _, e := range m.Pinned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreVersions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Earliest != 0 {
+		n += 1 + sovQuery(uint64(m.Earliest))
+	}
+	if m.Latest != 0 {
+		n += 1 + sovQuery(uint64(m.Latest))
+	}
+	if len(m.Gaps) > 0 {
+		for _, e := range m.Gaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HeightRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovQuery(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovQuery(uint64(m.End))
+	}
+	return n
+}
+
+func (m *PinVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Range.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PinVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnpinVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Range.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *UnpinVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BlockQuotasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BlockQuotasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if len(m.Quotas) > 0 {
+		for _, e := range m.Quotas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgQuotaUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxMsgs != 0 {
+		n += 1 + sovQuery(uint64(m.MaxMsgs))
+	}
+	if m.MaxGasPercent != 0 {
+		n += 1 + sovQuery(uint64(m.MaxGasPercent))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.MaxGas))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovQuery(uint64(m.MaxBytes))
+	}
+	if m.Msgs != 0 {
+		n += 1 + sovQuery(uint64(m.Msgs))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovQuery(uint64(m.Bytes))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, StoreVersions{})
+			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pinned = append(m.Pinned, HeightRange{})
+			if err := m.Pinned[len(m.Pinned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreVersions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreVersions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreVersions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earliest", wireType)
+			}
+			m.Earliest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Earliest |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latest", wireType)
+			}
+			m.Latest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Latest |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gaps = append(m.Gaps, HeightRange{})
+			if err := m.Gaps[len(m.Gaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeightRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeightRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeightRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PinVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PinVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PinVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PinVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PinVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PinVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpinVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpinVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpinVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpinVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpinVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpinVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *ConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BlockQuotasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockQuotasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockQuotasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *ConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MinimumGasPrice)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *BlockQuotasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -338,12 +2556,65 @@ func (m *ConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockQuotasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockQuotasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quotas = append(m.Quotas, MsgQuotaUsage{})
+			if err := m.Quotas[len(m.Quotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -365,7 +2636,7 @@ func (m *ConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgQuotaUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -388,15 +2659,15 @@ func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgQuotaUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgQuotaUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -424,8 +2695,141 @@ func (m *ConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinimumGasPrice = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgs", wireType)
+			}
+			m.MaxMsgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPercent", wireType)
+			}
+			m.MaxGasPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPercent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			m.Msgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Msgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
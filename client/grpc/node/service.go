@@ -2,17 +2,58 @@ package node
 
 import (
 	context "context"
+	"sort"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// VersionManager lists the versions of the application state available on
+// disk and pins versions so that they are not pruned. It is implemented by the
+// rootmulti.Store.
+type VersionManager interface {
+	ListVersions() map[string][]pruningtypes.HeightRange
+	PinnedVersions() []pruningtypes.HeightRange
+	PinVersions(r pruningtypes.HeightRange) error
+	UnpinVersions(r pruningtypes.HeightRange) error
+}
+
+// BlockQuotaTracker reports the usage of the block quotas of the message
+// types. It is implemented by the BaseApp.
+type BlockQuotaTracker interface {
+	BlockQuotaUsage() (int64, []baseapp.MsgQuotaUsage)
+}
+
+// Option defines an option of the node gRPC service.
+type Option func(*queryServer)
+
+// WithVersionManager enables the Versions query of the node gRPC service.
+func WithVersionManager(versions VersionManager) Option {
+	return func(s *queryServer) { s.versions = versions }
+}
+
+// WithVersionPinning enables the PinVersions and UnpinVersions methods of the
+// node gRPC service. Anyone able to query the node can then prevent heights
+// from being pruned, it should only be enabled on nodes that are not public.
+func WithVersionPinning() Option {
+	return func(s *queryServer) { s.pinning = true }
+}
+
+// WithBlockQuotas enables the BlockQuotas query of the node gRPC service.
+func WithBlockQuotas(quotas BlockQuotaTracker) Option {
+	return func(s *queryServer) { s.quotas = quotas }
+}
+
 // RegisterNodeService registers the node gRPC service on the provided gRPC router.
-func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server) {
-	RegisterServiceServer(server, NewQueryServer(clientCtx))
+func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, opts ...Option) {
+	RegisterServiceServer(server, NewQueryServer(clientCtx, opts...))
 }
 
 // RegisterGRPCGatewayRoutes mounts the node gRPC service's GRPC-gateway routes
@@ -25,12 +66,21 @@ var _ ServiceServer = queryServer{}
 
 type queryServer struct {
 	clientCtx client.Context
+	versions  VersionManager
+	pinning   bool
+	quotas    BlockQuotaTracker
 }
 
-func NewQueryServer(clientCtx client.Context) ServiceServer {
-	return queryServer{
+func NewQueryServer(clientCtx client.Context, opts ...Option) ServiceServer {
+	s := queryServer{
 		clientCtx: clientCtx,
 	}
+
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	return s
 }
 
 func (s queryServer) Config(ctx context.Context, _ *ConfigRequest) (*ConfigResponse, error) {
@@ -40,3 +90,114 @@ func (s queryServer) Config(ctx context.Context, _ *ConfigRequest) (*ConfigRespo
 		MinimumGasPrice: sdkCtx.MinGasPrices().String(),
 	}, nil
 }
+
+func (s queryServer) Versions(_ context.Context, _ *VersionsRequest) (*VersionsResponse, error) {
+	if s.versions == nil {
+		return nil, status.Error(codes.Unimplemented, "versions are not available on this node")
+	}
+
+	versions := s.versions.ListVersions()
+	storeNames := make([]string, 0, len(versions))
+	for storeName := range versions {
+		storeNames = append(storeNames, storeName)
+	}
+	sort.Strings(storeNames)
+
+	resp := &VersionsResponse{
+		Stores: make([]StoreVersions, 0, len(storeNames)),
+		Pinned: toHeightRanges(s.versions.PinnedVersions()),
+	}
+	for _, storeName := range storeNames {
+		ranges := versions[storeName]
+
+		storeVersions := StoreVersions{StoreName: storeName}
+		if len(ranges) > 0 {
+			storeVersions.Earliest = int64(ranges[0].Start)
+			storeVersions.Latest = int64(ranges[len(ranges)-1].End)
+		}
+		for i := 1; i < len(ranges); i++ {
+			storeVersions.Gaps = append(storeVersions.Gaps, HeightRange{
+				Start: int64(ranges[i-1].End) + 1,
+				End:   int64(ranges[i].Start) - 1,
+			})
+		}
+
+		resp.Stores = append(resp.Stores, storeVersions)
+	}
+
+	return resp, nil
+}
+
+func (s queryServer) PinVersions(_ context.Context, req *PinVersionsRequest) (*PinVersionsResponse, error) {
+	r, err := s.pinnedRange(req.Range)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.versions.PinVersions(r); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &PinVersionsResponse{}, nil
+}
+
+func (s queryServer) UnpinVersions(_ context.Context, req *UnpinVersionsRequest) (*UnpinVersionsResponse, error) {
+	r, err := s.pinnedRange(req.Range)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.versions.UnpinVersions(r); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &UnpinVersionsResponse{}, nil
+}
+
+func (s queryServer) BlockQuotas(_ context.Context, _ *BlockQuotasRequest) (*BlockQuotasResponse, error) {
+	if s.quotas == nil {
+		return nil, status.Error(codes.Unimplemented, "block quotas are not available on this node")
+	}
+
+	height, usages := s.quotas.BlockQuotaUsage()
+
+	resp := &BlockQuotasResponse{
+		Height: height,
+		Quotas: make([]MsgQuotaUsage, len(usages)),
+	}
+	for i, usage := range usages {
+		resp.Quotas[i] = MsgQuotaUsage{
+			MsgTypeUrl:    usage.MsgTypeURL,
+			MaxMsgs:       usage.MaxMsgs,
+			MaxGasPercent: usage.MaxGasPercent,
+			MaxGas:        usage.MaxGas,
+			MaxBytes:      usage.MaxBytes,
+			Msgs:          usage.Msgs,
+			Gas:           usage.Gas,
+			Bytes:         usage.Bytes,
+		}
+	}
+
+	return resp, nil
+}
+
+// pinnedRange checks that version pinning is enabled and that the range is valid.
+func (s queryServer) pinnedRange(r HeightRange) (pruningtypes.HeightRange, error) {
+	if s.versions == nil || !s.pinning {
+		return pruningtypes.HeightRange{}, status.Error(codes.PermissionDenied, "version pinning is not enabled on this node")
+	}
+
+	if r.Start <= 0 || r.Start > r.End {
+		return pruningtypes.HeightRange{}, status.Errorf(codes.InvalidArgument, "invalid height range %d-%d", r.Start, r.End)
+	}
+
+	return pruningtypes.HeightRange{Start: uint64(r.Start), End: uint64(r.End)}, nil
+}
+
+func toHeightRanges(ranges []pruningtypes.HeightRange) []HeightRange {
+	res := make([]HeightRange, len(ranges))
+	for i, r := range ranges {
+		res[i] = HeightRange{Start: int64(r.Start), End: int64(r.End)}
+	}
+	return res
+}
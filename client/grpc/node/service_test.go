@@ -1,11 +1,17 @@
 package node
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -19,3 +25,123 @@ func TestServiceServer_Config(t *testing.T) {
 	require.NotNil(t, resp)
 	require.Equal(t, ctx.MinGasPrices().String(), resp.MinimumGasPrice)
 }
+
+type mockVersionManager struct {
+	versions map[string][]pruningtypes.HeightRange
+	pinned   []pruningtypes.HeightRange
+}
+
+func (m *mockVersionManager) ListVersions() map[string][]pruningtypes.HeightRange {
+	return m.versions
+}
+
+func (m *mockVersionManager) PinnedVersions() []pruningtypes.HeightRange {
+	return m.pinned
+}
+
+func (m *mockVersionManager) PinVersions(r pruningtypes.HeightRange) error {
+	m.pinned = append(m.pinned, r)
+	return nil
+}
+
+func (m *mockVersionManager) UnpinVersions(r pruningtypes.HeightRange) error {
+	for i, pinned := range m.pinned {
+		if pinned == r {
+			m.pinned = append(m.pinned[:i], m.pinned[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("height range %s is not pinned", r)
+}
+
+func TestServiceServer_Versions(t *testing.T) {
+	_, err := NewQueryServer(client.Context{}).Versions(context.Background(), &VersionsRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	versions := &mockVersionManager{
+		versions: map[string][]pruningtypes.HeightRange{
+			"store2": {{Start: 10, End: 10}, {Start: 20, End: 20}, {Start: 25, End: 30}},
+			"store1": {{Start: 5, End: 30}},
+		},
+		pinned: []pruningtypes.HeightRange{{Start: 25, End: 26}},
+	}
+	svr := NewQueryServer(client.Context{}, WithVersionManager(versions))
+
+	resp, err := svr.Versions(context.Background(), &VersionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &VersionsResponse{
+		Stores: []StoreVersions{
+			{StoreName: "store1", Earliest: 5, Latest: 30},
+			{StoreName: "store2", Earliest: 10, Latest: 30, Gaps: []HeightRange{{Start: 11, End: 19}, {Start: 21, End: 24}}},
+		},
+		Pinned: []HeightRange{{Start: 25, End: 26}},
+	}, resp)
+}
+
+func TestServiceServer_PinVersions(t *testing.T) {
+	versions := &mockVersionManager{}
+
+	svr := NewQueryServer(client.Context{}, WithVersionManager(versions))
+	_, err := svr.PinVersions(context.Background(), &PinVersionsRequest{Range: HeightRange{Start: 1, End: 2}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	svr = NewQueryServer(client.Context{}, WithVersionManager(versions), WithVersionPinning())
+	_, err = svr.PinVersions(context.Background(), &PinVersionsRequest{Range: HeightRange{Start: 3, End: 2}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = svr.PinVersions(context.Background(), &PinVersionsRequest{Range: HeightRange{Start: 1, End: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []pruningtypes.HeightRange{{Start: 1, End: 2}}, versions.pinned)
+
+	_, err = svr.UnpinVersions(context.Background(), &UnpinVersionsRequest{Range: HeightRange{Start: 1, End: 3}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = svr.UnpinVersions(context.Background(), &UnpinVersionsRequest{Range: HeightRange{Start: 1, End: 2}})
+	require.NoError(t, err)
+	require.Empty(t, versions.pinned)
+}
+
+type mockBlockQuotaTracker struct {
+	height int64
+	usage  []baseapp.MsgQuotaUsage
+}
+
+func (m mockBlockQuotaTracker) BlockQuotaUsage() (int64, []baseapp.MsgQuotaUsage) {
+	return m.height, m.usage
+}
+
+func TestServiceServer_BlockQuotas(t *testing.T) {
+	_, err := NewQueryServer(client.Context{}).BlockQuotas(context.Background(), &BlockQuotasRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	tracker := mockBlockQuotaTracker{
+		height: 7,
+		usage: []baseapp.MsgQuotaUsage{
+			{
+				MsgQuota: baseapp.MsgQuota{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend", MaxMsgs: 10, MaxGasPercent: 20, MaxBytes: 1000},
+				MaxGas:   200,
+				Msgs:     2,
+				Gas:      50,
+				Bytes:    300,
+			},
+		},
+	}
+
+	resp, err := NewQueryServer(client.Context{}, WithBlockQuotas(tracker)).BlockQuotas(context.Background(), &BlockQuotasRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &BlockQuotasResponse{
+		Height: 7,
+		Quotas: []MsgQuotaUsage{
+			{
+				MsgTypeUrl:    "/cosmos.bank.v1beta1.MsgSend",
+				MaxMsgs:       10,
+				MaxGasPercent: 20,
+				MaxGas:        200,
+				MaxBytes:      1000,
+				Msgs:          2,
+				Gas:           50,
+				Bytes:         300,
+			},
+		},
+	}, resp)
+}
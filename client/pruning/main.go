@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -20,13 +21,14 @@ import (
 const FlagAppDBBackend = "app-db-backend"
 
 // PruningCmd prunes the sdk root multi store history versions based on the pruning options
-// specified by command flags.
+// specified by command flags, or by the app.toml of the home directory if the flags are not set.
 func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "prune",
 		Short: "Prune app history states by keeping the recent heights and deleting old heights",
 		Long: `Prune app history states by keeping the recent heights and deleting old heights.
-		The pruning option is provided via the '--pruning' flag or alternatively with '--pruning-keep-recent'
+		The pruning option is provided via the '--pruning' flag or alternatively with '--pruning-keep-recent'.
+		Flags that are not set are read from the app.toml of the home directory if it exists.
 		
 		For '--pruning' the options are as follows:
 		
@@ -34,6 +36,8 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 		nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 		everything: 2 latest states will be kept
 		custom: allow pruning options to be manually specified through 'pruning-keep-recent'.
+		the retention rules '--pruning-keep-every', '--pruning-keep-ranges' and '--pruning-keep-until' are
+		honored as well.
 		besides pruning options, database home directory and database backend type should also be specified via flags
 		'--home' and '--app-db-backend'.
 		valid app-db-backend type includes 'goleveldb', 'cleveldb', 'rocksdb', 'boltdb', and 'badgerdb'.
@@ -46,16 +50,36 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 			if err := vp.BindPFlags(cmd.Flags()); err != nil {
 				return err
 			}
+
+			home := vp.GetString(flags.FlagHome)
+			appConfig := filepath.Join(home, "config", "app.toml")
+			if _, err := os.Stat(appConfig); err == nil {
+				vp.SetConfigFile(appConfig)
+				if err := vp.ReadInConfig(); err != nil {
+					return fmt.Errorf("failed to read %s: %w", appConfig, err)
+				}
+			}
+
 			pruningOptions, err := server.GetPruningOptionsFromFlags(vp)
 			if err != nil {
 				return err
 			}
-			fmt.Printf("get pruning options from command flags, strategy: %v, keep-recent: %v\n",
+			fmt.Printf("get pruning options, strategy: %v, keep-recent: %v, keep-every: %v, keep-ranges: %v, keep-until: %v\n",
 				pruningOptions.Strategy,
 				pruningOptions.KeepRecent,
+				pruningOptions.KeepEvery,
+				pruningOptions.KeepRanges,
+				pruningOptions.KeepUntil,
 			)
+			if pruningOptions.GetPruningStrategy() == pruningtypes.PruningNothing {
+				fmt.Printf("pruning strategy is nothing, no heights to prune\n")
+				return nil
+			}
+			if pruningOptions.IsHeld(time.Now()) {
+				fmt.Printf("pruning is held until %v, no heights to prune\n", pruningOptions.KeepUntil)
+				return nil
+			}
 
-			home := vp.GetString(flags.FlagHome)
 			db, err := openDB(home, server.GetAppDBBackend(vp))
 			if err != nil {
 				return err
@@ -75,12 +99,7 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 				return fmt.Errorf("the database has no valid heights to prune, the latest height: %v", latestHeight)
 			}
 
-			var pruningHeights []int64
-			for height := int64(1); height < latestHeight; height++ {
-				if height < latestHeight-int64(pruningOptions.KeepRecent) {
-					pruningHeights = append(pruningHeights, height)
-				}
-			}
+			pruningHeights := getPruningHeights(pruningOptions, latestHeight, getAvailableVersions(rootMultiStore))
 			if len(pruningHeights) == 0 {
 				fmt.Printf("no heights to prune\n")
 				return nil
@@ -95,7 +114,10 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			fmt.Printf("successfully pruned the application root multi stores\n")
+			fmt.Printf("successfully pruned the application root multi stores, reclaimed %d versions: %v\n",
+				len(pruningHeights),
+				pruningtypes.NewHeightRanges(pruningHeights),
+			)
 			return nil
 		},
 	}
@@ -107,10 +129,42 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 	cmd.Flags().Uint64(server.FlagPruningInterval, 10,
 		`Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom'), 
 		this is not used by this command but kept for compatibility with the complete pruning options`)
+	cmd.Flags().Uint64(server.FlagPruningKeepEvery, 0, "Never prune heights that are a multiple of this value")
+	cmd.Flags().String(server.FlagPruningKeepRanges, "", "Comma separated inclusive height ranges never pruned, e.g. 1000-2000,5000-5000")
+	cmd.Flags().String(server.FlagPruningKeepUntil, "", "RFC3339 time before which no height is pruned")
 
 	return cmd
 }
 
+// getAvailableVersions returns the versions available in any of the stores of
+// the root multi store that keep a history of versions.
+func getAvailableVersions(rs *rootmulti.Store) map[int64]bool {
+	versions := make(map[int64]bool)
+	for _, key := range rs.StoreKeysByName() {
+		store, ok := rs.GetCommitKVStore(key).(interface{ GetAllVersions() []int })
+		if !ok {
+			continue
+		}
+
+		for _, v := range store.GetAllVersions() {
+			versions[int64(v)] = true
+		}
+	}
+	return versions
+}
+
+// getPruningHeights returns the available heights below latestHeight that must
+// be pruned according to the pruning options, in increasing order.
+func getPruningHeights(opts pruningtypes.PruningOptions, latestHeight int64, available map[int64]bool) []int64 {
+	var heights []int64
+	for height := int64(1); height < latestHeight-int64(opts.KeepRecent); height++ {
+		if available[height] && !opts.IsRetained(height) {
+			heights = append(heights, height)
+		}
+	}
+	return heights
+}
+
 func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("application", backendType, dataDir)
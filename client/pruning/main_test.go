@@ -0,0 +1,25 @@
+package pruning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+)
+
+func TestGetPruningHeights(t *testing.T) {
+	opts := pruningtypes.NewCustomPruningOptions(2, 10)
+	opts.KeepEvery = 4
+	opts.KeepRanges = []pruningtypes.HeightRange{{Start: 6, End: 6}}
+
+	available := make(map[int64]bool)
+	for h := int64(2); h <= 12; h++ {
+		available[h] = true
+	}
+
+	heights := getPruningHeights(opts, 12, available)
+	require.Equal(t, []int64{2, 3, 5, 7, 9}, heights)
+
+	require.Empty(t, getPruningHeights(opts, 3, available))
+}
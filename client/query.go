@@ -14,6 +14,7 @@ import (
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -49,6 +50,60 @@ func (ctx Context) QueryStore(key tmbytes.HexBytes, storeName string) ([]byte, i
 	return ctx.queryStore(key, storeName, "key")
 }
 
+// QueryStoreKeysWithProof queries the values of keys from several stores at the
+// same height, the context height or the last height whose app hash is known
+// by default. The combined proof of the values is verified against the app hash
+// of the header of the next block, as returned by the node: use a node whose
+// headers are verified, e.g. through a light client proxy, to trust the values.
+func (ctx Context) QueryStoreKeysWithProof(keys []storetypes.StoreKeyQuery) (storetypes.BatchKeysResult, int64, error) {
+	node, err := ctx.GetNode()
+	if err != nil {
+		return storetypes.BatchKeysResult{}, 0, err
+	}
+
+	height := ctx.Height
+	if height == 0 {
+		status, err := node.Status(context.Background())
+		if err != nil {
+			return storetypes.BatchKeysResult{}, 0, err
+		}
+		// the app hash of the latest block is only known from the next block
+		height = status.SyncInfo.LatestBlockHeight - 1
+	}
+
+	data, err := (&storetypes.BatchKeysQuery{Keys: keys}).Marshal()
+	if err != nil {
+		return storetypes.BatchKeysResult{}, 0, err
+	}
+
+	resp, err := ctx.queryABCI(abci.RequestQuery{
+		Path:   "/store" + rootmulti.QueryPathBatchKeys,
+		Data:   data,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return storetypes.BatchKeysResult{}, 0, err
+	}
+
+	var result storetypes.BatchKeysResult
+	if err := result.Unmarshal(resp.Value); err != nil {
+		return storetypes.BatchKeysResult{}, 0, err
+	}
+
+	nextHeight := resp.Height + 1
+	commit, err := node.Commit(context.Background(), &nextHeight)
+	if err != nil {
+		return storetypes.BatchKeysResult{}, 0, fmt.Errorf("failed to get the app hash of height %d: %w", resp.Height, err)
+	}
+
+	if err := rootmulti.VerifyBatchKeysProof(commit.AppHash, keys, result, resp.ProofOps); err != nil {
+		return storetypes.BatchKeysResult{}, 0, err
+	}
+
+	return result, resp.Height, nil
+}
+
 // QueryABCI performs a query to a Tendermint node with the provide RequestQuery.
 // It returns the ResultQuery obtained from the query. The height used to perform
 // the query is the RequestQuery Height if it is non-zero, otherwise the context
@@ -0,0 +1,66 @@
+package snapshot
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+)
+
+func makeChunks(chunks [][]byte) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	return ch
+}
+
+func setupStore(t *testing.T) *snapshots.Store {
+	store, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	return store
+}
+
+func TestDumpLoadArchive(t *testing.T) {
+	chunks := [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+
+	source := setupStore(t)
+	snapshot, err := source.Save(3, 2, makeChunks(chunks))
+	require.NoError(t, err)
+
+	archive := filepath.Join(t.TempDir(), "3-2.tar.gz")
+	require.NoError(t, dumpArchive(source, 3, 2, archive))
+
+	// missing snapshot
+	require.Error(t, dumpArchive(source, 4, 2, filepath.Join(t.TempDir(), "4-2.tar.gz")))
+
+	target := setupStore(t)
+	loaded, err := loadArchive(target, archive)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, loaded)
+
+	stored, chunksCh, err := target.Load(3, 2)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, stored)
+	for _, expected := range chunks {
+		chunk := <-chunksCh
+		bz, err := io.ReadAll(chunk)
+		require.NoError(t, err)
+		require.Equal(t, expected, bz)
+		require.NoError(t, chunk.Close())
+	}
+
+	// loading the same archive twice fails
+	_, err = loadArchive(target, archive)
+	require.Error(t, err)
+
+	// not an archive
+	_, err = loadArchive(target, filepath.Join(t.TempDir(), "missing.tar.gz"))
+	require.Error(t, err)
+}
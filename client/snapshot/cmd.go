@@ -0,0 +1,40 @@
+package snapshot
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+)
+
+// Cmd returns the snapshots group command
+func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local snapshots",
+		Long:  "Manage local snapshots",
+	}
+	cmd.AddCommand(
+		ListSnapshotsCmd,
+		RestoreSnapshotCmd(appCreator),
+		ExportSnapshotCmd(appCreator),
+		DumpArchiveCmd(),
+		LoadArchiveCmd(),
+		DeleteSnapshotCmd(),
+	)
+	return cmd
+}
+
+// snapshotApp is implemented by the apps built on BaseApp.
+type snapshotApp interface {
+	servertypes.Application
+	SnapshotManager() *snapshots.Manager
+}
+
+func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("application", backendType, dataDir)
+}
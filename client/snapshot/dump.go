@@ -0,0 +1,146 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+)
+
+const flagOutput = "output"
+
+// SnapshotFileName is the name of the snapshot metadata entry of an archive.
+const SnapshotFileName = "snapshot"
+
+// DumpArchiveCmd returns a command to dump the snapshot as portable archive format
+func DumpArchiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump <height> <format>",
+		Short: "Dump the snapshot as portable archive format",
+		Long: `Dump the snapshot as portable archive format.
+
+The archive is a gzip compressed tar file containing the snapshot metadata in a
+"snapshot" entry followed by one entry per chunk, named after the chunk index.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			format, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
+			}
+
+			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+
+			return dumpArchive(snapshotStore, height, uint32(format), output)
+		},
+	}
+
+	cmd.Flags().StringP(flagOutput, "o", "", "output file, default to <height>-<format>.tar.gz")
+
+	return cmd
+}
+
+func dumpArchive(snapshotStore *snapshots.Store, height uint64, format uint32, output string) (err error) {
+	snapshot, err := snapshotStore.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
+	}
+
+	bz, err := snapshot.Marshal()
+	if err != nil {
+		return err
+	}
+
+	fp, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := fp.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	// since the chunks are already compressed, we just use fastest compression here
+	gzipWriter, err := gzip.NewWriterLevel(fp, gzip.BestSpeed)
+	if err != nil {
+		return err
+	}
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	if err := writeArchiveEntry(tarWriter, SnapshotFileName, bz); err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := snapshotStore.LoadChunk(height, format, i)
+		if err != nil {
+			return err
+		}
+		if chunk == nil {
+			return fmt.Errorf("snapshot chunk %d is missing", i)
+		}
+
+		bz, err := io.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return err
+		}
+
+		if err := writeArchiveEntry(tarWriter, strconv.FormatUint(uint64(i), 10), bz); err != nil {
+			return err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("failed to close tar writer: %w", err)
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		return fmt.Errorf("failed to close gzip writer: %w", err)
+	}
+
+	return nil
+}
+
+func writeArchiveEntry(tarWriter *tar.Writer, name string, bz []byte) error {
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0o644,
+		Size: int64(len(bz)),
+	}); err != nil {
+		return fmt.Errorf("failed to write %s header: %w", name, err)
+	}
+
+	if _, err := tarWriter.Write(bz); err != nil {
+		return fmt.Errorf("failed to write %s body: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+const chunkBufferSize = 4
+
+// LoadArchiveCmd returns a command to load a snapshot archive file into snapshot store
+func LoadArchiveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "load <archive-file>",
+		Short: "Load a snapshot archive file into snapshot store",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+
+			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := loadArchive(snapshotStore, args[0])
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot loaded at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+}
+
+func loadArchive(snapshotStore *snapshots.Store, path string) (*types.Snapshot, error) {
+	fp, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive file: %w", err)
+	}
+	defer fp.Close()
+
+	reader, err := gzip.NewReader(fp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gzip reader: %w", err)
+	}
+	archive := tar.NewReader(reader)
+
+	hdr, err := archive.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot file header: %w", err)
+	}
+	if hdr.Name != SnapshotFileName {
+		return nil, fmt.Errorf("invalid archive, expect file: %s, got: %s", SnapshotFileName, hdr.Name)
+	}
+
+	bz, err := io.ReadAll(archive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot file: %w", err)
+	}
+
+	var snapshot types.Snapshot
+	if err := snapshot.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+
+	chunks := make(chan io.ReadCloser, chunkBufferSize)
+	type saveResult struct {
+		snapshot *types.Snapshot
+		err      error
+	}
+	chSaved := make(chan saveResult, 1)
+	go func() {
+		saved, err := snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
+		chSaved <- saveResult{saved, err}
+	}()
+
+	err = feedArchiveChunks(archive, snapshot.Chunks, chunks)
+	close(chunks)
+	result := <-chSaved
+
+	if err != nil {
+		if result.err == nil {
+			_ = snapshotStore.Delete(snapshot.Height, snapshot.Format)
+		}
+		return nil, err
+	}
+	if result.err != nil {
+		return nil, fmt.Errorf("failed to save snapshot: %w", result.err)
+	}
+
+	// the chunk hashes are recomputed by the store, hence the archive is
+	// rejected if its content does not match the metadata
+	if !reflect.DeepEqual(&snapshot, result.snapshot) {
+		_ = snapshotStore.Delete(snapshot.Height, snapshot.Format)
+		return nil, fmt.Errorf("invalid archive, the saved snapshot is not equal to the original one")
+	}
+
+	return result.snapshot, nil
+}
+
+// feedArchiveChunks reads the chunk entries of the archive into chunks.
+func feedArchiveChunks(archive *tar.Reader, count uint32, chunks chan<- io.ReadCloser) error {
+	for i := uint32(0); i < count; i++ {
+		hdr, err := archive.Next()
+		if err != nil {
+			return fmt.Errorf("failed to read chunk file header: %w", err)
+		}
+		if hdr.Name != strconv.FormatUint(uint64(i), 10) {
+			return fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
+		}
+
+		bz, err := io.ReadAll(archive)
+		if err != nil {
+			return fmt.Errorf("failed to read chunk file: %w", err)
+		}
+
+		chunks <- io.NopCloser(bytes.NewReader(bz))
+	}
+
+	return nil
+}
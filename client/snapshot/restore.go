@@ -0,0 +1,54 @@
+package snapshot
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// RestoreSnapshotCmd returns a command to restore a snapshot
+func RestoreSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <height> <format>",
+		Short: "Restore app state from local snapshot",
+		Long: `Restore app state from local snapshot.
+
+The application database must be empty, e.g. on a newly initialized node. Only the
+application state is restored, Tendermint still needs to sync the blocks from
+the snapshot height onwards.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			format, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(ctx.Config.RootDir, server.GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+
+			app, ok := appCreator(ctx.Logger, db, nil, ctx.Viper).(snapshotApp)
+			if !ok {
+				return fmt.Errorf("the application does not support snapshots")
+			}
+
+			if err := app.SnapshotManager().RestoreLocalSnapshot(height, uint32(format)); err != nil {
+				return err
+			}
+
+			cmd.Printf("Restored app state from snapshot at height %d, format %d\n", height, format)
+			return nil
+		},
+	}
+}
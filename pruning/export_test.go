@@ -1,11 +1,20 @@
 package pruning
 
+import "time"
+
 var (
 	PruneHeightsKey         = pruneHeightsKey
 	PruneSnapshotHeightsKey = pruneSnapshotHeightsKey
+	PruneHeldFromHeightKey  = pruneHeldFromHeightKey
 
 	Int64SliceToBytes          = int64SliceToBytes
 	ListToBytes                = listToBytes
 	LoadPruningHeights         = loadPruningHeights
 	LoadPruningSnapshotHeights = loadPruningSnapshotHeights
+	LoadPruningHeldFromHeight  = loadPruningHeldFromHeight
 )
+
+// SetClock overrides the clock used to evaluate the KeepUntil retention rule.
+func (m *Manager) SetClock(now func() time.Time) {
+	m.now = now
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
@@ -24,6 +25,14 @@ type Manager struct {
 	// we sync access to them to avoid soundness issues in the future if concurrency pattern changes.
 	pruneHeightsMx sync.Mutex
 	pruneHeights   []int64
+	// heldFromHeight is the first height that was not pruned because of the KeepUntil
+	// retention rule, 0 if pruning is not held. It is guarded by pruneHeightsMx.
+	heldFromHeight int64
+	// now returns the current time, used to evaluate the KeepUntil retention rule.
+	now func() time.Time
+	// pinnedHeights are the ranges of heights that are not pruned until unpinned, e.g. while
+	// an indexer is reading them. They are guarded by pruneHeightsMx.
+	pinnedHeights []types.HeightRange
 	// Snapshots are taken in a separate goroutine from the regular execution
 	// and can be delivered asynchrounously via HandleHeightSnapshot.
 	// Therefore, we sync access to pruneSnapshotHeights with this mutex.
@@ -47,6 +56,8 @@ func (e *NegativeHeightsError) Error() string {
 var (
 	pruneHeightsKey         = []byte("s/pruneheights")
 	pruneSnapshotHeightsKey = []byte("s/prunesnapshotheights")
+	pruneHeldFromHeightKey  = []byte("s/pruneheldfromheight")
+	prunePinnedHeightsKey   = []byte("s/prunepinnedheights")
 )
 
 // NewManager returns a new Manager with the given db and logger.
@@ -60,6 +71,7 @@ func NewManager(db dbm.DB, logger log.Logger) *Manager {
 		opts:                 types.NewPruningOptions(types.PruningNothing),
 		pruneHeights:         []int64{},
 		pruneSnapshotHeights: list.New(),
+		now:                  time.Now,
 	}
 }
 
@@ -87,23 +99,91 @@ func (m *Manager) GetFlushAndResetPruningHeights() ([]int64, error) {
 		return nil, err
 	}
 
-	// Return a copy to prevent data races.
-	pruningHeights := make([]int64, len(m.pruneHeights))
-	copy(pruningHeights, m.pruneHeights)
-	m.pruneHeights = m.pruneHeights[:0]
+	// Return a copy to prevent data races. Pinned heights are kept to be pruned once unpinned.
+	pruningHeights := make([]int64, 0, len(m.pruneHeights))
+	pinnedHeights := m.pruneHeights[:0]
+	for _, h := range m.pruneHeights {
+		if m.isPinned(h) {
+			pinnedHeights = append(pinnedHeights, h)
+		} else {
+			pruningHeights = append(pruningHeights, h)
+		}
+	}
+	m.pruneHeights = pinnedHeights
 
 	return pruningHeights, nil
 }
 
+// PinHeights prevents the heights of the given range from being pruned until
+// the range is unpinned with UnpinHeights. Flushes the update to disk.
+func (m *Manager) PinHeights(r types.HeightRange) error {
+	if r.Start == 0 || r.Start > r.End {
+		return fmt.Errorf("invalid height range %s", r)
+	}
+
+	m.pruneHeightsMx.Lock()
+	defer m.pruneHeightsMx.Unlock()
+
+	m.pinnedHeights = append(m.pinnedHeights, r)
+
+	return m.db.SetSync(prunePinnedHeightsKey, heightRangesToBytes(m.pinnedHeights))
+}
+
+// UnpinHeights removes a range previously pinned with PinHeights. The heights
+// that are no longer pinned are pruned at the next pruning interval.
+// Flushes the update to disk.
+func (m *Manager) UnpinHeights(r types.HeightRange) error {
+	m.pruneHeightsMx.Lock()
+	defer m.pruneHeightsMx.Unlock()
+
+	for i, pinned := range m.pinnedHeights {
+		if pinned == r {
+			m.pinnedHeights = append(m.pinnedHeights[:i], m.pinnedHeights[i+1:]...)
+			return m.db.SetSync(prunePinnedHeightsKey, heightRangesToBytes(m.pinnedHeights))
+		}
+	}
+
+	return fmt.Errorf("height range %s is not pinned", r)
+}
+
+// GetPinnedHeights returns the ranges of heights pinned with PinHeights.
+func (m *Manager) GetPinnedHeights() []types.HeightRange {
+	m.pruneHeightsMx.Lock()
+	defer m.pruneHeightsMx.Unlock()
+
+	pinnedHeights := make([]types.HeightRange, len(m.pinnedHeights))
+	copy(pinnedHeights, m.pinnedHeights)
+
+	return pinnedHeights
+}
+
+// isPinned returns true if the height is in a pinned range. pruneHeightsMx must be held.
+func (m *Manager) isPinned(height int64) bool {
+	for _, r := range m.pinnedHeights {
+		if uint64(height) >= r.Start && uint64(height) <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
 // HandleHeight determines if previousHeight height needs to be kept for pruning at the right interval prescribed by
 // the pruning strategy. Returns previousHeight, if it was kept to be pruned at the next call to Prune(), 0 otherwise.
 // previousHeight must be greater than 0 for the handling to take effect since valid heights start at 1 and 0 represents
 // the latest height. The latest height cannot be pruned. As a result, if previousHeight is less than or equal to 0, 0 is returned.
+//
+// Heights retained by the KeepEvery and KeepRanges rules are never kept for pruning. While the KeepUntil time is not
+// reached no height is kept for pruning; the heights skipped in the meantime are kept for pruning once it is reached.
 func (m *Manager) HandleHeight(previousHeight int64) int64 {
 	if m.opts.GetPruningStrategy() == types.PruningNothing || previousHeight <= 0 {
 		return 0
 	}
 
+	held := m.opts.IsHeld(m.now())
+	m.pruneHeightsMx.Lock()
+	heldFromHeight := m.heldFromHeight
+	m.pruneHeightsMx.Unlock()
+
 	defer func() {
 		m.pruneHeightsMx.Lock()
 		defer m.pruneHeightsMx.Unlock()
@@ -115,35 +195,54 @@ func (m *Manager) HandleHeight(previousHeight int64) int64 {
 		// represent the heights to be pruned at the next pruning interval.
 		var next *list.Element
 		for e := m.pruneSnapshotHeights.Front(); e != nil; e = next {
+			next = e.Next()
+
 			snHeight := e.Value.(int64)
-			if snHeight < previousHeight-int64(m.opts.KeepRecent) {
-				m.pruneHeights = append(m.pruneHeights, snHeight)
+			if held || snHeight >= previousHeight-int64(m.opts.KeepRecent) {
+				continue
+			}
 
-				// We must get next before removing to be able to continue iterating.
-				next = e.Next()
-				m.pruneSnapshotHeights.Remove(e)
-			} else {
-				next = e.Next()
+			if !m.opts.IsRetained(snHeight) {
+				m.pruneHeights = append(m.pruneHeights, snHeight)
 			}
+			m.pruneSnapshotHeights.Remove(e)
 		}
 
 		// flush the updates to disk so that they are not lost if crash happens.
 		if err := m.db.SetSync(pruneHeightsKey, int64SliceToBytes(m.pruneHeights)); err != nil {
 			panic(err)
 		}
+		if m.heldFromHeight != heldFromHeight {
+			if err := m.db.SetSync(pruneHeldFromHeightKey, int64ToBytes(m.heldFromHeight)); err != nil {
+				panic(err)
+			}
+		}
 	}()
 
 	if int64(m.opts.KeepRecent) < previousHeight {
 		pruneHeight := previousHeight - int64(m.opts.KeepRecent)
-		// We consider this height to be pruned iff:
-		//
-		// - snapshotInterval is zero as that means that all heights should be pruned.
-		// - snapshotInterval % (height - KeepRecent) != 0 as that means the height is not
-		// a 'snapshot' height.
-		if m.snapshotInterval == 0 || pruneHeight%int64(m.snapshotInterval) != 0 {
-			m.pruneHeightsMx.Lock()
-			defer m.pruneHeightsMx.Unlock()
 
+		m.pruneHeightsMx.Lock()
+		defer m.pruneHeightsMx.Unlock()
+
+		if held {
+			if m.heldFromHeight == 0 {
+				m.heldFromHeight = pruneHeight
+			}
+			return 0
+		}
+
+		// pruning was held until now, catch up with the heights skipped in the meantime.
+		if m.heldFromHeight > 0 {
+			for h := m.heldFromHeight; h < pruneHeight; h++ {
+				if m.isPrunable(h) {
+					m.pruneHeights = append(m.pruneHeights, h)
+				}
+			}
+			m.heldFromHeight = 0
+		}
+
+		if m.isPrunable(pruneHeight) {
 			m.pruneHeights = append(m.pruneHeights, pruneHeight)
 			return pruneHeight
 		}
@@ -151,6 +250,21 @@ func (m *Manager) HandleHeight(previousHeight int64) int64 {
 	return 0
 }
 
+// isPrunable returns true if the height is neither a 'snapshot' height, which is
+// pruned once the snapshot is complete, nor retained by the pruning options.
+func (m *Manager) isPrunable(height int64) bool {
+	// We consider this height to be pruned iff:
+	//
+	// - snapshotInterval is zero as that means that all heights should be pruned.
+	// - snapshotInterval % (height - KeepRecent) != 0 as that means the height is not
+	// a 'snapshot' height.
+	// - the height is not retained by the KeepEvery and KeepRanges rules.
+	if m.snapshotInterval != 0 && height%int64(m.snapshotInterval) == 0 {
+		return false
+	}
+	return !m.opts.IsRetained(height)
+}
+
 // HandleHeightSnapshot persists the snapshot height to be pruned at the next appropriate
 // height defined by the pruning strategy. Flushes the update to disk and panics if the flush fails
 // The input height must be greater than 0 and pruning strategy any but pruning nothing.
@@ -192,11 +306,23 @@ func (m *Manager) LoadPruningHeights(db dbm.DB) error {
 		return err
 	}
 
+	heldFromHeight, err := loadPruningHeldFromHeight(db)
+	if err != nil {
+		return err
+	}
+
+	pinnedHeights, err := loadPruningPinnedHeights(db)
+	if err != nil {
+		return err
+	}
+
+	m.pruneHeightsMx.Lock()
+	defer m.pruneHeightsMx.Unlock()
 	if len(loadedPruneHeights) > 0 {
-		m.pruneHeightsMx.Lock()
-		defer m.pruneHeightsMx.Unlock()
 		m.pruneHeights = loadedPruneHeights
 	}
+	m.heldFromHeight = heldFromHeight
+	m.pinnedHeights = pinnedHeights
 
 	loadedPruneSnapshotHeights, err := loadPruningSnapshotHeights(db)
 	if err != nil {
@@ -261,6 +387,55 @@ func loadPruningSnapshotHeights(db dbm.DB) (*list.List, error) {
 	return pruneSnapshotHeights, nil
 }
 
+func loadPruningHeldFromHeight(db dbm.DB) (int64, error) {
+	bz, err := db.Get(pruneHeldFromHeightKey)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get held pruning height: %w", err)
+	}
+	if len(bz) == 0 {
+		return 0, nil
+	}
+
+	h := int64(binary.BigEndian.Uint64(bz))
+	if h < 0 {
+		return 0, &NegativeHeightsError{Height: h}
+	}
+
+	return h, nil
+}
+
+func loadPruningPinnedHeights(db dbm.DB) ([]types.HeightRange, error) {
+	bz, err := db.Get(prunePinnedHeightsKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pinned heights: %w", err)
+	}
+
+	var pinnedHeights []types.HeightRange
+	for offset := 0; offset+16 <= len(bz); offset += 16 {
+		pinnedHeights = append(pinnedHeights, types.HeightRange{
+			Start: binary.BigEndian.Uint64(bz[offset : offset+8]),
+			End:   binary.BigEndian.Uint64(bz[offset+8 : offset+16]),
+		})
+	}
+
+	return pinnedHeights, nil
+}
+
+func heightRangesToBytes(ranges []types.HeightRange) []byte {
+	bz := make([]byte, len(ranges)*16)
+	for i, r := range ranges {
+		binary.BigEndian.PutUint64(bz[i*16:], r.Start)
+		binary.BigEndian.PutUint64(bz[i*16+8:], r.End)
+	}
+	return bz
+}
+
+func int64ToBytes(h int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(h))
+	return bz
+}
+
 func int64SliceToBytes(slice []int64) []byte {
 	bz := make([]byte, 0, len(slice)*8)
 	for _, ph := range slice {
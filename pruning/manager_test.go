@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -210,6 +211,117 @@ func TestHandleHeight_Inputs(t *testing.T) {
 	}
 }
 
+func TestHandleHeight_RetentionRules(t *testing.T) {
+	opts := types.NewCustomPruningOptions(2, 10)
+	opts.KeepEvery = 5
+	opts.KeepRanges = []types.HeightRange{{Start: 12, End: 14}}
+
+	manager := pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	manager.SetOptions(opts)
+	manager.SetSnapshotInterval(7)
+
+	for h := int64(1); h <= 20; h++ {
+		manager.HandleHeight(h)
+	}
+
+	// 5, 10, 15 are kept every 5 heights, 12-14 are kept by range and 7, 14 are snapshot heights.
+	actualHeights, err := manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4, 6, 8, 9, 11, 16, 17, 18}, actualHeights)
+
+	// a completed snapshot at a retained height is not pruned, 20 is kept every 5 heights
+	manager.HandleHeightSnapshot(14)
+	manager.HandleHeightSnapshot(21)
+	for h := int64(21); h <= 24; h++ {
+		manager.HandleHeight(h)
+	}
+
+	actualHeights, err = manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{19, 22, 21}, actualHeights)
+}
+
+func TestHandleHeight_KeepUntil(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	opts := types.NewCustomPruningOptions(2, 10)
+	opts.KeepEvery = 4
+	opts.KeepUntil = now.Add(time.Hour)
+
+	memDB := db.NewMemDB()
+	manager := pruning.NewManager(memDB, log.NewNopLogger())
+	manager.SetOptions(opts)
+	manager.SetClock(func() time.Time { return now })
+
+	for h := int64(1); h <= 8; h++ {
+		require.Equal(t, int64(0), manager.HandleHeight(h))
+	}
+
+	actualHeights, err := manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Empty(t, actualHeights)
+
+	heldFromHeight, err := pruning.LoadPruningHeldFromHeight(memDB)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), heldFromHeight)
+
+	// the held height survives a restart
+	manager = pruning.NewManager(memDB, log.NewNopLogger())
+	manager.SetOptions(opts)
+	require.NoError(t, manager.LoadPruningHeights(memDB))
+
+	now = opts.KeepUntil
+	manager.SetClock(func() time.Time { return now })
+	require.Equal(t, int64(7), manager.HandleHeight(9))
+
+	actualHeights, err = manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 5, 6, 7}, actualHeights)
+
+	heldFromHeight, err = pruning.LoadPruningHeldFromHeight(memDB)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), heldFromHeight)
+}
+
+func TestPinHeights(t *testing.T) {
+	memDB := db.NewMemDB()
+	manager := pruning.NewManager(memDB, log.NewNopLogger())
+	manager.SetOptions(types.NewCustomPruningOptions(2, 10))
+
+	require.Error(t, manager.PinHeights(types.HeightRange{Start: 0, End: 5}))
+	require.Error(t, manager.PinHeights(types.HeightRange{Start: 5, End: 4}))
+	require.NoError(t, manager.PinHeights(types.HeightRange{Start: 3, End: 5}))
+	require.NoError(t, manager.PinHeights(types.HeightRange{Start: 8, End: 8}))
+
+	for h := int64(1); h <= 12; h++ {
+		manager.HandleHeight(h)
+	}
+
+	// pinned heights survive a restart
+	manager = pruning.NewManager(memDB, log.NewNopLogger())
+	manager.SetOptions(types.NewCustomPruningOptions(2, 10))
+	require.NoError(t, manager.LoadPruningHeights(memDB))
+	require.Equal(t, []types.HeightRange{{Start: 3, End: 5}, {Start: 8, End: 8}}, manager.GetPinnedHeights())
+
+	actualHeights, err := manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 6, 7, 9, 10}, actualHeights)
+
+	require.Error(t, manager.UnpinHeights(types.HeightRange{Start: 3, End: 4}))
+	require.NoError(t, manager.UnpinHeights(types.HeightRange{Start: 3, End: 5}))
+	require.Equal(t, []types.HeightRange{{Start: 8, End: 8}}, manager.GetPinnedHeights())
+
+	manager.HandleHeight(13)
+	actualHeights, err = manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 4, 5, 11}, actualHeights)
+
+	require.NoError(t, manager.UnpinHeights(types.HeightRange{Start: 8, End: 8}))
+	actualHeights, err = manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{8}, actualHeights)
+}
+
 func TestHandleHeight_FlushLoadFromDisk(t *testing.T) {
 	testcases := map[string]struct {
 		previousHeight                   int64
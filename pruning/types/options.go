@@ -3,6 +3,9 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // PruningOptions defines the pruning strategy used when determining which
@@ -16,6 +19,24 @@ type PruningOptions struct {
 
 	// Strategy defines the kind of pruning strategy. See below for more information on each.
 	Strategy PruningStrategy
+
+	// KeepEvery defines that every height multiple of KeepEvery is never pruned.
+	// Zero disables the rule.
+	KeepEvery uint64
+
+	// KeepRanges defines ranges of heights that are never pruned.
+	KeepRanges []HeightRange
+
+	// KeepUntil defines a time before which no height is pruned. The heights
+	// that would have been pruned in the meantime are pruned once it is reached.
+	// The zero time disables the rule.
+	KeepUntil time.Time
+}
+
+// HeightRange defines an inclusive range of heights.
+type HeightRange struct {
+	Start uint64
+	End   uint64
 }
 
 type PruningStrategy int
@@ -56,6 +77,7 @@ var (
 	ErrPruningIntervalZero       = errors.New("'pruning-interval' must not be 0. If you want to disable pruning, select pruning = \"nothing\"")
 	ErrPruningIntervalTooSmall   = fmt.Errorf("'pruning-interval' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingInterval)
 	ErrPruningKeepRecentTooSmall = fmt.Errorf("'pruning-keep-recent' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingKeepRecent)
+	ErrPruningKeepRangeInvalid   = errors.New("'pruning-keep-ranges' must only contain ranges with 0 < start <= end")
 )
 
 func NewPruningOptions(pruningStrategy PruningStrategy) PruningOptions {
@@ -110,9 +132,85 @@ func (po PruningOptions) Validate() error {
 	if po.KeepRecent < pruneEverythingKeepRecent {
 		return ErrPruningKeepRecentTooSmall
 	}
+	for _, r := range po.KeepRanges {
+		if r.Start == 0 || r.Start > r.End {
+			return ErrPruningKeepRangeInvalid
+		}
+	}
 	return nil
 }
 
+// IsRetained returns true if the given height must never be pruned according
+// to the KeepEvery and KeepRanges retention rules.
+func (po PruningOptions) IsRetained(height int64) bool {
+	if height <= 0 {
+		return false
+	}
+	h := uint64(height)
+	if po.KeepEvery > 0 && h%po.KeepEvery == 0 {
+		return true
+	}
+	for _, r := range po.KeepRanges {
+		if r.Start <= h && h <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHeld returns true if no height may be pruned at the given time according to
+// the KeepUntil retention rule.
+func (po PruningOptions) IsHeld(now time.Time) bool {
+	return !po.KeepUntil.IsZero() && now.Before(po.KeepUntil)
+}
+
+func (r HeightRange) String() string {
+	return fmt.Sprintf("%d-%d", r.Start, r.End)
+}
+
+// NewHeightRanges compacts increasing heights into ranges of consecutive heights.
+func NewHeightRanges(heights []int64) []HeightRange {
+	var ranges []HeightRange
+	for _, h := range heights {
+		if n := len(ranges); n > 0 && ranges[n-1].End+1 == uint64(h) {
+			ranges[n-1].End = uint64(h)
+			continue
+		}
+		ranges = append(ranges, HeightRange{Start: uint64(h), End: uint64(h)})
+	}
+	return ranges
+}
+
+// ParseHeightRanges parses a comma separated list of inclusive height ranges,
+// e.g. "100-200,500-500".
+func ParseHeightRanges(s string) ([]HeightRange, error) {
+	var ranges []HeightRange
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		bounds := strings.Split(part, "-")
+		if len(bounds) != 2 {
+			return nil, fmt.Errorf("invalid height range %q, expected <start>-<end>", part)
+		}
+
+		start, err := strconv.ParseUint(strings.TrimSpace(bounds[0]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid height range %q: %w", part, err)
+		}
+		end, err := strconv.ParseUint(strings.TrimSpace(bounds[1]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid height range %q: %w", part, err)
+		}
+
+		ranges = append(ranges, HeightRange{Start: start, End: end})
+	}
+
+	return ranges, nil
+}
+
 func NewPruningOptionsFromString(strategy string) PruningOptions {
 	switch strategy {
 	case PruningOptionEverything:
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -21,6 +22,9 @@ func TestPruningOptions_Validate(t *testing.T) {
 		{NewCustomPruningOptions(2, 9), ErrPruningIntervalTooSmall},
 		{NewCustomPruningOptions(2, 0), ErrPruningIntervalZero},
 		{NewCustomPruningOptions(2, 0), ErrPruningIntervalZero},
+		{PruningOptions{KeepRecent: 2, Interval: 10, Strategy: PruningCustom, KeepRanges: []HeightRange{{1, 1}, {5, 10}}}, nil},
+		{PruningOptions{KeepRecent: 2, Interval: 10, Strategy: PruningCustom, KeepRanges: []HeightRange{{0, 10}}}, ErrPruningKeepRangeInvalid},
+		{PruningOptions{KeepRecent: 2, Interval: 10, Strategy: PruningCustom, KeepRanges: []HeightRange{{10, 5}}}, ErrPruningKeepRangeInvalid},
 	}
 
 	for _, tc := range testCases {
@@ -63,3 +67,63 @@ func TestNewPruningOptionsFromString(t *testing.T) {
 		require.Equal(t, tc.expect, actual)
 	}
 }
+
+func TestPruningOptions_IsRetained(t *testing.T) {
+	opts := NewPruningOptions(PruningDefault)
+	require.False(t, opts.IsRetained(100))
+
+	opts.KeepEvery = 100
+	opts.KeepRanges = []HeightRange{{Start: 5, End: 7}}
+
+	for _, h := range []int64{5, 6, 7, 100, 200} {
+		require.True(t, opts.IsRetained(h), h)
+	}
+	for _, h := range []int64{-100, 0, 1, 4, 8, 99, 101} {
+		require.False(t, opts.IsRetained(h), h)
+	}
+}
+
+func TestPruningOptions_IsHeld(t *testing.T) {
+	now := time.Now()
+
+	opts := NewPruningOptions(PruningDefault)
+	require.False(t, opts.IsHeld(now))
+
+	opts.KeepUntil = now.Add(time.Second)
+	require.True(t, opts.IsHeld(now))
+	require.False(t, opts.IsHeld(opts.KeepUntil))
+}
+
+func TestNewHeightRanges(t *testing.T) {
+	require.Nil(t, NewHeightRanges(nil))
+	require.Equal(t,
+		[]HeightRange{{Start: 2, End: 3}, {Start: 5, End: 5}, {Start: 7, End: 9}},
+		NewHeightRanges([]int64{2, 3, 5, 7, 8, 9}),
+	)
+}
+
+func TestParseHeightRanges(t *testing.T) {
+	testCases := []struct {
+		s         string
+		expect    []HeightRange
+		expectErr bool
+	}{
+		{"", nil, false},
+		{"1-10", []HeightRange{{1, 10}}, false},
+		{" 1-10, 20 - 20 ,", []HeightRange{{1, 10}, {20, 20}}, false},
+		{"10", nil, true},
+		{"1-2-3", nil, true},
+		{"a-10", nil, true},
+		{"1--10", nil, true},
+	}
+
+	for _, tc := range testCases {
+		actual, err := ParseHeightRanges(tc.s)
+		if tc.expectErr {
+			require.Error(t, err, tc.s)
+			continue
+		}
+		require.NoError(t, err, tc.s)
+		require.Equal(t, tc.expect, actual, tc.s)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -36,6 +37,9 @@ const (
 
 	// FileStreamer defines the store streaming type for file streaming.
 	FileStreamer = "file"
+
+	// GRPCStreamer defines the store streaming type for gRPC streaming.
+	GRPCStreamer = "grpc"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -48,6 +52,9 @@ type BaseConfig struct {
 	Pruning           string `mapstructure:"pruning"`
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
+	PruningKeepEvery  string `mapstructure:"pruning-keep-every"`
+	PruningKeepRanges string `mapstructure:"pruning-keep-ranges"`
+	PruningKeepUntil  string `mapstructure:"pruning-keep-until"`
 
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
@@ -174,6 +181,10 @@ type GRPCConfig struct {
 	// MaxSendMsgSize defines the max message size in bytes the server can send.
 	// The default value is math.MaxInt32.
 	MaxSendMsgSize int `mapstructure:"max-send-msg-size"`
+
+	// EnableVersionPinning defines if the node gRPC service allows pinning
+	// heights so that they are not pruned.
+	EnableVersionPinning bool `mapstructure:"enable-version-pinning"`
 }
 
 // GRPCWebConfig defines configuration for the gRPC-web server.
@@ -211,6 +222,7 @@ type (
 	// list defined by 'StoreConfig.Streamers'.
 	StreamersConfig struct {
 		File FileStreamerConfig `mapstructure:"file"`
+		GRPC GRPCStreamerConfig `mapstructure:"grpc"`
 	}
 
 	// FileStreamerConfig defines the file streaming configuration options.
@@ -228,6 +240,26 @@ type (
 		// the commit, but don't lose data in face of system crash.
 		Fsync bool `mapstructure:"fsync"`
 	}
+
+	// GRPCStreamerConfig defines the gRPC streaming configuration options.
+	GRPCStreamerConfig struct {
+		Keys    []string `mapstructure:"keys"`
+		Address string   `mapstructure:"address"`
+		// BufferSize is the number of blocks buffered for each subscriber.
+		BufferSize int `mapstructure:"buffer-size"`
+		// Backpressure defines what happens when the buffer of a subscriber is
+		// full, either "block" the commit or "drop" the block for the subscriber.
+		Backpressure string `mapstructure:"backpressure"`
+		// Ack specifies if the commit waits for the subscribers to acknowledge
+		// each block.
+		Ack bool `mapstructure:"ack"`
+		// AckTimeout is the maximum duration to wait for acknowledgements, 0
+		// waits forever.
+		AckTimeout time.Duration `mapstructure:"ack-timeout"`
+		// StopNodeOnError specifies if propagate the streamer errors to the consensus
+		// state machine.
+		StopNodeOnError bool `mapstructure:"stop-node-on-error"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -281,6 +313,7 @@ func DefaultConfig() *Config {
 			Pruning:             pruningtypes.PruningOptionDefault,
 			PruningKeepRecent:   "0",
 			PruningInterval:     "0",
+			PruningKeepEvery:    "0",
 			MinRetainBlocks:     0,
 			IndexEvents:         make([]string, 0),
 			IAVLCacheSize:       781250, // 50 MB
@@ -337,6 +370,12 @@ func DefaultConfig() *Config {
 				// in face of system crash.
 				Fsync: false,
 			},
+			GRPC: GRPCStreamerConfig{
+				Keys:         []string{"*"},
+				Address:      "localhost:9095",
+				BufferSize:   100,
+				Backpressure: "block",
+			},
 		},
 	}
 }
@@ -31,6 +31,14 @@ pruning = "{{ .BaseConfig.Pruning }}"
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# Retention rules, applied on top of any pruning strategy but nothing.
+# pruning-keep-every: heights that are a multiple of this value are never pruned (0 disables it).
+# pruning-keep-ranges: comma separated inclusive height ranges never pruned (e.g. "1000-2000,5000-5000").
+# pruning-keep-until: RFC3339 time before which no height is pruned, pruning resumes with the held back heights afterwards.
+pruning-keep-every = "{{ .BaseConfig.PruningKeepEvery }}"
+pruning-keep-ranges = "{{ .BaseConfig.PruningKeepRanges }}"
+pruning-keep-until = "{{ .BaseConfig.PruningKeepUntil }}"
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -205,6 +213,11 @@ max-recv-msg-size = "{{ .GRPC.MaxRecvMsgSize }}"
 # The default value is math.MaxInt32.
 max-send-msg-size = "{{ .GRPC.MaxSendMsgSize }}"
 
+# EnableVersionPinning defines if the node gRPC service allows pinning heights
+# so that they are not pruned, e.g. while an indexer is reading them.
+# NOTE: anyone able to query the node can pin heights, only enable it on nodes that are not public.
+enable-version-pinning = {{ .GRPC.EnableVersionPinning }}
+
 ###############################################################################
 ###                        gRPC Web Configuration                           ###
 ###############################################################################
@@ -258,6 +271,28 @@ stop-node-on-error = "{{ .Streamers.File.StopNodeOnError }}"
 
 # fsync specifies if call fsync after writing the files.
 fsync = "{{ .Streamers.File.Fsync }}"
+
+[streamers.grpc]
+keys = [{{ range .Streamers.GRPC.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# address defines the address the gRPC streaming server listens on.
+address = "{{ .Streamers.GRPC.Address }}"
+
+# buffer-size defines the number of blocks buffered for each subscriber.
+buffer-size = {{ .Streamers.GRPC.BufferSize }}
+
+# backpressure defines what happens when the buffer of a subscriber is full,
+# "block" waits for the subscriber during commit, "drop" skips the block for it.
+backpressure = "{{ .Streamers.GRPC.Backpressure }}"
+
+# ack specifies if the commit waits for the subscribers to acknowledge each block.
+ack = {{ .Streamers.GRPC.Ack }}
+
+# ack-timeout defines the maximum duration to wait for acknowledgements, 0 waits forever.
+ack-timeout = "{{ .Streamers.GRPC.AckTimeout }}"
+
+# stop-node-on-error specifies if propagate the gRPC streamer errors to consensus state machine.
+stop-node-on-error = {{ .Streamers.GRPC.StopNodeOnError }}
 `
 
 var configTemplate *template.Template
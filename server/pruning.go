@@ -3,6 +3,7 @@ package server
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cast"
 
@@ -13,18 +14,30 @@ import (
 // GetPruningOptionsFromFlags parses command flags and returns the correct
 // PruningOptions. If a pruning strategy is provided, that will be parsed and
 // returned, otherwise, it is assumed custom pruning options are provided.
+// The retention rules are applied on top of any strategy but "nothing".
 func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningOptions, error) {
 	strategy := strings.ToLower(cast.ToString(appOpts.Get(FlagPruning)))
 
 	switch strategy {
-	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
+	case pruningtypes.PruningOptionNothing:
 		return pruningtypes.NewPruningOptionsFromString(strategy), nil
 
+	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionEverything:
+		opts := pruningtypes.NewPruningOptionsFromString(strategy)
+		if err := setPruningRetentionRules(&opts, appOpts); err != nil {
+			return opts, err
+		}
+
+		return opts, opts.Validate()
+
 	case pruningtypes.PruningOptionCustom:
 		opts := pruningtypes.NewCustomPruningOptions(
 			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
 			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
 		)
+		if err := setPruningRetentionRules(&opts, appOpts); err != nil {
+			return opts, err
+		}
 
 		if err := opts.Validate(); err != nil {
 			return opts, fmt.Errorf("invalid custom pruning options: %w", err)
@@ -36,3 +49,25 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningO
 		return pruningtypes.PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
 	}
 }
+
+// setPruningRetentionRules parses the pruning-keep-every, pruning-keep-ranges
+// and pruning-keep-until flags into opts.
+func setPruningRetentionRules(opts *pruningtypes.PruningOptions, appOpts types.AppOptions) error {
+	opts.KeepEvery = cast.ToUint64(appOpts.Get(FlagPruningKeepEvery))
+
+	keepRanges, err := pruningtypes.ParseHeightRanges(cast.ToString(appOpts.Get(FlagPruningKeepRanges)))
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", FlagPruningKeepRanges, err)
+	}
+	opts.KeepRanges = keepRanges
+
+	if keepUntil := strings.TrimSpace(cast.ToString(appOpts.Get(FlagPruningKeepUntil))); keepUntil != "" {
+		t, err := time.Parse(time.RFC3339, keepUntil)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", FlagPruningKeepUntil, err)
+		}
+		opts.KeepUntil = t
+	}
+
+	return nil
+}
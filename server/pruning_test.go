@@ -2,6 +2,7 @@ package server
 
 import (
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
@@ -37,6 +38,44 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 			},
 			expectedOptions: pruningtypes.NewCustomPruningOptions(1234, 10),
 		},
+		{
+			name: "retention rules",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionEverything)
+				v.Set(FlagPruningKeepEvery, "100")
+				v.Set(FlagPruningKeepRanges, "5-10,20-20")
+				v.Set(FlagPruningKeepUntil, "2022-01-02T15:04:05Z")
+				return v
+			},
+			expectedOptions: func() pruningtypes.PruningOptions {
+				opts := pruningtypes.NewPruningOptions(pruningtypes.PruningEverything)
+				opts.KeepEvery = 100
+				opts.KeepRanges = []pruningtypes.HeightRange{{Start: 5, End: 10}, {Start: 20, End: 20}}
+				opts.KeepUntil = time.Date(2022, 1, 2, 15, 4, 5, 0, time.UTC)
+				return opts
+			}(),
+		},
+		{
+			name: "invalid keep ranges",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionDefault)
+				v.Set(FlagPruningKeepRanges, "10-5")
+				return v
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid keep until",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionDefault)
+				v.Set(FlagPruningKeepUntil, "tomorrow")
+				return v
+			},
+			wantErr: true,
+		},
 		{
 			name: pruningtypes.PruningOptionDefault,
 			initParams: func() *viper.Viper {
@@ -54,6 +54,9 @@ const (
 	FlagPruning             = "pruning"
 	FlagPruningKeepRecent   = "pruning-keep-recent"
 	FlagPruningInterval     = "pruning-interval"
+	FlagPruningKeepEvery    = "pruning-keep-every"
+	FlagPruningKeepRanges   = "pruning-keep-ranges"
+	FlagPruningKeepUntil    = "pruning-keep-until"
 	FlagIndexEvents         = "index-events"
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
@@ -79,6 +82,8 @@ const (
 	flagGRPCAddress    = "grpc.address"
 	flagGRPCWebEnable  = "grpc-web.enable"
 	flagGRPCWebAddress = "grpc-web.address"
+
+	FlagGRPCEnableVersionPinning = "grpc.enable-version-pinning"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -100,6 +105,10 @@ nothing: all historic states will be saved, nothing will be deleted (i.e. archiv
 everything: 2 latest states will be kept; pruning at 10 block intervals.
 custom: allow pruning options to be manually specified through 'pruning-keep-recent', and 'pruning-interval'
 
+Unless pruning is 'nothing', heights can additionally be retained with '--pruning-keep-every' (every Nth height),
+'--pruning-keep-ranges' (e.g. "1000-2000,5000-5000") and '--pruning-keep-until' (an RFC3339 time before which
+nothing is pruned).
+
 Node halting configurations exist in the form of two flags: '--halt-height' and '--halt-time'. During
 the ABCI Commit phase, the node will check if the current block height is greater than or equal to
 the halt-height or if the current block time is greater than or equal to the halt-time. If so, the
@@ -166,6 +175,9 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(FlagPruningKeepEvery, 0, "Never prune heights that are a multiple of this value (ignored if pruning is 'nothing')")
+	cmd.Flags().String(FlagPruningKeepRanges, "", "Comma separated inclusive height ranges never pruned, e.g. 1000-2000,5000-5000 (ignored if pruning is 'nothing')")
+	cmd.Flags().String(FlagPruningKeepUntil, "", "RFC3339 time before which no height is pruned (ignored if pruning is 'nothing')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
 
@@ -181,6 +193,7 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Bool(flagGRPCOnly, false, "Start the node in gRPC query only mode (no Tendermint process is started)")
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(flagGRPCAddress, serverconfig.DefaultGRPCAddress, "the gRPC server address to listen on")
+	cmd.Flags().Bool(FlagGRPCEnableVersionPinning, false, "Define if the node gRPC service allows pinning heights so that they are not pruned (unsafe on public nodes)")
 
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled)")
 	cmd.Flags().String(flagGRPCWebAddress, serverconfig.DefaultGRPCWebAddress, "The gRPC-Web server address to listen on")
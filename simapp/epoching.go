@@ -0,0 +1,81 @@
+package simapp
+
+import (
+	"math/rand"
+
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// The ante handler of SimApp rejects the staking and slashing messages which
+// are not wrapped in an epoching message, so the simulator must not generate
+// them. The epoching module simulates their wrapped counterparts instead.
+
+// stakingSimModule is the staking module for the simulator, without the
+// operations delivering messages queued by the epoching module.
+type stakingSimModule struct {
+	staking.AppModule
+
+	accountKeeper stakingtypes.AccountKeeper
+	bankKeeper    stakingtypes.BankKeeper
+	keeper        stakingkeeper.Keeper
+}
+
+func newStakingSimModule(am staking.AppModule, ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, k stakingkeeper.Keeper) stakingSimModule {
+	return stakingSimModule{
+		AppModule:     am,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		keeper:        k,
+	}
+}
+
+// WeightedOperations returns the staking operations which are not queued by
+// the epoching module.
+func (am stakingSimModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	var (
+		weightMsgEditValidator             int
+		weightMsgCancelUnbondingDelegation int
+	)
+
+	simState.AppParams.GetOrGenerate(simState.Cdc, stakingsim.OpWeightMsgEditValidator, &weightMsgEditValidator, nil,
+		func(_ *rand.Rand) {
+			weightMsgEditValidator = simappparams.DefaultWeightMsgEditValidator
+		},
+	)
+
+	simState.AppParams.GetOrGenerate(simState.Cdc, stakingsim.OpWeightMsgCancelUnbondingDelegation, &weightMsgCancelUnbondingDelegation, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelUnbondingDelegation = simappparams.DefaultWeightMsgCancelUnbondingDelegation
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgEditValidator,
+			stakingsim.SimulateMsgEditValidator(am.accountKeeper, am.bankKeeper, am.keeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelUnbondingDelegation,
+			stakingsim.SimulateMsgCancelUnbondingDelegate(am.accountKeeper, am.bankKeeper, am.keeper),
+		),
+	}
+}
+
+// slashingSimModule is the slashing module for the simulator. Its only
+// operation, unjail, is queued by the epoching module.
+type slashingSimModule struct {
+	slashing.AppModule
+}
+
+// WeightedOperations doesn't return any slashing module operation.
+func (slashingSimModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
+	return nil
+}
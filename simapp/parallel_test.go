@@ -0,0 +1,129 @@
+package simapp
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// TestParallelTxExecutionDeterminism delivers the same blocks of bank sends
+// to an app executing txs sequentially and to one executing them in parallel,
+// and checks that both produce the same responses and app hashes.
+func TestParallelTxExecutionDeterminism(t *testing.T) {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+
+	validator := tmtypes.NewValidator(pubKey, 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+
+	nAccounts := 10
+	privs := make([]cryptotypes.PrivKey, nAccounts)
+	addrs := make([]sdk.AccAddress, nAccounts)
+	accs := make([]authtypes.GenesisAccount, nAccounts)
+	balances := make([]banktypes.Balance, nAccounts)
+	for i := range privs {
+		privs[i] = secp256k1.GenPrivKey()
+		addrs[i] = sdk.AccAddress(privs[i].PubKey().Address())
+		accs[i] = authtypes.NewBaseAccount(addrs[i], privs[i].PubKey(), 0, 0)
+		balances[i] = banktypes.Balance{
+			Address: addrs[i].String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+		}
+	}
+
+	encCfg := MakeTestEncodingConfig()
+	newApp := func(options ...func(*baseapp.BaseApp)) *SimApp {
+		app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 5, encCfg, EmptyAppOptions{}, options...)
+		genesisState := genesisStateWithValSet(t, app, NewDefaultGenesisState(encCfg.Codec), valSet, accs, balances...)
+
+		stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+		require.NoError(t, err)
+
+		app.InitChain(abci.RequestInitChain{
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: DefaultConsensusParams,
+			AppStateBytes:   stateBytes,
+		})
+		app.Commit()
+
+		return app
+	}
+
+	sequentialApp := newApp()
+	parallelApp := newApp(baseapp.SetParallelTxExecution(4))
+	require.Equal(t, sequentialApp.LastCommitID(), parallelApp.LastCommitID())
+
+	ctx := sequentialApp.NewContext(true, tmproto.Header{})
+	accNums := make([]uint64, nAccounts)
+	accSeqs := make([]uint64, nAccounts)
+	for i, addr := range addrs {
+		accNums[i] = sequentialApp.AccountKeeper.GetAccount(ctx, addr).GetAccountNumber()
+	}
+
+	r := rand.New(rand.NewSource(1))
+	nBlocks := 3
+	txPerHeight := 40
+
+	for blockN := 0; blockN < nBlocks; blockN++ {
+		var reqs []abci.RequestDeliverTx
+		for i := 0; i < txPerHeight; i++ {
+			// a sender sends several txs per block, to the next account or to
+			// a new one, paying fees from time to time
+			from := r.Intn(nAccounts)
+			to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			if r.Intn(2) == 0 {
+				to = addrs[(from+1)%nAccounts]
+			}
+
+			fees := sdk.NewCoins()
+			if r.Intn(4) == 0 {
+				fees = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+			}
+
+			msg := banktypes.NewMsgSend(addrs[from], to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(r.Intn(100)+1))))
+			tx, err := helpers.GenSignedMockTx(
+				r, encCfg.TxConfig, []sdk.Msg{msg}, fees, 100000, "",
+				[]uint64{accNums[from]}, []uint64{accSeqs[from]}, privs[from],
+			)
+			require.NoError(t, err)
+			accSeqs[from]++
+
+			txBytes, err := encCfg.TxConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+
+			reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+		}
+
+		header := tmproto.Header{Height: sequentialApp.LastBlockHeight() + 1}
+		sequentialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+		parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		var expected []abci.ResponseDeliverTx
+		for _, req := range reqs {
+			expected = append(expected, sequentialApp.DeliverTx(req))
+		}
+		require.Equal(t, expected, parallelApp.DeliverTxs(reqs))
+
+		sequentialApp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		parallelApp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+
+		require.Equal(t, sequentialApp.Commit(), parallelApp.Commit())
+	}
+}
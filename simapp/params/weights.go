@@ -4,6 +4,7 @@ package params
 const (
 	DefaultWeightMsgSend                        int = 100
 	DefaultWeightMsgMultiSend                   int = 10
+	DefaultWeightMsgBurn                        int = 10
 	DefaultWeightMsgSetWithdrawAddress          int = 50
 	DefaultWeightMsgWithdrawDelegationReward    int = 50
 	DefaultWeightMsgWithdrawValidatorCommission int = 50
@@ -11,6 +12,7 @@ const (
 	DefaultWeightMsgDeposit                     int = 100
 	DefaultWeightMsgVote                        int = 67
 	DefaultWeightMsgVoteWeighted                int = 33
+	DefaultWeightMsgCancelProposal              int = 5
 	DefaultWeightMsgUnjail                      int = 100
 	DefaultWeightMsgCreateValidator             int = 100
 	DefaultWeightMsgEditValidator               int = 5
@@ -18,6 +20,11 @@ const (
 	DefaultWeightMsgUndelegate                  int = 100
 	DefaultWeightMsgBeginRedelegate             int = 100
 	DefaultWeightMsgCancelUnbondingDelegation   int = 100
+	DefaultWeightMsgWrappedCreateValidator      int = 100
+	DefaultWeightMsgWrappedDelegate             int = 100
+	DefaultWeightMsgWrappedUndelegate           int = 100
+	DefaultWeightMsgWrappedBeginRedelegate      int = 100
+	DefaultWeightMsgWrappedUnjail               int = 100
 
 	DefaultWeightCommunitySpendProposal int = 5
 	DefaultWeightTextProposal           int = 5
@@ -1,6 +1,7 @@
 package simapp
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math/rand"
@@ -19,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -26,6 +28,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -191,11 +194,14 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[evidencetypes.StoreKey], newApp.keys[evidencetypes.StoreKey], [][]byte{}},
 		{app.keys[capabilitytypes.StoreKey], newApp.keys[capabilitytypes.StoreKey], [][]byte{}},
 		{app.keys[authzkeeper.StoreKey], newApp.keys[authzkeeper.StoreKey], [][]byte{authzkeeper.GrantKey, authzkeeper.GrantQueuePrefix}},
+		{app.keys[epochingtypes.StoreKey], newApp.keys[epochingtypes.StoreKey], [][]byte{epochingtypes.EpochKey}}, // the epoch restarts at the first block
 	}
 
 	for _, skp := range storeKeysPrefixes {
-		storeA := ctxA.KVStore(skp.A)
-		storeB := ctxB.KVStore(skp.B)
+		// the stores may hold a different number of keys with the skipped
+		// prefixes, so they are filtered out of each store before diffing
+		storeA := withoutPrefixes(ctxA.KVStore(skp.A), skp.Prefixes)
+		storeB := withoutPrefixes(ctxB.KVStore(skp.B), skp.Prefixes)
 
 		failedKVAs, failedKVBs := sdk.DiffKVStores(storeA, storeB, skp.Prefixes)
 		require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare")
@@ -205,6 +211,31 @@ func TestAppImportExport(t *testing.T) {
 	}
 }
 
+// withoutPrefixes returns an in-memory copy of the store without the keys with
+// any of the prefixes.
+func withoutPrefixes(store sdk.KVStore, prefixes [][]byte) sdk.KVStore {
+	filtered := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		skip := false
+		for _, prefix := range prefixes {
+			if bytes.HasPrefix(iter.Key(), prefix) {
+				skip = true
+				break
+			}
+		}
+
+		if !skip {
+			filtered.Set(iter.Key(), iter.Value())
+		}
+	}
+
+	return filtered
+}
+
 func TestAppSimulationAfterImport(t *testing.T) {
 	config, db, dir, logger, skip, err := SetupSimulation("leveldb-app-sim", "Simulation")
 	if skip {
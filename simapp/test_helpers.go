@@ -405,6 +405,19 @@ func CheckBalance(t *testing.T, app *SimApp, addr sdk.AccAddress, balances sdk.C
 	require.True(t, balances.IsEqual(app.BankKeeper.GetAllBalances(ctxCheck, addr)))
 }
 
+// EndEpoch simulates the commitment of empty blocks until the end of the
+// current epoch, so that the messages queued by the epoching module are
+// executed.
+func EndEpoch(app *SimApp) {
+	epochNumber := app.EpochingKeeper.GetEpoch(app.BaseApp.NewContext(true, tmproto.Header{})).EpochNumber
+	for app.EpochingKeeper.GetEpoch(app.BaseApp.NewContext(true, tmproto.Header{})).EpochNumber == epochNumber {
+		header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+	}
+}
+
 // SignCheckDeliver checks a generated signed transaction and simulates a
 // block commitment with the given transaction. A test assertion is made using
 // the parameter 'expPass' against the result. A corresponding result is
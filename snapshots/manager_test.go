@@ -228,3 +228,42 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store := setupStore(t)
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	source := snapshots.NewManager(store, opts, &mockSnapshotter{
+		items:         items,
+		prunedHeights: make(map[int64]struct{}),
+	}, nil, log.NewNopLogger())
+	snapshot, err := source.Create(5)
+	require.NoError(t, err)
+
+	target := &mockSnapshotter{prunedHeights: make(map[int64]struct{})}
+	manager := snapshots.NewManager(store, opts, target, nil, log.NewNopLogger())
+
+	// nil manager should return error
+	err = (*snapshots.Manager)(nil).RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.Error(t, err)
+
+	// missing snapshot should error
+	err = manager.RestoreLocalSnapshot(4, snapshot.Format)
+	require.Error(t, err)
+
+	// unknown format should error
+	err = manager.RestoreLocalSnapshot(2, 1)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+	err = manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, items, target.items)
+
+	// restoring on a busy manager should error
+	manager = setupBusyManager(t)
+	err = manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.Error(t, err)
+}
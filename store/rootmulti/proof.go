@@ -1,11 +1,21 @@
 package rootmulti
 
 import (
+	"bytes"
+	"fmt"
+
 	"github.com/tendermint/tendermint/crypto/merkle"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 )
 
+// QueryPathBatchKeys is the multistore query path proving the values of keys
+// from several stores at the same height. The request data is a BatchKeysQuery
+// and the response value a BatchKeysResult. Through BaseApp, it is queried at
+// "/store/_batch/keys".
+const QueryPathBatchKeys = "/_batch/keys"
+
 // RequireProof returns whether proof is required for the subpath.
 func RequireProof(subpath string) bool {
 	// XXX: create a better convention.
@@ -25,3 +35,87 @@ func DefaultProofRuntime() (prt *merkle.ProofRuntime) {
 	prt.RegisterOpDecoder(storetypes.ProofOpSimpleMerkleCommitment, storetypes.CommitmentOpDecoder)
 	return
 }
+
+// VerifyBatchKeysProof verifies the proof ops of a QueryPathBatchKeys query of
+// keys against the app hash of the queried height. The values of the result
+// must be those of the keys, in order. The proof ops must hold the IAVL proof of
+// every value, in order, followed by the proof of every store of the values, in
+// the order in which they first appear.
+func VerifyBatchKeysProof(appHash []byte, keys []storetypes.StoreKeyQuery, result storetypes.BatchKeysResult, proofOps *tmcrypto.ProofOps) error {
+	if len(result.Values) != len(keys) {
+		return fmt.Errorf("expected %d values, got %d", len(keys), len(result.Values))
+	}
+	for i, value := range result.Values {
+		if value.StoreName != keys[i].StoreName || !bytes.Equal(value.Key, keys[i].Key) {
+			return fmt.Errorf(
+				"value %d is for key %X of store %s, expected key %X of store %s",
+				i, value.Key, value.StoreName, keys[i].Key, keys[i].StoreName,
+			)
+		}
+	}
+
+	if proofOps == nil {
+		return fmt.Errorf("proof ops are empty")
+	}
+
+	storeOps := make(map[string]merkle.ProofOperator)
+	for _, value := range result.Values {
+		storeOps[value.StoreName] = nil
+	}
+	if len(proofOps.Ops) != len(result.Values)+len(storeOps) {
+		return fmt.Errorf("expected %d proof ops, got %d", len(result.Values)+len(storeOps), len(proofOps.Ops))
+	}
+
+	for _, pop := range proofOps.Ops[len(result.Values):] {
+		if pop.Type != storetypes.ProofOpSimpleMerkleCommitment {
+			return fmt.Errorf("unexpected store proof op type %s", pop.Type)
+		}
+
+		op, err := storetypes.CommitmentOpDecoder(pop)
+		if err != nil {
+			return err
+		}
+
+		storeName := string(op.GetKey())
+		if prev, ok := storeOps[storeName]; !ok || prev != nil {
+			return fmt.Errorf("unexpected proof op for store %s", storeName)
+		}
+		storeOps[storeName] = op
+	}
+
+	for i, value := range result.Values {
+		pop := proofOps.Ops[i]
+		if pop.Type != storetypes.ProofOpIAVLCommitment {
+			return fmt.Errorf("unexpected proof op type %s for key %X of store %s", pop.Type, value.Key, value.StoreName)
+		}
+
+		op, err := storetypes.CommitmentOpDecoder(pop)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(op.GetKey(), value.Key) {
+			return fmt.Errorf("proof op key %X does not match key %X of store %s", op.GetKey(), value.Key, value.StoreName)
+		}
+
+		// an absence proof is run without any value
+		var args [][]byte
+		if value.Exists {
+			args = [][]byte{value.Value}
+		}
+
+		storeRoot, err := op.Run(args)
+		if err != nil {
+			return fmt.Errorf("invalid proof for key %X of store %s: %w", value.Key, value.StoreName, err)
+		}
+
+		root, err := storeOps[value.StoreName].Run(storeRoot)
+		if err != nil {
+			return fmt.Errorf("invalid proof for store %s: %w", value.StoreName, err)
+		}
+		if !bytes.Equal(root[0], appHash) {
+			return fmt.Errorf("proof for key %X of store %s does not match app hash %X", value.Key, value.StoreName, appHash)
+		}
+	}
+
+	return nil
+}
@@ -6,10 +6,13 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	dbm "github.com/tendermint/tm-db"
 
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestVerifyIAVLStoreQueryProof(t *testing.T) {
@@ -147,3 +150,94 @@ func TestVerifyMultiStoreQueryProofAbsence(t *testing.T) {
 	err = prt.VerifyValue(res.ProofOps, cid.Hash, "/iavlStoreKey/MYABSENTKEY", []byte(""))
 	require.NotNil(t, err)
 }
+
+func TestVerifyBatchKeysProof(t *testing.T) {
+	store := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, store.LoadLatestVersion())
+
+	store.GetStoreByName("store1").(types.KVStore).Set([]byte("k1"), []byte("v1"))
+	store.GetStoreByName("store2").(types.KVStore).Set([]byte("k2"), []byte("v2"))
+	store.GetStoreByName("store2").(types.KVStore).Set([]byte("k3"), []byte("v3"))
+	cid := store.Commit()
+	store.GetStoreByName("store1").(types.KVStore).Set([]byte("k1"), []byte("v1-new"))
+	store.Commit()
+
+	query := types.BatchKeysQuery{Keys: []types.StoreKeyQuery{
+		{StoreName: "store2", Key: []byte("k2")},
+		{StoreName: "store1", Key: []byte("k1")},
+		{StoreName: "store2", Key: []byte("absent")},
+		{StoreName: "store2", Key: []byte("k3")},
+	}}
+	bz, err := query.Marshal()
+	require.NoError(t, err)
+
+	res := store.Query(abci.RequestQuery{Path: QueryPathBatchKeys, Data: bz, Height: cid.Version, Prove: true})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, cid.Version, res.Height)
+	require.Len(t, res.ProofOps.Ops, 6)
+
+	var result types.BatchKeysResult
+	require.NoError(t, result.Unmarshal(res.Value))
+	require.Equal(t, []types.StoreKeyValue{
+		{StoreName: "store2", Key: []byte("k2"), Value: []byte("v2"), Exists: true},
+		{StoreName: "store1", Key: []byte("k1"), Value: []byte("v1"), Exists: true},
+		{StoreName: "store2", Key: []byte("absent")},
+		{StoreName: "store2", Key: []byte("k3"), Value: []byte("v3"), Exists: true},
+	}, result.Values)
+
+	require.NoError(t, VerifyBatchKeysProof(cid.Hash, query.Keys, result, res.ProofOps))
+
+	// Verify (bad) app hash.
+	require.Error(t, VerifyBatchKeysProof(store.LastCommitID().Hash, query.Keys, result, res.ProofOps))
+
+	// Verify (bad) values.
+	tampered := types.BatchKeysResult{Values: append([]types.StoreKeyValue(nil), result.Values...)}
+	tampered.Values[1].Value = []byte("v1-new")
+	require.Error(t, VerifyBatchKeysProof(cid.Hash, query.Keys, tampered, res.ProofOps))
+
+	tampered.Values[1] = result.Values[1]
+	tampered.Values[2] = types.StoreKeyValue{StoreName: "store2", Key: []byte("absent"), Value: []byte{}, Exists: true}
+	require.Error(t, VerifyBatchKeysProof(cid.Hash, query.Keys, tampered, res.ProofOps))
+
+	tampered.Values[2] = types.StoreKeyValue{StoreName: "store2", Key: []byte("k2")}
+	require.Error(t, VerifyBatchKeysProof(cid.Hash, query.Keys, tampered, res.ProofOps))
+
+	// Verify (bad) keys: a valid proof for other keys than the requested ones is
+	// rejected.
+	otherQuery := types.BatchKeysQuery{Keys: []types.StoreKeyQuery{{StoreName: "store1", Key: []byte("k1")}}}
+	otherBz, err := otherQuery.Marshal()
+	require.NoError(t, err)
+	otherRes := store.Query(abci.RequestQuery{Path: QueryPathBatchKeys, Data: otherBz, Height: cid.Version, Prove: true})
+	require.True(t, otherRes.IsOK(), otherRes.Log)
+	var otherResult types.BatchKeysResult
+	require.NoError(t, otherResult.Unmarshal(otherRes.Value))
+	require.NoError(t, VerifyBatchKeysProof(cid.Hash, otherQuery.Keys, otherResult, otherRes.ProofOps))
+
+	requested := []types.StoreKeyQuery{{StoreName: "store2", Key: []byte("k1")}}
+	require.Error(t, VerifyBatchKeysProof(cid.Hash, requested, otherResult, otherRes.ProofOps))
+	requested = []types.StoreKeyQuery{{StoreName: "store1", Key: []byte("k2")}}
+	require.Error(t, VerifyBatchKeysProof(cid.Hash, requested, otherResult, otherRes.ProofOps))
+	require.Error(t, VerifyBatchKeysProof(cid.Hash, query.Keys, otherResult, otherRes.ProofOps))
+
+	// Verify (bad) proof ops.
+	require.Error(t, VerifyBatchKeysProof(cid.Hash, query.Keys, result, nil))
+	require.Error(t, VerifyBatchKeysProof(cid.Hash, query.Keys, result, &tmcrypto.ProofOps{Ops: res.ProofOps.Ops[:5]}))
+
+	// Without proof, the latest height is queried by default.
+	res = store.Query(abci.RequestQuery{Path: QueryPathBatchKeys, Data: bz})
+	require.True(t, res.IsOK(), res.Log)
+	require.Nil(t, res.ProofOps)
+	var latest types.BatchKeysResult
+	require.NoError(t, latest.Unmarshal(res.Value))
+	require.Equal(t, []byte("v1-new"), latest.Values[1].Value)
+
+	// Unknown store.
+	bz, err = (&types.BatchKeysQuery{Keys: []types.StoreKeyQuery{{StoreName: "unknown", Key: []byte("k1")}}}).Marshal()
+	require.NoError(t, err)
+	res = store.Query(abci.RequestQuery{Path: QueryPathBatchKeys, Data: bz, Prove: true})
+	require.EqualValues(t, sdkerrors.ErrUnknownRequest.ABCICode(), res.Code)
+
+	// No keys.
+	res = store.Query(abci.RequestQuery{Path: QueryPathBatchKeys, Prove: true})
+	require.EqualValues(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.Code)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/pruning"
@@ -105,6 +106,64 @@ func (rs *Store) SetSnapshotInterval(snapshotInterval uint64) {
 	rs.pruningManager.SetSnapshotInterval(snapshotInterval)
 }
 
+// PinVersions prevents the versions of the given range from being pruned until
+// they are unpinned with UnpinVersions, e.g. while an indexer is reading them.
+func (rs *Store) PinVersions(r pruningtypes.HeightRange) error {
+	return rs.pruningManager.PinHeights(r)
+}
+
+// UnpinVersions removes a range of versions pinned with PinVersions.
+func (rs *Store) UnpinVersions(r pruningtypes.HeightRange) error {
+	return rs.pruningManager.UnpinHeights(r)
+}
+
+// PinnedVersions returns the ranges of versions pinned with PinVersions.
+func (rs *Store) PinnedVersions() []pruningtypes.HeightRange {
+	return rs.pruningManager.GetPinnedHeights()
+}
+
+// ListVersions returns the ranges of consecutive versions available on disk
+// for every IAVL store, by store name.
+func (rs *Store) ListVersions() map[string][]pruningtypes.HeightRange {
+	versions := make(map[string][]pruningtypes.HeightRange)
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		allVersions := rs.GetCommitKVStore(key).(*iavl.Store).GetAllVersions()
+		heights := make([]int64, len(allVersions))
+		for i, v := range allVersions {
+			heights[i] = int64(v)
+		}
+		versions[key.Name()] = pruningtypes.NewHeightRanges(heights)
+	}
+
+	return versions
+}
+
+// VersionExists returns true if the version is available on disk in at least
+// one IAVL store, or if there is no IAVL store. Stores mounted by an upgrade
+// do not have the versions preceding the upgrade, so a version missing from
+// every store was either pruned or precedes the initial version.
+func (rs *Store) VersionExists(version int64) bool {
+	hasIAVL := false
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+
+		hasIAVL = true
+		if rs.GetCommitKVStore(key).(*iavl.Store).VersionExists(version) {
+			return true
+		}
+	}
+
+	return !hasIAVL
+}
+
 func (rs *Store) SetIAVLCacheSize(cacheSize int) {
 	rs.iavlCacheSize = cacheSize
 }
@@ -624,22 +683,22 @@ func (rs *Store) GetStoreByName(name string) types.Store {
 // Query calls substore.Query with the same `req` where `req.Path` is
 // modified to remove the substore prefix.
 // Ie. `req.Path` here is `/<substore>/<path>`, and trimmed to `/<path>` for the substore.
+// The QueryPathBatchKeys path is answered by the multistore itself, see queryBatchKeys.
 // TODO: add proof for `multistore -> substore`.
 func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 	path := req.Path
+	if path == QueryPathBatchKeys {
+		return rs.queryBatchKeys(req)
+	}
+
 	storeName, subpath, err := parsePath(path)
 	if err != nil {
 		return sdkerrors.QueryResult(err, false)
 	}
 
-	store := rs.GetStoreByName(storeName)
-	if store == nil {
-		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no such store: %s", storeName), false)
-	}
-
-	queryable, ok := store.(types.Queryable)
-	if !ok {
-		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "store %s (type %T) doesn't support queries", storeName, store), false)
+	queryable, err := rs.getQueryableByName(storeName)
+	if err != nil {
+		return sdkerrors.QueryResult(err, false)
 	}
 
 	// trim the path and make the query
@@ -654,26 +713,138 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proof is unexpectedly empty; ensure height has not been pruned"), false)
 	}
 
-	// If the request's height is the latest height we've committed, then utilize
-	// the store's lastCommitInfo as this commit info may not be flushed to disk.
-	// Otherwise, we query for the commit info from disk.
-	var commitInfo *types.CommitInfo
+	commitInfo, err := rs.getCommitInfoAt(res.Height)
+	if err != nil {
+		return sdkerrors.QueryResult(err, false)
+	}
 
-	if res.Height == rs.lastCommitInfo.Version {
-		commitInfo = rs.lastCommitInfo
-	} else {
-		commitInfo, err = getCommitInfo(rs.db, res.Height)
+	// Restore origin path and append proof op.
+	res.ProofOps.Ops = append(res.ProofOps.Ops, commitInfo.ProofOp(storeName))
+
+	return res
+}
+
+// queryBatchKeys answers a BatchKeysQuery by querying the value of every key at
+// the same height. When a proof is requested, the proof ops of the response are
+// the IAVL existence or absence proof of every key, in the order of the keys,
+// followed by the proof of every queried store against the app hash, in the
+// order in which the stores first appear in the keys. See VerifyBatchKeysProof.
+func (rs *Store) queryBatchKeys(req abci.RequestQuery) abci.ResponseQuery {
+	var query types.BatchKeysQuery
+	if err := query.Unmarshal(req.Data); err != nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error()), false)
+	}
+	if len(query.Keys) == 0 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no keys to query"), false)
+	}
+
+	// all the keys are queried at the same height, the latest one by default.
+	height := req.Height
+	if height == 0 {
+		height = rs.LastCommitID().Version
+	}
+
+	var (
+		result     = types.BatchKeysResult{Values: make([]types.StoreKeyValue, 0, len(query.Keys))}
+		keyOps     = make([]tmcrypto.ProofOp, 0, len(query.Keys))
+		storeNames []string
+	)
+	for _, k := range query.Keys {
+		queryable, err := rs.getQueryableByName(k.StoreName)
 		if err != nil {
 			return sdkerrors.QueryResult(err, false)
 		}
+
+		res := queryable.Query(abci.RequestQuery{Path: "/key", Data: k.Key, Height: height, Prove: req.Prove})
+		if !res.IsOK() {
+			return res
+		}
+		if res.Log != "" {
+			return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "store %s at height %d: %s", k.StoreName, height, res.Log), false)
+		}
+
+		if req.Prove {
+			if res.ProofOps == nil || len(res.ProofOps.Ops) != 1 {
+				return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "store %s did not return a single proof op; ensure height has not been pruned", k.StoreName), false)
+			}
+			keyOps = append(keyOps, res.ProofOps.Ops[0])
+		}
+
+		if !containsString(storeNames, k.StoreName) {
+			storeNames = append(storeNames, k.StoreName)
+		}
+
+		result.Values = append(result.Values, types.StoreKeyValue{
+			StoreName: k.StoreName,
+			Key:       k.Key,
+			Value:     res.Value,
+			Exists:    res.Value != nil,
+		})
 	}
 
-	// Restore origin path and append proof op.
-	res.ProofOps.Ops = append(res.ProofOps.Ops, commitInfo.ProofOp(storeName))
+	bz, err := result.Marshal()
+	if err != nil {
+		return sdkerrors.QueryResult(err, false)
+	}
+
+	res := abci.ResponseQuery{
+		Key:    req.Data,
+		Value:  bz,
+		Height: height,
+	}
+	if !req.Prove {
+		return res
+	}
+
+	commitInfo, err := rs.getCommitInfoAt(height)
+	if err != nil {
+		return sdkerrors.QueryResult(err, false)
+	}
+
+	for _, storeName := range storeNames {
+		keyOps = append(keyOps, commitInfo.ProofOp(storeName))
+	}
+	res.ProofOps = &tmcrypto.ProofOps{Ops: keyOps}
 
 	return res
 }
 
+// getQueryableByName returns the store with the given name if it supports queries.
+func (rs *Store) getQueryableByName(storeName string) (types.Queryable, error) {
+	store := rs.GetStoreByName(storeName)
+	if store == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no such store: %s", storeName)
+	}
+
+	queryable, ok := store.(types.Queryable)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "store %s (type %T) doesn't support queries", storeName, store)
+	}
+
+	return queryable, nil
+}
+
+// getCommitInfoAt returns the commit info of the given height.
+func (rs *Store) getCommitInfoAt(height int64) (*types.CommitInfo, error) {
+	// If the request's height is the latest height we've committed, then utilize
+	// the store's lastCommitInfo as this commit info may not be flushed to disk.
+	// Otherwise, we query for the commit info from disk.
+	if rs.lastCommitInfo != nil && height == rs.lastCommitInfo.Version {
+		return rs.lastCommitInfo, nil
+	}
+
+	return getCommitInfo(rs.db, height)
+}
+
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
 // SetInitialVersion sets the initial version of the IAVL tree. It is used when
 // starting a new chain at an arbitrary height.
 func (rs *Store) SetInitialVersion(version int64) error {
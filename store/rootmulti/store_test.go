@@ -507,6 +507,37 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_ListVersions(t *testing.T) {
+	ms := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewCustomPruningOptions(2, 10))
+	ms.SetSnapshotInterval(4)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.NoError(t, ms.PinVersions(pruningtypes.HeightRange{Start: 6, End: 6}))
+
+	for i := 0; i < 10; i++ {
+		ms.Commit()
+	}
+
+	// 4 and 8 are snapshot heights, 6 is pinned and 8 to 10 are kept recent
+	expected := []pruningtypes.HeightRange{{Start: 4, End: 4}, {Start: 6, End: 6}, {Start: 8, End: 10}}
+	versions := ms.ListVersions()
+	require.Len(t, versions, 3)
+	for _, key := range []types.StoreKey{testStoreKey1, testStoreKey2, testStoreKey3} {
+		require.Equal(t, expected, versions[key.Name()])
+	}
+	require.Equal(t, []pruningtypes.HeightRange{{Start: 6, End: 6}}, ms.PinnedVersions())
+
+	require.False(t, ms.VersionExists(3))
+	require.True(t, ms.VersionExists(6))
+	require.True(t, ms.VersionExists(10))
+	require.False(t, ms.VersionExists(11))
+
+	require.NoError(t, ms.UnpinVersions(pruningtypes.HeightRange{Start: 6, End: 6}))
+	for i := 0; i < 10; i++ {
+		ms.Commit()
+	}
+	require.False(t, ms.VersionExists(6))
+}
+
 func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	const (
 		numVersions int64  = 10
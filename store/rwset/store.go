@@ -0,0 +1,180 @@
+package rwset
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+type (
+	// Store implements the KVStore interface and records the read and write
+	// sets of the operations performed on it. Reads are the keys passed to Get
+	// and Has and the domains of the opened iterators, writes are the keys
+	// passed to Set and Delete. All calls are delegated to the parent KVStore.
+	//
+	// A Store is not safe for concurrent use.
+	Store struct {
+		parent types.KVStore
+		reads  KeySet
+		ranges []Range
+		writes KeySet
+	}
+
+	// KeySet is a set of store keys.
+	KeySet map[string]struct{}
+
+	// Range is the domain [Start, End) of an iterator. A nil Start or End
+	// leaves the range unbounded on that side.
+	Range struct {
+		Start []byte
+		End   []byte
+	}
+)
+
+// NewStore returns a reference to a new recording Store given a parent
+// KVStore implementation.
+func NewStore(parent types.KVStore) *Store {
+	return &Store{
+		parent: parent,
+		reads:  make(KeySet),
+		writes: make(KeySet),
+	}
+}
+
+// Get implements the KVStore interface. It records a read of key and
+// delegates the Get call to the parent KVStore.
+func (s *Store) Get(key []byte) []byte {
+	s.reads.Add(key)
+	return s.parent.Get(key)
+}
+
+// Set implements the KVStore interface. It records a write of key and
+// delegates the Set call to the parent KVStore.
+func (s *Store) Set(key []byte, value []byte) {
+	types.AssertValidKey(key)
+	s.writes.Add(key)
+	s.parent.Set(key, value)
+}
+
+// Delete implements the KVStore interface. It records a write of key and
+// delegates the Delete call to the parent KVStore.
+func (s *Store) Delete(key []byte) {
+	s.writes.Add(key)
+	s.parent.Delete(key)
+}
+
+// Has implements the KVStore interface. It records a read of key and
+// delegates the Has call to the parent KVStore.
+func (s *Store) Has(key []byte) bool {
+	s.reads.Add(key)
+	return s.parent.Has(key)
+}
+
+// Iterator implements the KVStore interface. It records a read of the
+// iterated domain and delegates the Iterator call to the parent KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	s.ranges = append(s.ranges, Range{Start: copyBytes(start), End: copyBytes(end)})
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface. It records a read of the
+// iterated domain and delegates the ReverseIterator call to the parent KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	s.ranges = append(s.ranges, Range{Start: copyBytes(start), End: copyBytes(end)})
+	return s.parent.ReverseIterator(start, end)
+}
+
+// Reads returns the keys read through Get and Has.
+func (s *Store) Reads() KeySet {
+	return s.reads
+}
+
+// Ranges returns the domains of all the iterators opened on the store.
+func (s *Store) Ranges() []Range {
+	return s.ranges
+}
+
+// Writes returns the keys written through Set and Delete.
+func (s *Store) Writes() KeySet {
+	return s.writes
+}
+
+// ConflictsWith returns true if any key written in writes was read from the
+// store, either directly or as part of an iterated range.
+func (s *Store) ConflictsWith(writes KeySet) bool {
+	if len(writes) == 0 {
+		return false
+	}
+
+	for key := range s.reads {
+		if _, ok := writes[key]; ok {
+			return true
+		}
+	}
+
+	for _, r := range s.ranges {
+		for key := range writes {
+			if r.Contains([]byte(key)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. It panics because a Store
+// cannot be branched.
+func (s *Store) CacheWrap() types.CacheWrap {
+	panic("cannot CacheWrap a read/write set KVStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface. It panics as a
+// Store cannot be branched.
+func (s *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace a read/write set KVStore")
+}
+
+// Add inserts key into the set.
+func (ks KeySet) Add(key []byte) {
+	ks[string(key)] = struct{}{}
+}
+
+// Has returns true if key is in the set.
+func (ks KeySet) Has(key []byte) bool {
+	_, ok := ks[string(key)]
+	return ok
+}
+
+// Merge inserts all the keys of other into the set.
+func (ks KeySet) Merge(other KeySet) {
+	for key := range other {
+		ks[key] = struct{}{}
+	}
+}
+
+// Contains returns true if key falls within the range.
+func (r Range) Contains(key []byte) bool {
+	if r.Start != nil && bytes.Compare(key, r.Start) < 0 {
+		return false
+	}
+
+	return r.End == nil || bytes.Compare(key, r.End) < 0
+}
+
+func copyBytes(bz []byte) []byte {
+	if bz == nil {
+		return nil
+	}
+
+	return append([]byte{}, bz...)
+}
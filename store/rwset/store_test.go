@@ -0,0 +1,142 @@
+package rwset_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/rwset"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keyFmt(i int) []byte { return bz(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return bz(fmt.Sprintf("value%0.8d", i)) }
+
+func newParent(n int) dbadapter.Store {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := 0; i < n; i++ {
+		parent.Set(keyFmt(i), valFmt(i))
+	}
+
+	return parent
+}
+
+func TestStoreRecordsReadWriteSets(t *testing.T) {
+	store := rwset.NewStore(newParent(10))
+
+	require.Equal(t, valFmt(1), store.Get(keyFmt(1)))
+	require.True(t, store.Has(keyFmt(2)))
+	store.Set(keyFmt(3), valFmt(30))
+	store.Delete(keyFmt(4))
+
+	iter := store.Iterator(keyFmt(5), keyFmt(7))
+	require.NoError(t, iter.Close())
+	iter = store.ReverseIterator(keyFmt(9), nil)
+	require.NoError(t, iter.Close())
+
+	require.Equal(t, rwset.KeySet{string(keyFmt(1)): {}, string(keyFmt(2)): {}}, store.Reads())
+	require.Equal(t, rwset.KeySet{string(keyFmt(3)): {}, string(keyFmt(4)): {}}, store.Writes())
+	require.Equal(t, []rwset.Range{{Start: keyFmt(5), End: keyFmt(7)}, {Start: keyFmt(9)}}, store.Ranges())
+	require.Equal(t, valFmt(30), store.Get(keyFmt(3)))
+}
+
+func TestStoreConflictsWith(t *testing.T) {
+	store := rwset.NewStore(newParent(10))
+	store.Get(keyFmt(1))
+	store.Set(keyFmt(2), valFmt(2))
+	store.Iterator(keyFmt(5), keyFmt(7)).Close()
+
+	testCases := []struct {
+		name     string
+		writes   []int
+		conflict bool
+	}{
+		{"no writes", nil, false},
+		{"read key", []int{1}, true},
+		{"written key", []int{2}, false},
+		{"range start", []int{5}, true},
+		{"within range", []int{6}, true},
+		{"range end", []int{7}, false},
+		{"unrelated keys", []int{0, 3, 8}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			writes := make(rwset.KeySet)
+			for _, i := range tc.writes {
+				writes.Add(keyFmt(i))
+			}
+
+			require.Equal(t, tc.conflict, store.ConflictsWith(writes))
+		})
+	}
+}
+
+func TestRangeContains(t *testing.T) {
+	require.True(t, rwset.Range{}.Contains(keyFmt(1)))
+	require.True(t, rwset.Range{Start: keyFmt(1)}.Contains(keyFmt(1)))
+	require.False(t, rwset.Range{Start: keyFmt(2)}.Contains(keyFmt(1)))
+	require.True(t, rwset.Range{End: keyFmt(2)}.Contains(keyFmt(1)))
+	require.False(t, rwset.Range{End: keyFmt(2)}.Contains(keyFmt(2)))
+}
+
+func TestSyncStoreIterator(t *testing.T) {
+	// enough items for the iterators to read several batches
+	n := 300
+	store := rwset.NewSyncStore(cachekv.NewStore(newParent(n)))
+
+	iter := store.Iterator(nil, nil)
+	for i := 0; i < n; i++ {
+		require.True(t, iter.Valid())
+		require.Equal(t, keyFmt(i), iter.Key())
+		require.Equal(t, valFmt(i), iter.Value())
+		iter.Next()
+	}
+	require.False(t, iter.Valid())
+	require.Panics(t, iter.Next)
+	require.NoError(t, iter.Close())
+
+	iter = store.ReverseIterator(keyFmt(10), keyFmt(290))
+	for i := 289; i >= 10; i-- {
+		require.True(t, iter.Valid())
+		require.Equal(t, keyFmt(i), iter.Key())
+		iter.Next()
+	}
+	require.False(t, iter.Valid())
+
+	start, end := iter.Domain()
+	require.Equal(t, keyFmt(10), start)
+	require.Equal(t, keyFmt(290), end)
+	require.NoError(t, iter.Close())
+}
+
+func TestSyncStoreConcurrentAccess(t *testing.T) {
+	n := 300
+	store := rwset.NewSyncStore(cachekv.NewStore(newParent(n)))
+
+	done := make(chan struct{})
+	for w := 0; w < 4; w++ {
+		go func(w int) {
+			defer func() { done <- struct{}{} }()
+
+			iter := store.Iterator(nil, nil)
+			defer iter.Close()
+
+			for i := 0; iter.Valid(); iter.Next() {
+				// open other iterators and read keys while iterating
+				store.ReverseIterator(nil, keyFmt(i)).Close()
+				store.Get(keyFmt(i))
+				i++
+			}
+		}(w)
+	}
+
+	for w := 0; w < 4; w++ {
+		<-done
+	}
+}
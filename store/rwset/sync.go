@@ -0,0 +1,201 @@
+package rwset
+
+import (
+	"io"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// syncIteratorBatchSize is the number of items a SyncStore iterator reads from
+// its parent at once.
+const syncIteratorBatchSize = 128
+
+var _ types.KVStore = &SyncStore{}
+
+// SyncStore implements the KVStore interface and serializes all the calls to
+// its parent KVStore, allowing a store which is not safe for concurrent use,
+// e.g. a cachekv.Store, to be shared by several goroutines.
+//
+// Iterators never keep a parent iterator open between calls: items are read
+// in batches while holding the lock, so iterating one SyncStore iterator does
+// not block writers of the parent waiting on another one.
+type SyncStore struct {
+	mtx    sync.Mutex
+	parent types.KVStore
+}
+
+// NewSyncStore returns a reference to a new SyncStore given a parent KVStore
+// implementation.
+func NewSyncStore(parent types.KVStore) *SyncStore {
+	return &SyncStore{parent: parent}
+}
+
+// Get implements the KVStore interface. The returned value is a copy, hence
+// it is never shared with other users of the store.
+func (s *SyncStore) Get(key []byte) []byte {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return copyBytes(s.parent.Get(key))
+}
+
+// Set implements the KVStore interface.
+func (s *SyncStore) Set(key []byte, value []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.parent.Set(key, value)
+}
+
+// Delete implements the KVStore interface.
+func (s *SyncStore) Delete(key []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.parent.Delete(key)
+}
+
+// Has implements the KVStore interface.
+func (s *SyncStore) Has(key []byte) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.parent.Has(key)
+}
+
+// Iterator implements the KVStore interface.
+func (s *SyncStore) Iterator(start, end []byte) types.Iterator {
+	return newSyncIterator(s, start, end, true)
+}
+
+// ReverseIterator implements the KVStore interface.
+func (s *SyncStore) ReverseIterator(start, end []byte) types.Iterator {
+	return newSyncIterator(s, start, end, false)
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *SyncStore) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. It panics because a SyncStore
+// cannot be branched.
+func (s *SyncStore) CacheWrap() types.CacheWrap {
+	panic("cannot CacheWrap a SyncStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface. It panics as a
+// SyncStore cannot be branched.
+func (s *SyncStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace a SyncStore")
+}
+
+// syncIterator iterates over a SyncStore by reading batches of items from
+// short-lived parent iterators.
+type syncIterator struct {
+	store     *SyncStore
+	start     []byte
+	end       []byte
+	ascending bool
+
+	items     []kv.Pair
+	exhausted bool
+}
+
+func newSyncIterator(store *SyncStore, start, end []byte, ascending bool) *syncIterator {
+	it := &syncIterator{
+		store:     store,
+		start:     copyBytes(start),
+		end:       copyBytes(end),
+		ascending: ascending,
+	}
+	it.fill(start, end)
+
+	return it
+}
+
+// fill reads the next batch of items within [start, end) from the parent.
+func (it *syncIterator) fill(start, end []byte) {
+	it.store.mtx.Lock()
+	defer it.store.mtx.Unlock()
+
+	var parent types.Iterator
+	if it.ascending {
+		parent = it.store.parent.Iterator(start, end)
+	} else {
+		parent = it.store.parent.ReverseIterator(start, end)
+	}
+	defer parent.Close()
+
+	it.items = it.items[:0]
+	for ; parent.Valid() && len(it.items) < syncIteratorBatchSize; parent.Next() {
+		it.items = append(it.items, kv.Pair{
+			Key:   copyBytes(parent.Key()),
+			Value: copyBytes(parent.Value()),
+		})
+	}
+
+	it.exhausted = !parent.Valid()
+}
+
+// Domain implements the Iterator interface.
+func (it *syncIterator) Domain() (start []byte, end []byte) {
+	return it.start, it.end
+}
+
+// Valid implements the Iterator interface.
+func (it *syncIterator) Valid() bool {
+	return len(it.items) > 0
+}
+
+// Next implements the Iterator interface.
+func (it *syncIterator) Next() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	last := it.items[0].Key
+	it.items = it.items[1:]
+	if len(it.items) > 0 || it.exhausted {
+		return
+	}
+
+	if it.ascending {
+		// resume right after the last key returned
+		it.fill(append(last, 0x00), it.end)
+	} else {
+		it.fill(it.start, last)
+	}
+}
+
+// Key implements the Iterator interface.
+func (it *syncIterator) Key() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	return it.items[0].Key
+}
+
+// Value implements the Iterator interface.
+func (it *syncIterator) Value() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	return it.items[0].Value
+}
+
+// Error implements the Iterator interface.
+func (it *syncIterator) Error() error {
+	return nil
+}
+
+// Close implements the Iterator interface.
+func (it *syncIterator) Close() error {
+	it.items = nil
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -27,6 +28,7 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	GRPC
 )
 
 // Streaming option keys
@@ -37,6 +39,13 @@ const (
 	OptStreamersFileStopNodeOnError = "streamers.file.stop-node-on-error"
 	OptStreamersFileFsync           = "streamers.file.fsync"
 
+	OptStreamersGRPCAddress         = "streamers.grpc.address"
+	OptStreamersGRPCBufferSize      = "streamers.grpc.buffer-size"
+	OptStreamersGRPCBackpressure    = "streamers.grpc.backpressure"
+	OptStreamersGRPCAck             = "streamers.grpc.ack"
+	OptStreamersGRPCAckTimeout      = "streamers.grpc.ack-timeout"
+	OptStreamersGRPCStopNodeOnError = "streamers.grpc.stop-node-on-error"
+
 	OptStoreStreamers = "store.streamers"
 )
 
@@ -47,6 +56,9 @@ func ServiceTypeFromString(name string) ServiceType {
 	case "file", "f":
 		return File
 
+	case "grpc":
+		return GRPC
+
 	default:
 		return Unknown
 	}
@@ -58,6 +70,9 @@ func (sst ServiceType) String() string {
 	case File:
 		return "file"
 
+	case GRPC:
+		return "grpc"
+
 	default:
 		return "unknown"
 	}
@@ -67,6 +82,7 @@ func (sst ServiceType) String() string {
 // streaming.ServiceConstructors types.
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
 	File: NewFileStreamingService,
+	GRPC: NewGRPCStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding
@@ -113,6 +129,28 @@ func NewFileStreamingService(
 	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller, outputMetadata, stopNodeOnErr, fsync)
 }
 
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for
+// creating a gRPC StreamingService.
+func NewGRPCStreamingService(
+	opts serverTypes.AppOptions,
+	keys []types.StoreKey,
+	_ codec.BinaryCodec,
+) (baseapp.StreamingService, error) {
+	backpressure, err := grpc.BackpressureFromString(cast.ToString(opts.Get(OptStreamersGRPCBackpressure)))
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc.NewStreamingService(keys, grpc.Config{
+		Address:       cast.ToString(opts.Get(OptStreamersGRPCAddress)),
+		BufferSize:    cast.ToInt(opts.Get(OptStreamersGRPCBufferSize)),
+		Backpressure:  backpressure,
+		AckMode:       cast.ToBool(opts.Get(OptStreamersGRPCAck)),
+		AckTimeout:    cast.ToDuration(opts.Get(OptStreamersGRPCAckTimeout)),
+		StopNodeOnErr: cast.ToBool(opts.Get(OptStreamersGRPCStopNodeOnError)),
+	}), nil
+}
+
 // LoadStreamingServices is a function for loading StreamingServices onto the
 // BaseApp using the provided AppOptions, codec, and keys. It returns the
 // WaitGroup and quit channel used to synchronize with the streaming services
@@ -183,7 +221,14 @@ func LoadStreamingServices(
 		bApp.SetStreamingService(streamingService)
 
 		// kick off the background streaming service loop
-		streamingService.Stream(wg)
+		if err := streamingService.Stream(wg); err != nil {
+			streamingService.Close()
+			for _, activeStreamer := range activeStreamers {
+				activeStreamer.Close()
+			}
+
+			return nil, nil, err
+		}
 
 		// add to the list of active streamers
 		activeStreamers = append(activeStreamers, streamingService)
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/store/streaming"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -54,6 +55,28 @@ func TestStreamingServiceConstructor(t *testing.T) {
 	}
 }
 
+func TestGRPCStreamingServiceConstructor(t *testing.T) {
+	constructor, err := streaming.NewServiceConstructor("grpc")
+	require.Nil(t, err)
+
+	serv, err := constructor(grpcOptions{streaming.OptStreamersGRPCBackpressure: "drop"}, mockKeys, testMarshaller)
+	require.Nil(t, err)
+	require.IsType(t, &grpc.StreamingService{}, serv)
+	listeners := serv.Listeners()
+	for _, key := range mockKeys {
+		_, ok := listeners[key]
+		require.True(t, ok)
+	}
+	require.NoError(t, serv.Close())
+
+	_, err = constructor(grpcOptions{streaming.OptStreamersGRPCBackpressure: "unknown"}, mockKeys, testMarshaller)
+	require.Error(t, err)
+}
+
+type grpcOptions map[string]interface{}
+
+func (o grpcOptions) Get(key string) interface{} { return o[key] }
+
 func TestLoadStreamingServices(t *testing.T) {
 	db := dbm.NewMemDB()
 	encCdc := simapp.MakeTestEncodingConfig()
@@ -0,0 +1,461 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	_ baseapp.StreamingService   = &StreamingService{}
+	_ types.StateStreamingServer = &StreamingService{}
+)
+
+const (
+	// DefaultAddress is the default address the gRPC server listens on.
+	DefaultAddress = "localhost:9095"
+
+	// DefaultBufferSize is the default number of blocks buffered per subscriber.
+	DefaultBufferSize = 100
+)
+
+// Backpressure defines what the StreamingService does when the buffer of a
+// subscriber is full.
+type Backpressure int
+
+const (
+	// BackpressureBlock blocks the ABCI Commit until the subscriber drains its
+	// buffer or disconnects.
+	BackpressureBlock Backpressure = iota
+	// BackpressureDrop drops the block for the subscriber.
+	BackpressureDrop
+)
+
+// BackpressureFromString returns the Backpressure corresponding to the provided
+// name. An empty name returns BackpressureBlock.
+func BackpressureFromString(name string) (Backpressure, error) {
+	switch strings.ToLower(name) {
+	case "", "block":
+		return BackpressureBlock, nil
+
+	case "drop":
+		return BackpressureDrop, nil
+
+	default:
+		return 0, fmt.Errorf("unrecognized backpressure mode %s", name)
+	}
+}
+
+// String returns the string name of a Backpressure.
+func (b Backpressure) String() string {
+	switch b {
+	case BackpressureBlock:
+		return "block"
+
+	case BackpressureDrop:
+		return "drop"
+
+	default:
+		return "unknown"
+	}
+}
+
+// Config defines the configuration of the gRPC StreamingService.
+type Config struct {
+	// Address is the address the gRPC server listens on.
+	Address string
+
+	// BufferSize is the number of blocks buffered for each subscriber.
+	BufferSize int
+
+	// Backpressure defines the behaviour when the buffer of a subscriber is full.
+	Backpressure Backpressure
+
+	// AckMode, if true, makes the ABCI Commit wait until every subscriber the
+	// block was sent to acknowledged it.
+	AckMode bool
+
+	// AckTimeout is the maximum duration to wait for acknowledgements in
+	// AckMode. A zero value waits forever.
+	AckTimeout time.Duration
+
+	// StopNodeOnErr, if true, returns the errors to the ABCI Commit, halting the
+	// node, otherwise they are ignored which could yield data loss for
+	// subscribers.
+	StopNodeOnErr bool
+}
+
+// StreamingService is a concrete implementation of StreamingService that pushes
+// the state changes and ABCI messages of every block to the subscribers of a
+// gRPC server.
+type StreamingService struct {
+	storeListeners []*types.MemoryListener // a series of KVStore listeners for each KVStore
+	storeKeys      map[string]struct{}     // names of the exposed store keys
+	cfg            Config
+
+	currentBlockNumber int64
+	blockMetadata      types.BlockMetadata
+
+	server    *grpclib.Server
+	quit      chan struct{}
+	closeOnce sync.Once
+
+	mtx         sync.Mutex
+	nextID      uint64
+	subscribers map[uint64]*subscriber
+}
+
+// subscriber is a client connected to the Subscribe stream.
+type subscriber struct {
+	id        uint64
+	storeKeys map[string]struct{} // an empty filter subscribes to all exposed store keys
+	blocks    chan *types.StreamBlock
+
+	ackHeight int64         // latest acknowledged height, accessed atomically
+	acked     chan struct{} // signals a new acknowledgement
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewStreamingService returns a gRPC StreamingService exposing the state changes
+// of the given store keys. The gRPC server is only started by Stream.
+func NewStreamingService(storeKeys []types.StoreKey, cfg Config) *StreamingService {
+	// sort storeKeys for deterministic output
+	sort.SliceStable(storeKeys, func(i, j int) bool {
+		return storeKeys[i].Name() < storeKeys[j].Name()
+	})
+
+	listeners := make([]*types.MemoryListener, len(storeKeys))
+	names := make(map[string]struct{}, len(storeKeys))
+	for i, key := range storeKeys {
+		listeners[i] = types.NewMemoryListener(key)
+		names[key.Name()] = struct{}{}
+	}
+
+	if cfg.Address == "" {
+		cfg.Address = DefaultAddress
+	}
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = DefaultBufferSize
+	}
+
+	gss := &StreamingService{
+		storeListeners: listeners,
+		storeKeys:      names,
+		cfg:            cfg,
+		server:         grpclib.NewServer(),
+		quit:           make(chan struct{}),
+		subscribers:    make(map[uint64]*subscriber),
+	}
+	types.RegisterStateStreamingServer(gss.server, gss)
+
+	return gss
+}
+
+// Listeners satisfies the StreamingService interface. It returns the
+// StreamingService's underlying WriteListeners. Use for registering the
+// underlying WriteListeners with the BaseApp.
+func (gss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(gss.storeListeners))
+	for _, listener := range gss.storeListeners {
+		listeners[listener.StoreKey()] = []types.WriteListener{listener}
+	}
+
+	return listeners
+}
+
+// ListenBeginBlock satisfies the ABCIListener interface. It sets the received
+// BeginBlock request, response and the current block number.
+func (gss *StreamingService) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	gss.blockMetadata.RequestBeginBlock = &req
+	gss.blockMetadata.ResponseBeginBlock = &res
+	gss.currentBlockNumber = req.Header.Height
+	return nil
+}
+
+// ListenDeliverTx satisfies the ABCIListener interface. It appends the received
+// DeliverTx request and response to a list of DeliverTxs objects.
+func (gss *StreamingService) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	gss.blockMetadata.DeliverTxs = append(gss.blockMetadata.DeliverTxs, &types.BlockMetadata_DeliverTx{
+		Request:  &req,
+		Response: &res,
+	})
+
+	return nil
+}
+
+// ListenEndBlock satisfies the ABCIListener interface. It sets the received
+// EndBlock request and response.
+func (gss *StreamingService) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	gss.blockMetadata.RequestEndBlock = &req
+	gss.blockMetadata.ResponseEndBlock = &res
+	return nil
+}
+
+// ListenCommit satisfies the ABCIListener interface. It is executed during the
+// ABCI Commit request and is responsible for pushing the block to the
+// subscribers and, in AckMode, waiting for their acknowledgements. It will only
+// return a non-nil error when StopNodeOnErr is set.
+func (gss *StreamingService) ListenCommit(ctx context.Context, res abci.ResponseCommit) error {
+	if err := gss.doListenCommit(res); err != nil {
+		if gss.cfg.StopNodeOnErr {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (gss *StreamingService) doListenCommit(res abci.ResponseCommit) error {
+	metadata := gss.blockMetadata
+	metadata.ResponseCommit = &res
+	gss.blockMetadata = types.BlockMetadata{}
+
+	block := &types.StreamBlock{
+		Height:   gss.currentBlockNumber,
+		Metadata: &metadata,
+	}
+	for _, listener := range gss.storeListeners {
+		cache := listener.PopStateCache()
+		for i := range cache {
+			block.StateChanges = append(block.StateChanges, &cache[i])
+		}
+	}
+
+	gss.mtx.Lock()
+	subscribers := make([]*subscriber, 0, len(gss.subscribers))
+	for _, sub := range gss.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	gss.mtx.Unlock()
+
+	// sort subscribers for a deterministic delivery order
+	sort.Slice(subscribers, func(i, j int) bool { return subscribers[i].id < subscribers[j].id })
+
+	pending := make([]*subscriber, 0, len(subscribers))
+	for _, sub := range subscribers {
+		if gss.publish(sub, filterBlock(block, sub.storeKeys)) {
+			pending = append(pending, sub)
+		}
+	}
+
+	if !gss.cfg.AckMode {
+		return nil
+	}
+
+	return gss.waitAcks(pending, block.Height)
+}
+
+// publish sends the block to the subscriber according to the backpressure
+// mode, returning whether the block was sent.
+func (gss *StreamingService) publish(sub *subscriber, block *types.StreamBlock) bool {
+	if gss.cfg.Backpressure == BackpressureDrop {
+		select {
+		case sub.blocks <- block:
+			return true
+		default:
+			return false
+		}
+	}
+
+	select {
+	case sub.blocks <- block:
+		return true
+	case <-sub.done:
+		return false
+	case <-gss.quit:
+		return false
+	}
+}
+
+// waitAcks waits until all the subscribers acknowledged the block at height
+// or disconnected.
+func (gss *StreamingService) waitAcks(subscribers []*subscriber, height int64) error {
+	var timeout <-chan time.Time
+	if gss.cfg.AckTimeout > 0 {
+		timer := time.NewTimer(gss.cfg.AckTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+subscribers:
+	for _, sub := range subscribers {
+		for atomic.LoadInt64(&sub.ackHeight) < height {
+			select {
+			case <-sub.acked:
+			case <-sub.done:
+				// a disconnected subscriber cannot acknowledge anymore
+				continue subscribers
+			case <-gss.quit:
+				return nil
+			case <-timeout:
+				return fmt.Errorf("subscriber %d did not acknowledge block %d within %s", sub.id, height, gss.cfg.AckTimeout)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Subscribe implements the StateStreaming gRPC service.
+func (gss *StreamingService) Subscribe(stream types.StateStreaming_SubscribeServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	filter := make(map[string]struct{}, len(req.StoreKeys))
+	for _, key := range req.StoreKeys {
+		if _, ok := gss.storeKeys[key]; !ok {
+			return status.Errorf(codes.InvalidArgument, "store key %s is not exposed by the streaming service", key)
+		}
+		filter[key] = struct{}{}
+	}
+
+	sub := gss.subscribe(filter)
+	defer gss.unsubscribe(sub)
+
+	sub.ack(req.AckHeight)
+
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				sub.close()
+				return
+			}
+
+			sub.ack(req.AckHeight)
+		}
+	}()
+
+	for {
+		select {
+		case block := <-sub.blocks:
+			if err := stream.Send(block); err != nil {
+				return err
+			}
+
+		case <-sub.done:
+			return nil
+
+		case <-gss.quit:
+			return nil
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func (gss *StreamingService) subscribe(filter map[string]struct{}) *subscriber {
+	gss.mtx.Lock()
+	defer gss.mtx.Unlock()
+
+	gss.nextID++
+	sub := &subscriber{
+		id:        gss.nextID,
+		storeKeys: filter,
+		blocks:    make(chan *types.StreamBlock, gss.cfg.BufferSize),
+		acked:     make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+	gss.subscribers[sub.id] = sub
+
+	return sub
+}
+
+func (gss *StreamingService) unsubscribe(sub *subscriber) {
+	gss.mtx.Lock()
+	delete(gss.subscribers, sub.id)
+	gss.mtx.Unlock()
+
+	sub.close()
+}
+
+// ack records that the subscriber processed all blocks up to height.
+func (sub *subscriber) ack(height int64) {
+	for {
+		current := atomic.LoadInt64(&sub.ackHeight)
+		if height <= current {
+			return
+		}
+		if atomic.CompareAndSwapInt64(&sub.ackHeight, current, height) {
+			break
+		}
+	}
+
+	select {
+	case sub.acked <- struct{}{}:
+	default:
+	}
+}
+
+func (sub *subscriber) close() {
+	sub.closeOnce.Do(func() { close(sub.done) })
+}
+
+// filterBlock returns the block with only the state changes of the given store
+// keys, or the block itself if no store key is given.
+func filterBlock(block *types.StreamBlock, storeKeys map[string]struct{}) *types.StreamBlock {
+	if len(storeKeys) == 0 {
+		return block
+	}
+
+	filtered := &types.StreamBlock{
+		Height:   block.Height,
+		Metadata: block.Metadata,
+	}
+	for _, kv := range block.StateChanges {
+		if _, ok := storeKeys[kv.StoreKey]; ok {
+			filtered.StateChanges = append(filtered.StateChanges, kv)
+		}
+	}
+
+	return filtered
+}
+
+// Stream satisfies the StreamingService interface. It starts the gRPC server on
+// the configured address in the background.
+func (gss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	lis, err := net.Listen("tcp", gss.cfg.Address)
+	if err != nil {
+		return err
+	}
+
+	gss.serve(lis, wg)
+	return nil
+}
+
+func (gss *StreamingService) serve(lis net.Listener, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = gss.server.Serve(lis)
+	}()
+}
+
+// Close satisfies the StreamingService interface. It disconnects all the
+// subscribers and stops the gRPC server.
+func (gss *StreamingService) Close() error {
+	gss.closeOnce.Do(func() {
+		close(gss.quit)
+		gss.server.Stop()
+	})
+
+	return nil
+}
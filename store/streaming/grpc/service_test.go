@@ -0,0 +1,225 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+
+	mockKey1   = []byte{1, 2, 3}
+	mockValue1 = []byte{3, 2, 1}
+	mockKey2   = []byte{4, 5, 6}
+	mockValue2 = []byte{6, 5, 4}
+)
+
+// setupStreamingService starts a StreamingService on an in-memory listener and
+// returns a client connected to it.
+func setupStreamingService(t *testing.T, cfg Config) (*StreamingService, types.StateStreamingClient) {
+	gss := NewStreamingService([]types.StoreKey{mockStoreKey1, mockStoreKey2}, cfg)
+
+	lis := bufconn.Listen(1 << 20)
+	wg := new(sync.WaitGroup)
+	gss.serve(lis, wg)
+
+	conn, err := grpclib.DialContext(
+		context.Background(), "bufnet",
+		grpclib.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpclib.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		conn.Close()
+		gss.Close()
+		wg.Wait()
+	})
+
+	return gss, types.NewStateStreamingClient(conn)
+}
+
+// subscribe opens a Subscribe stream and waits until the service registered it.
+func subscribe(t *testing.T, gss *StreamingService, client types.StateStreamingClient, storeKeys ...string) types.StateStreaming_SubscribeClient {
+	gss.mtx.Lock()
+	expected := len(gss.subscribers) + 1
+	gss.mtx.Unlock()
+
+	stream, err := client.Subscribe(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&types.SubscribeRequest{StoreKeys: storeKeys}))
+
+	require.Eventually(t, func() bool {
+		gss.mtx.Lock()
+		defer gss.mtx.Unlock()
+		return len(gss.subscribers) == expected
+	}, time.Second, time.Millisecond)
+
+	return stream
+}
+
+// commitBlock feeds the service with the ABCI messages and state changes of a
+// block and returns the result of ListenCommit.
+func commitBlock(t *testing.T, gss *StreamingService, height int64) error {
+	ctx := context.Background()
+	listeners := gss.Listeners()
+
+	require.NoError(t, gss.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, listeners[mockStoreKey1][0].OnWrite(mockStoreKey1, mockKey1, mockValue1, false))
+	require.NoError(t, gss.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte{byte(height)}}, abci.ResponseDeliverTx{}))
+	require.NoError(t, listeners[mockStoreKey2][0].OnWrite(mockStoreKey2, mockKey2, nil, true))
+	require.NoError(t, gss.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+
+	return gss.ListenCommit(ctx, abci.ResponseCommit{Data: []byte{byte(height)}})
+}
+
+func TestStreamingServiceSubscribe(t *testing.T) {
+	gss, client := setupStreamingService(t, Config{})
+
+	all := subscribe(t, gss, client)
+	filtered := subscribe(t, gss, client, mockStoreKey2.Name())
+
+	require.NoError(t, commitBlock(t, gss, 1))
+
+	block, err := all.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), block.Height)
+	require.Equal(t, int64(1), block.Metadata.RequestBeginBlock.Header.Height)
+	require.Len(t, block.Metadata.DeliverTxs, 1)
+	require.Equal(t, []byte{1}, block.Metadata.DeliverTxs[0].Request.Tx)
+	require.Equal(t, int64(1), block.Metadata.RequestEndBlock.Height)
+	require.Equal(t, []byte{1}, block.Metadata.ResponseCommit.Data)
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: mockKey1, Value: mockValue1},
+		{StoreKey: mockStoreKey2.Name(), Key: mockKey2, Delete: true},
+	}, block.StateChanges)
+
+	block, err = filtered.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), block.Height)
+	require.Len(t, block.Metadata.DeliverTxs, 1)
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: mockStoreKey2.Name(), Key: mockKey2, Delete: true},
+	}, block.StateChanges)
+
+	// the ABCI messages of a block are not carried over to the next one
+	require.NoError(t, commitBlock(t, gss, 2))
+	block, err = all.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), block.Height)
+	require.Len(t, block.Metadata.DeliverTxs, 1)
+	require.Equal(t, []byte{2}, block.Metadata.DeliverTxs[0].Request.Tx)
+	require.Len(t, block.StateChanges, 2)
+}
+
+func TestStreamingServiceInvalidStoreKey(t *testing.T) {
+	_, client := setupStreamingService(t, Config{})
+
+	stream, err := client.Subscribe(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&types.SubscribeRequest{StoreKeys: []string{"unknown"}}))
+
+	_, err = stream.Recv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStreamingServiceBackpressure(t *testing.T) {
+	// subscribers are registered without a stream so that nothing drains them
+	t.Run("drop", func(t *testing.T) {
+		gss := NewStreamingService([]types.StoreKey{mockStoreKey1, mockStoreKey2}, Config{BufferSize: 1, Backpressure: BackpressureDrop})
+		sub := gss.subscribe(nil)
+
+		for h := int64(1); h <= 3; h++ {
+			require.NoError(t, commitBlock(t, gss, h))
+		}
+
+		require.Len(t, sub.blocks, 1)
+		require.Equal(t, int64(1), (<-sub.blocks).Height)
+	})
+
+	t.Run("block", func(t *testing.T) {
+		gss := NewStreamingService([]types.StoreKey{mockStoreKey1, mockStoreKey2}, Config{BufferSize: 1})
+		sub := gss.subscribe(nil)
+
+		require.NoError(t, commitBlock(t, gss, 1))
+
+		done := make(chan error, 1)
+		go func() { done <- commitBlock(t, gss, 2) }()
+
+		select {
+		case <-done:
+			t.Fatal("commit should block until the subscriber drains its buffer")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		require.Equal(t, int64(1), (<-sub.blocks).Height)
+		require.NoError(t, <-done)
+		require.Equal(t, int64(2), (<-sub.blocks).Height)
+
+		// a disconnected subscriber does not block the commit anymore
+		require.NoError(t, commitBlock(t, gss, 3))
+		gss.unsubscribe(sub)
+		require.NoError(t, commitBlock(t, gss, 4))
+	})
+}
+
+func TestStreamingServiceAckMode(t *testing.T) {
+	gss, client := setupStreamingService(t, Config{AckMode: true})
+	stream := subscribe(t, gss, client)
+
+	done := make(chan error, 1)
+	go func() { done <- commitBlock(t, gss, 1) }()
+
+	block, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), block.Height)
+
+	select {
+	case <-done:
+		t.Fatal("commit should wait for the acknowledgement")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.NoError(t, stream.Send(&types.SubscribeRequest{AckHeight: block.Height}))
+	require.NoError(t, <-done)
+
+	// closing the stream releases the commit
+	go func() { done <- commitBlock(t, gss, 2) }()
+	_, err = stream.Recv()
+	require.NoError(t, err)
+	require.NoError(t, stream.CloseSend())
+	require.NoError(t, <-done)
+}
+
+func TestStreamingServiceAckTimeout(t *testing.T) {
+	for _, stopNodeOnErr := range []bool{true, false} {
+		gss, client := setupStreamingService(t, Config{AckMode: true, AckTimeout: 20 * time.Millisecond, StopNodeOnErr: stopNodeOnErr})
+		stream := subscribe(t, gss, client)
+
+		err := commitBlock(t, gss, 1)
+		if stopNodeOnErr {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+
+		_, err = stream.Recv()
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,772 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/store/v1beta1/streaming.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribeRequest is the request type of the StateStreaming/Subscribe RPC.
+type SubscribeRequest struct {
+	// store_keys filters the state changes sent to the subscriber. An empty list
+	// subscribes to all the store keys exposed by the streaming service. Only
+	// read from the first request of a stream.
+	StoreKeys []string `protobuf:"bytes,1,rep,name=store_keys,json=storeKeys,proto3" json:"store_keys,omitempty"`
+	// ack_height acknowledges that the block at this height was processed.
+	AckHeight int64 `protobuf:"varint,2,opt,name=ack_height,json=ackHeight,proto3" json:"ack_height,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20155f3e7501d264, []int{0}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetStoreKeys() []string {
+	if m != nil {
+		return m.StoreKeys
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetAckHeight() int64 {
+	if m != nil {
+		return m.AckHeight
+	}
+	return 0
+}
+
+// StreamBlock contains the state changes and the ABCI messages of a block.
+type StreamBlock struct {
+	Height       int64          `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Metadata     *BlockMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	StateChanges []*StoreKVPair `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *StreamBlock) Reset()         { *m = StreamBlock{} }
+func (m *StreamBlock) String() string { return proto.CompactTextString(m) }
+func (*StreamBlock) ProtoMessage()    {}
+func (*StreamBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20155f3e7501d264, []int{1}
+}
+func (m *StreamBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBlock.Merge(m, src)
+}
+func (m *StreamBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamBlock proto.InternalMessageInfo
+
+func (m *StreamBlock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StreamBlock) GetMetadata() *BlockMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *StreamBlock) GetStateChanges() []*StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "cosmos.base.store.v1beta1.SubscribeRequest")
+	proto.RegisterType((*StreamBlock)(nil), "cosmos.base.store.v1beta1.StreamBlock")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/store/v1beta1/streaming.proto", fileDescriptor_20155f3e7501d264)
+}
+
+var fileDescriptor_20155f3e7501d264 = []byte{
+	// 339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xfb, 0x40,
+	0x10, 0xc6, 0xbb, 0xff, 0x40, 0xf9, 0x67, 0xab, 0x22, 0x39, 0x48, 0x2d, 0x18, 0x4a, 0x0f, 0x12,
+	0x11, 0x37, 0xb6, 0xbe, 0x41, 0xf5, 0x20, 0x14, 0xa1, 0x24, 0xe0, 0xc1, 0x4b, 0xd9, 0x6c, 0x87,
+	0x24, 0xa4, 0xe9, 0xd6, 0xcc, 0xb4, 0xd0, 0xb7, 0xf0, 0x71, 0x7c, 0x04, 0x8f, 0x3d, 0x7a, 0x94,
+	0xf6, 0x45, 0xa4, 0xdb, 0x98, 0x83, 0x60, 0x4e, 0xcb, 0xcc, 0xfe, 0xbe, 0x8f, 0x99, 0x6f, 0xf8,
+	0x95, 0xd2, 0x98, 0x6b, 0xf4, 0x23, 0x89, 0xe0, 0x23, 0xe9, 0x02, 0xfc, 0x55, 0x3f, 0x02, 0x92,
+	0x7d, 0x1f, 0xa9, 0x00, 0x99, 0xa7, 0xf3, 0x58, 0x2c, 0x0a, 0x4d, 0xda, 0x39, 0x3f, 0xa0, 0x62,
+	0x8f, 0x0a, 0x83, 0x8a, 0x12, 0xed, 0xd4, 0xb8, 0xcc, 0x52, 0x24, 0x98, 0x57, 0x2e, 0xbd, 0x31,
+	0x3f, 0x0d, 0x97, 0x11, 0xaa, 0x22, 0x8d, 0x20, 0x80, 0xd7, 0x25, 0x20, 0x39, 0x17, 0x9c, 0x1b,
+	0xd1, 0x24, 0x83, 0x35, 0xb6, 0x59, 0xd7, 0xf2, 0xec, 0xc0, 0x36, 0x9d, 0x11, 0xac, 0x71, 0xff,
+	0x2d, 0x55, 0x36, 0x49, 0x20, 0x8d, 0x13, 0x6a, 0xff, 0xeb, 0x32, 0xcf, 0x0a, 0x6c, 0xa9, 0xb2,
+	0x47, 0xd3, 0xe8, 0xbd, 0x33, 0xde, 0x0a, 0xcd, 0xac, 0xc3, 0x99, 0x56, 0x99, 0x73, 0xc6, 0x9b,
+	0x25, 0xca, 0x0c, 0x5a, 0x56, 0xce, 0x03, 0xff, 0x9f, 0x03, 0xc9, 0xa9, 0x24, 0x69, 0x4c, 0x5a,
+	0x03, 0x4f, 0xfc, 0xb9, 0x92, 0x30, 0x5e, 0x4f, 0x25, 0x1f, 0x54, 0x4a, 0x67, 0xc4, 0x8f, 0x91,
+	0x24, 0xc1, 0x44, 0x25, 0x72, 0x1e, 0x03, 0xb6, 0xad, 0xae, 0xe5, 0xb5, 0x06, 0x97, 0x35, 0x56,
+	0xa1, 0xd9, 0xe4, 0x79, 0x2c, 0xd3, 0x22, 0x38, 0x32, 0xe2, 0xfb, 0x83, 0x76, 0xb0, 0xe2, 0x27,
+	0xe1, 0xbe, 0x0e, 0x7f, 0xa2, 0x76, 0xa6, 0xdc, 0xae, 0xe2, 0x71, 0xae, 0xeb, 0x4c, 0x7f, 0x85,
+	0xd8, 0xa9, 0x9f, 0xa0, 0x8a, 0xc7, 0x63, 0xb7, 0x6c, 0x38, 0xfc, 0xd8, 0xba, 0x6c, 0xb3, 0x75,
+	0xd9, 0xd7, 0xd6, 0x65, 0x6f, 0x3b, 0xb7, 0xb1, 0xd9, 0xb9, 0x8d, 0xcf, 0x9d, 0xdb, 0x78, 0xf1,
+	0xe2, 0x94, 0x92, 0x65, 0x24, 0x94, 0xce, 0xfd, 0xf2, 0xa8, 0x87, 0xe7, 0x06, 0xa7, 0x59, 0x79,
+	0x5a, 0x5a, 0x2f, 0x00, 0xa3, 0xa6, 0xb9, 0xe7, 0xdd, 0xf7, 0x00, 0x03, 0x13, 0x98, 0x87, 0x42,
+	0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StateStreamingClient is the client API for StateStreaming service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StateStreamingClient interface {
+	// Subscribe opens a stream of blocks. The first request sets the store key
+	// filter of the subscription, every following request acknowledges that the
+	// subscriber processed the block at ack_height.
+	Subscribe(ctx context.Context, opts ...grpc.CallOption) (StateStreaming_SubscribeClient, error)
+}
+
+type stateStreamingClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStateStreamingClient(cc grpc1.ClientConn) StateStreamingClient {
+	return &stateStreamingClient{cc}
+}
+
+func (c *stateStreamingClient) Subscribe(ctx context.Context, opts ...grpc.CallOption) (StateStreaming_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StateStreaming_serviceDesc.Streams[0], "/cosmos.base.store.v1beta1.StateStreaming/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stateStreamingSubscribeClient{stream}
+	return x, nil
+}
+
+type StateStreaming_SubscribeClient interface {
+	Send(*SubscribeRequest) error
+	Recv() (*StreamBlock, error)
+	grpc.ClientStream
+}
+
+type stateStreamingSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *stateStreamingSubscribeClient) Send(m *SubscribeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *stateStreamingSubscribeClient) Recv() (*StreamBlock, error) {
+	m := new(StreamBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StateStreamingServer is the server API for StateStreaming service.
+type StateStreamingServer interface {
+	// Subscribe opens a stream of blocks. The first request sets the store key
+	// filter of the subscription, every following request acknowledges that the
+	// subscriber processed the block at ack_height.
+	Subscribe(StateStreaming_SubscribeServer) error
+}
+
+// UnimplementedStateStreamingServer can be embedded to have forward compatible implementations.
+type UnimplementedStateStreamingServer struct {
+}
+
+func (*UnimplementedStateStreamingServer) Subscribe(srv StateStreaming_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterStateStreamingServer(s grpc1.Server, srv StateStreamingServer) {
+	s.RegisterService(&_StateStreaming_serviceDesc, srv)
+}
+
+func _StateStreaming_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StateStreamingServer).Subscribe(&stateStreamingSubscribeServer{stream})
+}
+
+type StateStreaming_SubscribeServer interface {
+	Send(*StreamBlock) error
+	Recv() (*SubscribeRequest, error)
+	grpc.ServerStream
+}
+
+type stateStreamingSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *stateStreamingSubscribeServer) Send(m *StreamBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *stateStreamingSubscribeServer) Recv() (*SubscribeRequest, error) {
+	m := new(SubscribeRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _StateStreaming_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.store.v1beta1.StateStreaming",
+	HandlerType: (*StateStreamingServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _StateStreaming_Subscribe_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "cosmos/base/store/v1beta1/streaming.proto",
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AckHeight != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.AckHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StoreKeys) > 0 {
+		for iNdEx := len(m.StoreKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StoreKeys[iNdEx])
+			copy(dAtA[i:], m.StoreKeys[iNdEx])
+			i = encodeVarintStreaming(dAtA, i, uint64(len(m.StoreKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStreaming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStreaming(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStreaming(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoreKeys) > 0 {
+		for _, s := range m.StoreKeys {
+			l = len(s)
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	if m.AckHeight != 0 {
+		n += 1 + sovStreaming(uint64(m.AckHeight))
+	}
+	return n
+}
+
+func (m *StreamBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStreaming(uint64(m.Height))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStreaming(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStreaming(x uint64) (n int) {
+	return sovStreaming(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKeys = append(m.StoreKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckHeight", wireType)
+			}
+			m.AckHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &BlockMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStreaming(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStreaming
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStreaming
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStreaming
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStreaming        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStreaming          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStreaming = fmt.Errorf("proto: unexpected end of group")
+)
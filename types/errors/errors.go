@@ -2,6 +2,7 @@ package errors
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"google.golang.org/grpc/codes"
 )
 
 // Type Aliases to errors module
@@ -167,6 +168,18 @@ var (
 	// supplied.
 	ErrInvalidGasLimit = Register(RootCodespace, 41, "invalid gas limit")
 
+	// ErrHeightPruned defines an error when the state of a height is not
+	// available anymore, e.g. when querying a pruned height.
+	ErrHeightPruned = errorsmod.RegisterWithGRPCCode(RootCodespace, 42, codes.NotFound, "height pruned")
+
+	// ErrMsgQuotaExceeded defines an error when a message would exceed the
+	// block quota of its type.
+	ErrMsgQuotaExceeded = Register(RootCodespace, 43, "message block quota exceeded")
+
+	// ErrMsgDisabled defines an error when the execution of a message type was
+	// disabled by a circuit breaker.
+	ErrMsgDisabled = Register(RootCodespace, 44, "message disabled by circuit breaker")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = errorsmod.ErrPanic
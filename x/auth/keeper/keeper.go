@@ -60,6 +60,10 @@ type AccountKeeper struct {
 	// The prototypical AccountI constructor.
 	proto      func() types.AccountI
 	addressCdc address.Codec
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -70,9 +74,12 @@ var _ AccountKeeperI = &AccountKeeper{}
 // types.PermissionsForAddress and is used in keeper.ValidatePermissions. Permissions are plain strings,
 // and don't have to fit into any predefined structure. This auth module does not use account permissions internally, though other modules
 // may use auth.Keeper to access the accounts permissions map.
+// The params are stored in the auth store, paramstore is only used to migrate
+// them from the legacy x/params subspace. authority is the address allowed to
+// update them, typically the x/gov module account.
 func NewAccountKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramstore paramtypes.Subspace, proto func() types.AccountI,
-	maccPerms map[string][]string, bech32Prefix string,
+	maccPerms map[string][]string, bech32Prefix string, authority string,
 ) AccountKeeper {
 	// set KeyTable if it has not already been set
 	if !paramstore.HasKeyTable() {
@@ -93,9 +100,15 @@ func NewAccountKeeper(
 		paramSubspace: paramstore,
 		permAddrs:     permAddrs,
 		addressCdc:    bech32Codec,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the x/auth module's authority.
+func (ak AccountKeeper) GetAuthority() string {
+	return ak.authority
+}
+
 // Logger returns a module-specific logger.
 func (ak AccountKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -5,13 +5,27 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
-// SetParams sets the auth module's parameters.
-func (ak AccountKeeper) SetParams(ctx sdk.Context, params types.Params) {
-	ak.paramSubspace.SetParamSet(ctx, &params)
+// SetParams sets the auth module's parameters. It returns an error if the
+// parameters are invalid.
+func (ak AccountKeeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(ak.key)
+	store.Set(types.ParamsKey, ak.cdc.MustMarshal(&params))
+
+	return nil
 }
 
 // GetParams gets the auth module's parameters.
 func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	ak.paramSubspace.GetParamSet(ctx, &params)
-	return
+	store := ctx.KVStore(ak.key)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	ak.cdc.MustUnmarshal(bz, &params)
+	return params
 }
@@ -1,11 +1,13 @@
 package authz
 
 import (
+	"math"
 	"time"
 
 	proto "github.com/gogo/protobuf/proto"
 
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -52,6 +54,10 @@ func (g Grant) GetAuthorization() (Authorization, error) {
 }
 
 func (g Grant) ValidateBasic() error {
+	if g.UsageRetention != nil && *g.UsageRetention <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("usage retention must be positive, got %v", *g.UsageRetention)
+	}
+
 	av := g.Authorization.GetCachedValue()
 	a, ok := av.(Authorization)
 	if !ok {
@@ -59,3 +65,23 @@ func (g Grant) ValidateBasic() error {
 	}
 	return a.ValidateBasic()
 }
+
+// Validate performs a basic validation of a grant usage log entry.
+func (u GrantUsage) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(u.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(u.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	if u.MsgTypeUrl == "" || len(u.MsgTypeUrl) > math.MaxUint8 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid msg type url %q", u.MsgTypeUrl)
+	}
+	if u.Height < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("negative height %d", u.Height)
+	}
+	if !u.Amount.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid amount %s", u.Amount)
+	}
+	return nil
+}
@@ -42,6 +42,33 @@ func TestNewGrant(t *testing.T) {
 	}
 }
 
+func TestGrantValidateBasicUsageRetention(t *testing.T) {
+	tcs := []struct {
+		title          string
+		usageRetention *time.Duration
+		err            string
+	}{
+		{"no usage log", nil, ""},
+		{"positive usage retention", duration(time.Hour), ""},
+		{"zero usage retention", duration(0), "usage retention must be positive"},
+		{"negative usage retention", duration(-time.Hour), "usage retention must be positive"},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			g, err := NewGrant(time.Unix(10, 0), NewGenericAuthorization("some-type"), nil)
+			require.NoError(t, err)
+			g.UsageRetention = tc.usageRetention
+			expecError(require.New(t), tc.err, g.ValidateBasic())
+		})
+	}
+}
+
+func duration(d time.Duration) *time.Duration {
+	return &d
+}
+
 func unixTime(s, ns int64) *time.Time {
 	t := time.Unix(s, ns)
 	return &t
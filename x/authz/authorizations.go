@@ -24,6 +24,16 @@ type Authorization interface {
 	ValidateBasic() error
 }
 
+// SpendLimitAuthorization is an Authorization limiting the coins the grantee
+// can spend, the coins it consumes are recorded in the usage log of the grant.
+type SpendLimitAuthorization interface {
+	Authorization
+
+	// SpentCoins returns the coins consumed from the spend limit by an
+	// accepted sdk.Msg.
+	SpentCoins(msg sdk.Msg) sdk.Coins
+}
+
 // AcceptResponse instruments the controller of an authz message if the request is accepted
 // and if it should be updated or deleted.
 type AcceptResponse struct {
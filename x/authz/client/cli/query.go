@@ -29,6 +29,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryGrants(),
 		GetQueryGranterGrants(),
 		GetQueryGranteeGrants(),
+		GetCmdQueryGrantUsage(),
 	)
 
 	return authorizationQueryCmd
@@ -192,3 +193,64 @@ $ %s q %s grants-by-grantee cosmos1skj..
 	flags.AddPaginationFlagsToCmd(cmd, "grantee-grants")
 	return cmd
 }
+
+// GetCmdQueryGrantUsage implements the query grant usage command.
+func GetCmdQueryGrantUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-usage [granter-addr] [grantee-addr] [msg-type-url]?",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "query the usage log of the grants for a granter-grantee pair and optionally a msg-type-url",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the usage log entries of the grants for a granter-grantee pair. If
+msg-type-url is set, it will select the entries only for that msg type.
+Examples:
+$ %s query %s grant-usage cosmos1skj.. cosmos1skjwj..
+$ %s query %s grant-usage cosmos1skjw.. cosmos1skjwj.. %s
+`,
+				version.AppName, authz.ModuleName,
+				version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := authz.NewQueryClient(clientCtx)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			msgAuthorized := ""
+			if len(args) >= 3 {
+				msgAuthorized = args[2]
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GrantUsage(
+				cmd.Context(),
+				&authz.QueryGrantUsageRequest{
+					Granter:    granter.String(),
+					Grantee:    grantee.String(),
+					MsgTypeUrl: msgAuthorized,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grant usage")
+	return cmd
+}
@@ -26,6 +26,12 @@ const (
 	FlagExpiration        = "expiration"
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
+	FlagAllowList         = "allow-list"
+	FlagPeriod            = "period"
+	FlagPeriodLimit       = "period-limit"
+	FlagFilter            = "filter"
+	FlagMaxUses           = "max-uses"
+	FlagUsageRetention    = "usage-retention"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -54,15 +60,19 @@ func GetTxCmd() *cobra.Command {
 // NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"filter\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
 
 Examples:
  $ %s tx %s grant cosmos1skjw.. send %s --spend-limit=1000stake --from=cosmos1skl..
- $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. send --period-limit=100stake --period=24h --allow-list=cosmos1ghe..,cosmos1tyu.. --from=cosmos1skl..
+ $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --max-uses=3 --from=cosmos1sk..
+ $ %s tx %s grant cosmos1skjw.. filter --msg-type=/cosmos.gov.v1.MsgVote --filter=proposal_id=1,2 --from=cosmos1sk..
+ $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --usage-retention=720h --from=cosmos1skl..
+	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName,
+				version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -89,11 +99,45 @@ Examples:
 					return err
 				}
 
-				if !spendLimit.IsAllPositive() {
-					return fmt.Errorf("spend-limit should be greater than zero")
+				allowList, err := cmd.Flags().GetStringSlice(FlagAllowList)
+				if err != nil {
+					return err
+				}
+
+				allowed, err := bech32toAccAddresses(allowList)
+				if err != nil {
+					return err
+				}
+
+				period, err := cmd.Flags().GetDuration(FlagPeriod)
+				if err != nil {
+					return err
+				}
+
+				if period == 0 {
+					if !spendLimit.IsAllPositive() {
+						return fmt.Errorf("spend-limit should be greater than zero")
+					}
+
+					authorization = bank.NewSendAuthorization(spendLimit, allowed...)
+					break
+				}
+
+				periodLimit, err := cmd.Flags().GetString(FlagPeriodLimit)
+				if err != nil {
+					return err
+				}
+
+				periodSpendLimit, err := sdk.ParseCoinsNormalized(periodLimit)
+				if err != nil {
+					return err
+				}
+
+				if !periodSpendLimit.IsAllPositive() {
+					return fmt.Errorf("period-limit should be greater than zero")
 				}
 
-				authorization = bank.NewSendAuthorization(spendLimit)
+				authorization = bank.NewPeriodicSendAuthorization(spendLimit, period, periodSpendLimit, allowed...)
 			case "generic":
 				msgType, err := cmd.Flags().GetString(FlagMsgType)
 				if err != nil {
@@ -101,6 +145,23 @@ Examples:
 				}
 
 				authorization = authz.NewGenericAuthorization(msgType)
+			case "filter":
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				filterArgs, err := cmd.Flags().GetStringArray(FlagFilter)
+				if err != nil {
+					return err
+				}
+
+				filters, err := parseMsgFieldFilters(filterArgs)
+				if err != nil {
+					return err
+				}
+
+				authorization = authz.NewMsgFilterAuthorization(msgType, filters...)
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -166,6 +227,18 @@ Examples:
 				return fmt.Errorf("invalid authorization type, %s", args[1])
 			}
 
+			maxUses, err := cmd.Flags().GetUint64(FlagMaxUses)
+			if err != nil {
+				return err
+			}
+
+			if maxUses > 0 {
+				authorization, err = authz.NewMaxUsesAuthorization(authorization, maxUses)
+				if err != nil {
+					return err
+				}
+			}
+
 			expire, err := getExpireTime(cmd)
 			if err != nil {
 				return err
@@ -176,6 +249,15 @@ Examples:
 				return err
 			}
 
+			usageRetention, err := cmd.Flags().GetDuration(FlagUsageRetention)
+			if err != nil {
+				return err
+			}
+
+			if usageRetention != 0 {
+				msg.Grant.UsageRetention = &usageRetention
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -184,7 +266,13 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
+	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to send funds separated by ,")
+	cmd.Flags().Duration(FlagPeriod, 0, "Period of a periodic Send Authorization, its period-limit can be spent every period")
+	cmd.Flags().String(FlagPeriodLimit, "", "Coins allowed to be spent every period of a periodic Send Authorization")
+	cmd.Flags().StringArray(FlagFilter, []string{}, "Message field filter of a filter authorization as path=value1,value2, can be repeated")
+	cmd.Flags().Uint64(FlagMaxUses, 0, "Number of times the authorization can be used. Set zero (0) for no limit. Default is 0.")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
+	cmd.Flags().Duration(FlagUsageRetention, 0, "Duration for which the uses of the grant are logged. Set zero (0) to not log them. Default is 0.")
 	return cmd
 }
 
@@ -284,3 +372,29 @@ func bech32toValidatorAddresses(validators []string) ([]sdk.ValAddress, error) {
 	}
 	return vals, nil
 }
+
+func bech32toAccAddresses(accounts []string) ([]sdk.AccAddress, error) {
+	addrs := make([]sdk.AccAddress, len(accounts))
+	for i, account := range accounts {
+		addr, err := sdk.AccAddressFromBech32(account)
+		if err != nil {
+			return nil, err
+		}
+		addrs[i] = addr
+	}
+	return addrs, nil
+}
+
+// parseMsgFieldFilters parses message field filters of the form
+// path=value1,value2.
+func parseMsgFieldFilters(args []string) ([]authz.MsgFieldFilter, error) {
+	filters := make([]authz.MsgFieldFilter, len(args))
+	for i, arg := range args {
+		path, values, ok := strings.Cut(arg, "=")
+		if !ok || path == "" || values == "" {
+			return nil, fmt.Errorf("invalid filter %s, expected path=value1,value2", arg)
+		}
+		filters[i] = authz.NewMsgFieldFilter(path, strings.Split(values, ",")...)
+	}
+	return filters, nil
+}
@@ -13,6 +13,11 @@ func NewGenesisState(entries []GrantAuthorization) *GenesisState {
 
 // ValidateGenesis check the given genesis state has no integrity issues
 func ValidateGenesis(data GenesisState) error {
+	for _, usage := range data.Usages {
+		if err := usage.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -22,11 +22,20 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *authz.GenesisState) {
 			panic("expected authorization")
 		}
 
-		err := k.SaveGrant(ctx, grantee, granter, a, entry.Expiration)
+		err := k.saveGrant(ctx, grantee, granter, a, entry.Expiration, entry.UsageRetention)
 		if err != nil {
 			panic(err)
 		}
 	}
+
+	for _, usage := range data.Usages {
+		// ignore expired usage log entries
+		if !usage.Expiration.After(now) {
+			continue
+		}
+
+		k.setGrantUsage(ctx, usage)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
@@ -34,13 +43,20 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *authz.GenesisState {
 	var entries []authz.GrantAuthorization
 	k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant authz.Grant) bool {
 		entries = append(entries, authz.GrantAuthorization{
-			Granter:       granter.String(),
-			Grantee:       grantee.String(),
-			Expiration:    grant.Expiration,
-			Authorization: grant.Authorization,
+			Granter:        granter.String(),
+			Grantee:        grantee.String(),
+			Expiration:     grant.Expiration,
+			Authorization:  grant.Authorization,
+			UsageRetention: grant.UsageRetention,
 		})
 		return false
 	})
 
-	return authz.NewGenesisState(entries)
+	gs := authz.NewGenesisState(entries)
+	k.IterateGrantUsages(ctx, func(usage authz.GrantUsage) bool {
+		gs.Usages = append(gs.Usages, usage)
+		return false
+	})
+
+	return gs
 }
@@ -7,9 +7,11 @@ import (
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -55,6 +57,41 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 	suite.Require().Equal(genesis, newGenesis)
 }
 
+func (suite *GenesisTestSuite) TestImportExportGenesisUsageLog() {
+	now := suite.ctx.BlockTime()
+	usageRetention := time.Hour
+	any, err := codectypes.NewAnyWithValue(authz.NewGenericAuthorization(bank.SendAuthorization{}.MsgTypeURL()))
+	suite.Require().NoError(err)
+	usage := authz.GrantUsage{
+		Granter:    granterAddr.String(),
+		Grantee:    granteeAddr.String(),
+		MsgTypeUrl: bank.SendAuthorization{}.MsgTypeURL(),
+		Height:     1,
+		Amount:     sdk.NewCoins(sdk.NewInt64Coin("foo", 10)),
+		Expiration: now.Add(usageRetention),
+	}
+	genesis := &authz.GenesisState{
+		Authorization: []authz.GrantAuthorization{{
+			Granter:        granterAddr.String(),
+			Grantee:        granteeAddr.String(),
+			Authorization:  any,
+			UsageRetention: &usageRetention,
+		}},
+		Usages: []authz.GrantUsage{
+			usage,
+			usage,
+			// expired entries are ignored
+			{Granter: usage.Granter, Grantee: usage.Grantee, MsgTypeUrl: usage.MsgTypeUrl, Expiration: now},
+		},
+	}
+	suite.Require().NoError(authz.ValidateGenesis(*genesis))
+
+	suite.keeper.InitGenesis(suite.ctx, genesis)
+	newGenesis := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Equal(genesis.Authorization, newGenesis.Authorization)
+	suite.Require().Equal([]authz.GrantUsage{usage, usage}, newGenesis.Usages)
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
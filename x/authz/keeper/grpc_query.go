@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"math"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -51,8 +52,9 @@ func (k Keeper) Grants(c context.Context, req *authz.QueryGrantsRequest) (*authz
 		}
 		return &authz.QueryGrantsResponse{
 			Grants: []*authz.Grant{{
-				Authorization: authorizationAny,
-				Expiration:    grant.Expiration,
+				Authorization:  authorizationAny,
+				Expiration:     grant.Expiration,
+				UsageRetention: grant.UsageRetention,
 			}},
 		}, nil
 	}
@@ -72,8 +74,9 @@ func (k Keeper) Grants(c context.Context, req *authz.QueryGrantsRequest) (*authz
 			return nil, status.Errorf(codes.Internal, err.Error())
 		}
 		return &authz.Grant{
-			Authorization: authorizationAny,
-			Expiration:    auth.Expiration,
+			Authorization:  authorizationAny,
+			Expiration:     auth.Expiration,
+			UsageRetention: auth.UsageRetention,
 		}, nil
 	}, func() *authz.Grant {
 		return &authz.Grant{}
@@ -116,10 +119,11 @@ func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRe
 
 		grantee := firstAddressFromGrantStoreKey(key)
 		return &authz.GrantAuthorization{
-			Granter:       granter.String(),
-			Grantee:       grantee.String(),
-			Authorization: any,
-			Expiration:    auth.Expiration,
+			Granter:        granter.String(),
+			Grantee:        grantee.String(),
+			Authorization:  any,
+			Expiration:     auth.Expiration,
+			UsageRetention: auth.UsageRetention,
 		}, nil
 	}, func() *authz.Grant {
 		return &authz.Grant{}
@@ -165,10 +169,11 @@ func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRe
 		}
 
 		return &authz.GrantAuthorization{
-			Authorization: authorizationAny,
-			Expiration:    auth.Expiration,
-			Granter:       granter.String(),
-			Grantee:       grantee.String(),
+			Authorization:  authorizationAny,
+			Expiration:     auth.Expiration,
+			UsageRetention: auth.UsageRetention,
+			Granter:        granter.String(),
+			Grantee:        grantee.String(),
 		}, nil
 	}, func() *authz.Grant {
 		return &authz.Grant{}
@@ -182,3 +187,49 @@ func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRe
 		Pagination: pageRes,
 	}, nil
 }
+
+// GrantUsage implements the Query/GrantUsage gRPC method.
+// It returns the usage log entries of the grants for a granter-grantee pair. If
+// msg type URL is set, it returns the entries only for that msg type.
+func (k Keeper) GrantUsage(c context.Context, req *authz.QueryGrantUsageRequest) (*authz.QueryGrantUsageResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	// msg type urls are prefixed with a single byte length in the usage log keys
+	if len(req.MsgTypeUrl) > math.MaxUint8 {
+		return nil, status.Errorf(codes.InvalidArgument, "msg type url too long")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), grantUsagePrefix(granter, grantee, req.MsgTypeUrl))
+
+	var usages []authz.GrantUsage
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var usage authz.GrantUsage
+		if err := k.cdc.Unmarshal(value, &usage); err != nil {
+			return err
+		}
+
+		usages = append(usages, usage)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &authz.QueryGrantUsageResponse{
+		Usages:     usages,
+		Pagination: pageRes,
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -131,6 +132,10 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 			if !resp.Accept {
 				return nil, sdkerrors.ErrUnauthorized
 			}
+
+			if err := k.logGrantUsage(ctx, grantee, granter, grant, authorization, msg); err != nil {
+				return nil, err
+			}
 		}
 
 		handler := k.router.Handler(msg)
@@ -165,6 +170,14 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 // with the provided expiration time and insert authorization key into the grants queue. If there is an existing authorization grant for the
 // same `sdk.Msg` type, this grant overwrites that.
 func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
+	return k.saveGrant(ctx, grantee, granter, authorization, expiration, nil)
+}
+
+// saveGrant saves a grant like SaveGrant, the uses of the grant are logged for
+// usageRetention if it is not nil.
+func (k Keeper) saveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization,
+	expiration *time.Time, usageRetention *time.Duration,
+) error {
 	store := ctx.KVStore(k.storeKey)
 	msgType := authorization.MsgTypeURL()
 	skey := grantStoreKey(grantee, granter, msgType)
@@ -173,6 +186,7 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 	if err != nil {
 		return err
 	}
+	grant.UsageRetention = usageRetention
 
 	var oldExp *time.Time
 	if oldGrant, found := k.getGrant(ctx, skey); found {
@@ -400,3 +414,92 @@ func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context) error {
 
 	return nil
 }
+
+// logGrantUsage emits an EventGrantUsed for a msg executed under a grant and
+// adds it to the usage log of the grant if the grant has a usage retention.
+func (k Keeper) logGrantUsage(ctx sdk.Context, grantee, granter sdk.AccAddress, grant authz.Grant,
+	authorization authz.Authorization, msg sdk.Msg,
+) error {
+	var amount sdk.Coins
+	if spendLimit, ok := authorization.(authz.SpendLimitAuthorization); ok {
+		amount = spendLimit.SpentCoins(msg)
+	}
+
+	msgType := sdk.MsgTypeURL(msg)
+	if grant.UsageRetention != nil {
+		var txHash string
+		if txBytes := ctx.TxBytes(); len(txBytes) > 0 {
+			txHash = fmt.Sprintf("%X", tmhash.Sum(txBytes))
+		}
+
+		k.setGrantUsage(ctx, authz.GrantUsage{
+			Granter:    granter.String(),
+			Grantee:    grantee.String(),
+			MsgTypeUrl: msgType,
+			Height:     ctx.BlockHeight(),
+			TxHash:     txHash,
+			Amount:     amount,
+			Expiration: ctx.BlockTime().Add(*grant.UsageRetention),
+		})
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&authz.EventGrantUsed{
+		MsgTypeUrl: msgType,
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		Amount:     amount,
+	})
+}
+
+// setGrantUsage adds an entry to the usage log of a grant and inserts it into
+// the grant usage queue, it is ordered after the entries of the grant at the
+// same height.
+func (k Keeper) setGrantUsage(ctx sdk.Context, usage authz.GrantUsage) {
+	store := ctx.KVStore(k.storeKey)
+	granter := sdk.MustAccAddressFromBech32(usage.Granter)
+	grantee := sdk.MustAccAddressFromBech32(usage.Grantee)
+
+	var seq uint64
+	iter := sdk.KVStoreReversePrefixIterator(store, grantUsageHeightPrefix(granter, grantee, usage.MsgTypeUrl, usage.Height))
+	if iter.Valid() {
+		key := iter.Key()
+		seq = sdk.BigEndianToUint64(key[len(key)-8:]) + 1
+	}
+	iter.Close()
+
+	key := grantUsageKey(granter, grantee, usage.MsgTypeUrl, usage.Height, seq)
+	store.Set(key, k.cdc.MustMarshal(&usage))
+	store.Set(GrantUsageQueueKey(usage.Expiration, key), []byte{})
+}
+
+// IterateGrantUsages iterates over the entries of the usage logs of all the
+// grants. The iteration stops when the handler function returns true or the
+// iterator exhaust.
+func (k Keeper) IterateGrantUsages(ctx sdk.Context, handler func(usage authz.GrantUsage) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, GrantUsagePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var usage authz.GrantUsage
+		k.cdc.MustUnmarshal(iter.Value(), &usage)
+		if handler(usage) {
+			break
+		}
+	}
+}
+
+// DequeueAndDeleteExpiredGrantUsages deletes the expired entries of the usage
+// logs from the state and the grant usage queue.
+func (k Keeper) DequeueAndDeleteExpiredGrantUsages(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	// the keys are prefixed with the expiration, the entries expiring at the
+	// block time are included by iterating up to the end of its prefix
+	iterator := store.Iterator(GrantUsageQueuePrefix, sdk.PrefixEndBytes(GrantUsageQueueTimePrefix(ctx.BlockTime())))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+		store.Delete(parseGrantUsageQueueKey(iterator.Key()))
+	}
+}
@@ -1,10 +1,13 @@
 package keeper_test
 
 import (
+	gocontext "context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
@@ -320,6 +323,130 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDispatchActionMaxUses() {
+	require := s.Require()
+	app, addrs := s.app, s.addrs
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	require.NoError(testutil.FundAccount(app.BankKeeper, s.ctx, granterAddr, coins1000))
+	expiration := s.ctx.BlockTime().AddDate(0, 1, 0)
+
+	a, err := authz.NewMaxUsesAuthorization(banktypes.NewSendAuthorization(coins100), 2)
+	require.NoError(err)
+	require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, a, &expiration))
+
+	msgs := []sdk.Msg{
+		&banktypes.MsgSend{
+			Amount:      coins10,
+			FromAddress: granterAddr.String(),
+			ToAddress:   recipientAddr.String(),
+		},
+	}
+
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, msgs)
+	require.NoError(err)
+
+	authorization, _ := app.AuthzKeeper.GetAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.NotNil(authorization)
+	maxUses := authorization.(*authz.MaxUsesAuthorization)
+	require.Equal(uint64(1), maxUses.Uses)
+	inner, err := maxUses.GetAuthorization()
+	require.NoError(err)
+	require.Equal(coins100.Sub(coins10...), inner.(*banktypes.SendAuthorization).SpendLimit)
+
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, msgs)
+	require.NoError(err)
+
+	authorization, _ = app.AuthzKeeper.GetAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.Nil(authorization)
+
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, msgs)
+	require.Error(err)
+}
+
+func (s *TestSuite) TestGrantUsageLog() {
+	require := s.Require()
+	app, addrs := s.app, s.addrs
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	granter2Addr := addrs[3]
+	require.NoError(testutil.FundAccount(app.BankKeeper, s.ctx, granterAddr, coins1000))
+	require.NoError(testutil.FundAccount(app.BankKeeper, s.ctx, granter2Addr, coins1000))
+	now := s.ctx.BlockTime()
+
+	// grant with a usage log
+	msg, err := authz.NewMsgGrant(granterAddr, granteeAddr, banktypes.NewSendAuthorization(coins100), nil)
+	require.NoError(err)
+	usageRetention := time.Hour
+	msg.Grant.UsageRetention = &usageRetention
+	_, err = app.AuthzKeeper.Grant(sdk.WrapSDKContext(s.ctx), msg)
+	require.NoError(err)
+
+	// grant without a usage log
+	require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granter2Addr, banktypes.NewSendAuthorization(coins100), nil))
+
+	txBytes := []byte("tx")
+	ctx := s.ctx.WithBlockHeight(10).WithTxBytes(txBytes).WithEventManager(sdk.NewEventManager())
+	for _, granter := range []sdk.AccAddress{granterAddr, granterAddr, granter2Addr} {
+		_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, []sdk.Msg{
+			banktypes.NewMsgSend(granter, recipientAddr, coins10),
+		})
+		require.NoError(err)
+	}
+
+	var used int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "cosmos.authz.v1beta1.EventGrantUsed" {
+			used++
+		}
+	}
+	require.Equal(3, used)
+
+	expUsage := authz.GrantUsage{
+		Granter:    granterAddr.String(),
+		Grantee:    granteeAddr.String(),
+		MsgTypeUrl: bankSendAuthMsgType,
+		Height:     10,
+		TxHash:     fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+		Amount:     coins10,
+		Expiration: now.Add(usageRetention),
+	}
+	res, err := s.queryClient.GrantUsage(gocontext.Background(), &authz.QueryGrantUsageRequest{
+		Granter: granterAddr.String(),
+		Grantee: granteeAddr.String(),
+	})
+	require.NoError(err)
+	require.Equal([]authz.GrantUsage{expUsage, expUsage}, res.Usages)
+
+	res, err = s.queryClient.GrantUsage(gocontext.Background(), &authz.QueryGrantUsageRequest{
+		Granter: granter2Addr.String(),
+		Grantee: granteeAddr.String(),
+	})
+	require.NoError(err)
+	require.Empty(res.Usages)
+
+	// the usage log is kept after the grant is deleted, until it expires
+	require.NoError(app.AuthzKeeper.DeleteGrant(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType))
+	app.AuthzKeeper.DequeueAndDeleteExpiredGrantUsages(s.ctx.WithBlockTime(now.Add(usageRetention - time.Second)))
+	res, err = s.queryClient.GrantUsage(gocontext.Background(), &authz.QueryGrantUsageRequest{
+		Granter:    granterAddr.String(),
+		Grantee:    granteeAddr.String(),
+		MsgTypeUrl: bankSendAuthMsgType,
+	})
+	require.NoError(err)
+	require.Len(res.Usages, 2)
+
+	app.AuthzKeeper.DequeueAndDeleteExpiredGrantUsages(s.ctx.WithBlockTime(now.Add(usageRetention)))
+	res, err = s.queryClient.GrantUsage(gocontext.Background(), &authz.QueryGrantUsageRequest{
+		Granter: granterAddr.String(),
+		Grantee: granteeAddr.String(),
+	})
+	require.NoError(err)
+	require.Empty(res.Usages)
+}
+
 func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require := s.Require()
 	app, addrs := s.app, s.addrs
@@ -15,9 +15,13 @@ import (
 //
 // - 0x01<grant_Bytes>: Grant
 // - 0x02<grant_expiration_Bytes>: GrantQueueItem
+// - 0x03<grant_usage_Bytes>: GrantUsage
+// - 0x04<grant_usage_expiration_Bytes><grant_usage_Bytes>: []byte{}
 var (
-	GrantKey         = []byte{0x01} // prefix for each key
-	GrantQueuePrefix = []byte{0x02}
+	GrantKey              = []byte{0x01} // prefix for each key
+	GrantQueuePrefix      = []byte{0x02}
+	GrantUsagePrefix      = []byte{0x03}
+	GrantUsageQueuePrefix = []byte{0x04}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -97,3 +101,52 @@ func firstAddressFromGrantStoreKey(key []byte) sdk.AccAddress {
 	addrLen := key[0]
 	return sdk.AccAddress(key[1 : 1+addrLen])
 }
+
+// grantUsagePrefix returns the prefix of the usage log entries of the grants of
+// granter to grantee, restricted to the grant of msgType if it is not empty.
+// Key format is:
+//
+//	0x03<granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgTypeLen (1 Byte)><msgType_Bytes>
+func grantUsagePrefix(granter, grantee sdk.AccAddress, msgType string) []byte {
+	key := sdk.AppendLengthPrefixedBytes(GrantUsagePrefix, address.MustLengthPrefix(granter), address.MustLengthPrefix(grantee))
+	if msgType == "" {
+		return key
+	}
+	return append(key, address.MustLengthPrefix(conv.UnsafeStrToBytes(msgType))...)
+}
+
+// grantUsageKey returns the key of a usage log entry, seq orders the entries
+// of a grant at the same height.
+// Key format is:
+//
+//	0x03<granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgTypeLen (1 Byte)><msgType_Bytes><height (8 Bytes)><seq (8 Bytes)>: GrantUsage
+func grantUsageKey(granter, grantee sdk.AccAddress, msgType string, height int64, seq uint64) []byte {
+	return sdk.AppendLengthPrefixedBytes(grantUsageHeightPrefix(granter, grantee, msgType, height), sdk.Uint64ToBigEndian(seq))
+}
+
+// grantUsageHeightPrefix returns the prefix of the usage log entries of a
+// grant at height.
+func grantUsageHeightPrefix(granter, grantee sdk.AccAddress, msgType string, height int64) []byte {
+	return sdk.AppendLengthPrefixedBytes(grantUsagePrefix(granter, grantee, msgType), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GrantUsageQueueKey returns the key of the entry of the grant usage queue
+// pruning the usage log entry at usageKey.
+// Key format is:
+//
+//	0x04<grant_usage_expiration_Bytes><grant_usage_Bytes>: []byte{}
+func GrantUsageQueueKey(expiration time.Time, usageKey []byte) []byte {
+	return sdk.AppendLengthPrefixedBytes(GrantUsageQueueTimePrefix(expiration), usageKey[len(GrantUsagePrefix):])
+}
+
+// GrantUsageQueueTimePrefix returns the grant usage queue time prefix.
+func GrantUsageQueueTimePrefix(expiration time.Time) []byte {
+	return append(GrantUsageQueuePrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
+// parseGrantUsageQueueKey returns the key of the usage log entry pruned by a
+// grant usage queue entry.
+func parseGrantUsageQueueKey(key []byte) []byte {
+	kv.AssertKeyAtLeastLength(key, len(GrantUsageQueuePrefix)+lenTime+1)
+	return append(GrantUsagePrefix, key[len(GrantUsageQueuePrefix)+lenTime:]...)
+}
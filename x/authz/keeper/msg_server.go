@@ -40,7 +40,7 @@ func (k Keeper) Grant(goCtx context.Context, msg *authz.MsgGrant) (*authz.MsgGra
 		return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist.", t)
 	}
 
-	err = k.SaveGrant(ctx, grantee, granter, authorization, msg.Grant.Expiration)
+	err = k.saveGrant(ctx, grantee, granter, authorization, msg.Grant.Expiration, msg.Grant.UsageRetention)
 	if err != nil {
 		return nil, err
 	}
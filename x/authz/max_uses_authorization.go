@@ -0,0 +1,125 @@
+package authz
+
+import (
+	proto "github.com/gogo/protobuf/proto"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ SpendLimitAuthorization          = &MaxUsesAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &MaxUsesAuthorization{}
+)
+
+// NewMaxUsesAuthorization creates a new MaxUsesAuthorization object allowing
+// the wrapped authorization to be used at most uses times.
+func NewMaxUsesAuthorization(authorization Authorization, uses uint64) (*MaxUsesAuthorization, error) {
+	a := &MaxUsesAuthorization{Uses: uses}
+	if err := a.SetAuthorization(authorization); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a MaxUsesAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(a.Authorization, &authorization)
+}
+
+// GetAuthorization returns the wrapped authorization.
+func (a MaxUsesAuthorization) GetAuthorization() (Authorization, error) {
+	if a.Authorization == nil {
+		return nil, sdkerrors.ErrInvalidType.Wrap("authorization is nil")
+	}
+	av := a.Authorization.GetCachedValue()
+	authorization, ok := av.(Authorization)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (Authorization)(nil), av)
+	}
+	return authorization, nil
+}
+
+// SetAuthorization sets the wrapped authorization.
+func (a *MaxUsesAuthorization) SetAuthorization(authorization Authorization) error {
+	msg, ok := authorization.(proto.Message)
+	if !ok {
+		return sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", authorization)
+	}
+	any, err := cdctypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+
+	a.Authorization = any
+	return nil
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a MaxUsesAuthorization) MsgTypeURL() string {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return ""
+	}
+	return authorization.MsgTypeURL()
+}
+
+// Accept implements Authorization.Accept. The wrapped authorization must
+// accept the message, the authorization is deleted once all its uses are used
+// or the wrapped authorization is deleted.
+func (a MaxUsesAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil || !resp.Accept {
+		return resp, err
+	}
+
+	if resp.Delete || a.Uses <= 1 {
+		return AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	if resp.Updated != nil {
+		authorization = resp.Updated
+	}
+	updated, err := NewMaxUsesAuthorization(authorization, a.Uses-1)
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	return AcceptResponse{Accept: true, Updated: updated}, nil
+}
+
+// SpentCoins implements SpendLimitAuthorization.SpentCoins, returning the coins
+// consumed by the wrapped authorization.
+func (a MaxUsesAuthorization) SpentCoins(msg sdk.Msg) sdk.Coins {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return nil
+	}
+
+	spendLimit, ok := authorization.(SpendLimitAuthorization)
+	if !ok {
+		return nil
+	}
+	return spendLimit.SpentCoins(msg)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MaxUsesAuthorization) ValidateBasic() error {
+	if a.Uses == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("uses must be positive")
+	}
+
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return err
+	}
+	return authorization.ValidateBasic()
+}
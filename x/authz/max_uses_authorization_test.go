@@ -0,0 +1,64 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMaxUsesAuthorization(t *testing.T) {
+	ctx := sdk.Context{}
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	send := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	t.Log("verify ValidateBasic rejects zero uses")
+	a, err := authz.NewMaxUsesAuthorization(authz.NewGenericAuthorization(banktypes.SendAuthorization{}.MsgTypeURL()), 0)
+	require.NoError(t, err)
+	require.Error(t, a.ValidateBasic())
+
+	t.Log("verify ValidateBasic validates the wrapped authorization")
+	a, err = authz.NewMaxUsesAuthorization(banktypes.NewSendAuthorization(sdk.Coins{}), 2)
+	require.NoError(t, err)
+	require.Error(t, a.ValidateBasic())
+
+	t.Log("verify the uses are decremented and the wrapped authorization updated")
+	a, err = authz.NewMaxUsesAuthorization(banktypes.NewSendAuthorization(coins), 2)
+	require.NoError(t, err)
+	require.NoError(t, a.ValidateBasic())
+	require.Equal(t, banktypes.SendAuthorization{}.MsgTypeURL(), a.MsgTypeURL())
+
+	resp, err := a.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated, ok := resp.Updated.(*authz.MaxUsesAuthorization)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), updated.Uses)
+	inner, err := updated.GetAuthorization()
+	require.NoError(t, err)
+	require.Equal(t, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 900))), inner)
+
+	t.Log("verify the authorization is deleted after the last use")
+	resp, err = updated.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	t.Log("verify the authorization is deleted with the wrapped authorization")
+	a, err = authz.NewMaxUsesAuthorization(banktypes.NewSendAuthorization(send.Amount), 5)
+	require.NoError(t, err)
+	resp, err = a.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+
+	t.Log("verify a message rejected by the wrapped authorization is rejected")
+	a, err = authz.NewMaxUsesAuthorization(banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 10))), 5)
+	require.NoError(t, err)
+	_, err = a.Accept(ctx, send)
+	require.Error(t, err)
+}
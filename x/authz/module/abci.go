@@ -11,4 +11,7 @@ func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	if err := keeper.DequeueAndDeleteExpiredGrants(ctx); err != nil {
 		panic(err)
 	}
+
+	// delete all the expired entries of the usage logs
+	keeper.DequeueAndDeleteExpiredGrantUsages(ctx)
 }
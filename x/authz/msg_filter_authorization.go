@@ -0,0 +1,129 @@
+package authz
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Authorization = &MsgFilterAuthorization{}
+
+// NewMsgFilterAuthorization creates a new MsgFilterAuthorization object.
+func NewMsgFilterAuthorization(msgTypeURL string, filters ...MsgFieldFilter) *MsgFilterAuthorization {
+	return &MsgFilterAuthorization{
+		Msg:     msgTypeURL,
+		Filters: filters,
+	}
+}
+
+// NewMsgFieldFilter creates a new MsgFieldFilter object.
+func NewMsgFieldFilter(path string, values ...string) MsgFieldFilter {
+	return MsgFieldFilter{
+		Path:   path,
+		Values: values,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a MsgFilterAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept. The fields of the JSON encoding of
+// the message must match all the filters.
+func (a MsgFilterAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	var fields interface{}
+	if err := decoder.Decode(&fields); err != nil {
+		return AcceptResponse{}, err
+	}
+
+	for _, filter := range a.Filters {
+		if !filter.match(fields, strings.Split(filter.Path, ".")) {
+			return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("message field %s is not allowed", filter.Path)
+		}
+	}
+
+	return AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MsgFilterAuthorization) ValidateBasic() error {
+	if len(a.Filters) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("msg filter authorization must have at least one filter")
+	}
+
+	for _, filter := range a.Filters {
+		for _, key := range strings.Split(filter.Path, ".") {
+			if key == "" {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid filter path %q", filter.Path)
+			}
+		}
+		if len(filter.Values) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("filter %s must have at least one value", filter.Path)
+		}
+	}
+	return nil
+}
+
+// match returns true if the fields at path all have one of the filter values.
+// Strings are compared as is, other values as their compact JSON encoding with
+// sorted object keys. A wildcard does not match an empty array.
+func (f MsgFieldFilter) match(fields interface{}, path []string) bool {
+	if len(path) == 0 {
+		value, ok := fields.(string)
+		if !ok {
+			bz, err := json.Marshal(fields)
+			if err != nil {
+				return false
+			}
+			value = string(bz)
+		}
+
+		for _, v := range f.Values {
+			if v == value {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch node := fields.(type) {
+	case map[string]interface{}:
+		child, ok := node[path[0]]
+		return ok && f.match(child, path[1:])
+
+	case []interface{}:
+		if path[0] == "*" {
+			if len(node) == 0 {
+				return false
+			}
+			for _, child := range node {
+				if !f.match(child, path[1:]) {
+					return false
+				}
+			}
+			return true
+		}
+
+		i, err := strconv.Atoi(path[0])
+		if err != nil || i < 0 || i >= len(node) {
+			return false
+		}
+		return f.match(node[i], path[1:])
+
+	default:
+		return false
+	}
+}
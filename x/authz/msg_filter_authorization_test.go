@@ -0,0 +1,73 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMsgFilterAuthorizationValidateBasic(t *testing.T) {
+	msgType := banktypes.SendAuthorization{}.MsgTypeURL()
+
+	testCases := []struct {
+		name    string
+		filters []authz.MsgFieldFilter
+		expErr  bool
+	}{
+		{"valid", []authz.MsgFieldFilter{authz.NewMsgFieldFilter("amount.*.denom", "stake")}, false},
+		{"no filters", nil, true},
+		{"empty path", []authz.MsgFieldFilter{authz.NewMsgFieldFilter("", "stake")}, true},
+		{"empty path segment", []authz.MsgFieldFilter{authz.NewMsgFieldFilter("amount..denom", "stake")}, true},
+		{"no values", []authz.MsgFieldFilter{authz.NewMsgFieldFilter("to_address")}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := authz.NewMsgFilterAuthorization(msgType, tc.filters...).ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgFilterAuthorizationAccept(t *testing.T) {
+	toAddr := sdk.AccAddress("to")
+	send := banktypes.NewMsgSend(sdk.AccAddress("from"), toAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 100)))
+
+	testCases := []struct {
+		name   string
+		filter authz.MsgFieldFilter
+		accept bool
+	}{
+		{"field", authz.NewMsgFieldFilter("to_address", "cosmos1other", toAddr.String()), true},
+		{"field not allowed", authz.NewMsgFieldFilter("to_address", "cosmos1other"), false},
+		{"missing field", authz.NewMsgFieldFilter("recipient", toAddr.String()), false},
+		{"index", authz.NewMsgFieldFilter("amount.1.denom", "stake"), true},
+		{"index out of range", authz.NewMsgFieldFilter("amount.2.denom", "stake"), false},
+		{"wildcard", authz.NewMsgFieldFilter("amount.*.denom", "atom", "stake"), true},
+		{"wildcard not all allowed", authz.NewMsgFieldFilter("amount.*.denom", "stake"), false},
+		{"object", authz.NewMsgFieldFilter("amount.0", `{"amount":"10","denom":"atom"}`), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := authz.NewMsgFilterAuthorization(sdk.MsgTypeURL(send), tc.filter)
+			resp, err := a.Accept(sdk.Context{}, send)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.False(t, resp.Delete)
+			require.Nil(t, resp.Updated)
+		})
+	}
+}
@@ -25,9 +25,22 @@ func TestDecodeStore(t *testing.T) {
 	grant, _ := authz.NewGrant(now, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("foo", 123))), &e)
 	grantBz, err := cdc.Marshal(&grant)
 	require.NoError(t, err)
+	usage := authz.GrantUsage{
+		Granter:    sdk.AccAddress("granter").String(),
+		Grantee:    sdk.AccAddress("grantee").String(),
+		MsgTypeUrl: banktypes.SendAuthorization{}.MsgTypeURL(),
+		Height:     10,
+		Amount:     sdk.NewCoins(sdk.NewInt64Coin("foo", 12)),
+		Expiration: e,
+	}
+	usageBz, err := cdc.Marshal(&usage)
+	require.NoError(t, err)
+	usageQueueKey := keeper.GrantUsageQueueKey(e, append(keeper.GrantUsagePrefix, 0x01))
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: []byte(keeper.GrantKey), Value: grantBz},
+			{Key: keeper.GrantUsagePrefix, Value: usageBz},
+			{Key: usageQueueKey, Value: []byte{}},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -38,6 +51,8 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Grant", false, fmt.Sprintf("%v\n%v", grant, grant)},
+		{"GrantUsage", false, fmt.Sprintf("%v\n%v", usage, usage)},
+		{"GrantUsageQueue", false, fmt.Sprintf("%X\n%X", usageQueueKey, usageQueueKey)},
 		{"other", true, ""},
 	}
 
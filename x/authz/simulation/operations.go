@@ -117,6 +117,11 @@ func SimulateMsgGrant(ak authz.AccountKeeper, bk authz.BankKeeper, _ keeper.Keep
 			return simtypes.NoOpMsg(authz.ModuleName, TypeMsgGrant, err.Error()), nil, err
 		}
 
+		if r.Intn(2) == 0 {
+			usageRetention := time.Duration(simtypes.RandIntBetween(r, 1, 1000)) * time.Hour
+			msg.Grant.UsageRetention = &usageRetention
+		}
+
 		txCfg := simappparams.MakeTestEncodingConfig().TxConfig
 		tx, err := helpers.GenSignedMockTx(
 			r,
@@ -33,6 +33,9 @@ func GetQueryCmd() *cobra.Command {
 		GetBalancesCmd(),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
+		GetCmdQueryDenomRestrictions(),
+		GetCmdQueryDenomBlacklist(),
+		GetCmdQuerySendEnabled(),
 	)
 
 	return cmd
@@ -213,3 +216,131 @@ To query for the total supply of a specific coin denomination use:
 
 	return cmd
 }
+
+func GetCmdQueryDenomRestrictions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-restrictions [denom]",
+		Short: "Query the admin and the frozen status of a denomination",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the admin and the frozen status of a coin denomination.
+
+Example:
+  $ %s query %s denom-restrictions [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomRestrictions(cmd.Context(), &types.QueryDenomRestrictionsRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Restrictions)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryDenomBlacklist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-blacklist [denom]",
+		Short: "Query the blacklisted addresses of a denomination",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the addresses which can neither send nor receive a coin denomination.
+
+Example:
+  $ %s query %s denom-blacklist [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomBlacklist(cmd.Context(), &types.QueryDenomBlacklistRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom blacklist")
+
+	return cmd
+}
+
+func GetCmdQuerySendEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-enabled [denom1 ...]",
+		Short: "Query the send enabled entries of denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the send enabled entries of the given denominations, or of all the
+denominations if none is given. The denominations without an entry use the
+default_send_enabled param and are omitted.
+
+Example:
+  $ %s query %s send-enabled
+  $ %s query %s send-enabled foocoin barcoin
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SendEnabled(cmd.Context(), &types.QuerySendEnabledRequest{
+				Denoms:     args,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "send enabled entries")
+
+	return cmd
+}
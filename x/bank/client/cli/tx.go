@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/spf13/cobra"
+	"strconv"
 )
 
 var FlagSplit = "split"
@@ -27,7 +28,11 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewSendTxCmd(),
 		NewMultiSendTxCmd(),
+		NewBurnTxCmd(),
 		NewMintProposalTxCmd(),
+		NewSetDenomAdminTxCmd(),
+		NewSetDenomFrozenTxCmd(),
+		NewSetBlacklistedTxCmd(),
 	)
 
 	return txCmd
@@ -203,3 +208,139 @@ func NewMintProposalTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewBurnTxCmd returns a CLI command handler for creating a MsgBurn transaction.
+func NewBurnTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [from_key_or_address] [amount]",
+		Short: "Burn funds from an account.",
+		Long: `Burn funds from an account, removing them from the total supply.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
+When using '--dry-run' a key name cannot be used, only a bech32 address.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress(), coins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetDenomAdminTxCmd returns a CLI command handler for creating a MsgSetDenomAdmin transaction.
+func NewSetDenomAdminTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-admin [denom] [admin]",
+		Short: "Set the admin of a denomination.",
+		Long: `Set the admin of a denomination, who can freeze it and blacklist addresses.
+The sender must be the current admin of the denomination. An empty [admin] removes the admin.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var admin sdk.AccAddress
+			if args[1] != "" {
+				admin, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetDenomAdmin(clientCtx.GetFromAddress(), args[0], admin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetDenomFrozenTxCmd returns a CLI command handler for creating a MsgSetDenomFrozen transaction.
+func NewSetDenomFrozenTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-frozen [denom] [true/false]",
+		Short: "Freeze or unfreeze all the transfers of a denomination.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			frozen, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomFrozen(clientCtx.GetFromAddress(), args[0], frozen)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetBlacklistedTxCmd returns a CLI command handler for creating a MsgSetBlacklisted transaction.
+func NewSetBlacklistedTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-blacklisted [denom] [true/false] [address_1, address_2, ...]",
+		Short: "Add addresses to the blacklist of a denomination, or remove them.",
+		Long: `Add addresses to the blacklist of a denomination, or remove them.
+The blacklisted addresses can neither send nor receive the denomination.
+`,
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blacklisted, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			addrs := make([]sdk.AccAddress, 0, len(args)-2)
+			for _, arg := range args[2:] {
+				addr, err := sdk.AccAddressFromBech32(arg)
+				if err != nil {
+					return err
+				}
+
+				addrs = append(addrs, addr)
+			}
+
+			msg := types.NewMsgSetBlacklisted(clientCtx.GetFromAddress(), args[0], blacklisted, addrs...)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BenchmarkSendCoinsSendEnabled measures SendCoins with a growing number of
+// send enabled entries. The lookup of an entry does not depend on their number.
+func BenchmarkSendCoinsSendEnabled(b *testing.B) {
+	for _, entries := range []int{10, 1000, 10000} {
+		b.Run(fmt.Sprintf("entries=%d", entries), func(b *testing.B) {
+			app := simapp.Setup(&testing.T{}, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			sendEnabled := make([]*types.SendEnabled, entries)
+			for i := range sendEnabled {
+				sendEnabled[i] = types.NewSendEnabled(fmt.Sprintf("denom%d", i), true)
+			}
+			app.BankKeeper.SetAllSendEnabledEntries(ctx, sendEnabled)
+
+			fromAddr := sdk.AccAddress([]byte("from________________"))
+			toAddr := sdk.AccAddress([]byte("to__________________"))
+			amt := sdk.NewCoins(sdk.NewInt64Coin(fmt.Sprintf("denom%d", entries-1), 1))
+			require.NoError(b, testutil.FundAccount(app.BankKeeper, ctx, fromAddr, amt.MulInt(sdk.NewInt(int64(b.N)))))
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				require.NoError(b, app.BankKeeper.IsSendEnabledCoins(ctx, amt...))
+				require.NoError(b, app.BankKeeper.SendCoins(ctx, fromAddr, toAddr, amt))
+			}
+		})
+	}
+}
@@ -10,7 +10,15 @@ import (
 
 // InitGenesis initializes the bank module's state from a given genesis state.
 func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
-	k.SetParams(ctx, genState.Params)
+	// SetParams moves the deprecated send enabled entries of the params to the
+	// send enabled store
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
+	for _, entry := range genState.SendEnabled {
+		k.SetSendEnabledEntry(ctx, entry.Denom, entry.Enabled)
+	}
 
 	totalSupply := sdk.Coins{}
 	genState.Balances = types.SanitizeGenesisBalances(genState.Balances)
@@ -36,6 +44,14 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, restrictions := range genState.DenomRestrictions {
+		k.SetDenomRestrictions(ctx, restrictions)
+	}
+
+	for _, blacklisted := range genState.BlacklistedAddresses {
+		k.UpdateBlacklisted(ctx, blacklisted.Denom, sdk.MustAccAddressFromBech32(blacklisted.Address), true)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -45,10 +61,26 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("unable to fetch total supply %v", err))
 	}
 
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetAccountsBalances(ctx),
 		totalSupply,
 		k.GetAllDenomMetaData(ctx),
 	)
+	genState.SendEnabled = k.GetAllSendEnabledEntries(ctx)
+
+	k.IterateDenomRestrictions(ctx, func(restrictions types.DenomRestrictions) bool {
+		genState.DenomRestrictions = append(genState.DenomRestrictions, restrictions)
+		return false
+	})
+
+	k.IterateBlacklistedAddresses(ctx, func(denom string, addr sdk.AccAddress) bool {
+		genState.BlacklistedAddresses = append(genState.BlacklistedAddresses, types.BlacklistedAddress{
+			Denom:   denom,
+			Address: addr.String(),
+		})
+		return false
+	})
+
+	return genState
 }
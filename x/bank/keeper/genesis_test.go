@@ -70,6 +70,25 @@ func (suite *IntegrationTestSuite) TestInitGenesis() {
 	suite.Require().Equal(m, m2)
 }
 
+func (suite *IntegrationTestSuite) TestGenesisSendEnabled() {
+	bk := suite.app.BankKeeper
+	g := types.DefaultGenesisState()
+	g.Params = types.NewParams(true, []*types.SendEnabled{types.NewSendEnabled("foocoin", false)})
+	g.SendEnabled = []types.SendEnabled{*types.NewSendEnabled("barcoin", false)}
+	bk.InitGenesis(suite.ctx, g)
+
+	suite.Require().False(bk.IsSendEnabledDenom(suite.ctx, "foocoin"))
+	suite.Require().False(bk.IsSendEnabledDenom(suite.ctx, "barcoin"))
+
+	// the entries of the params are exported with the other ones
+	exportGenesis := bk.ExportGenesis(suite.ctx)
+	suite.Require().Empty(exportGenesis.Params.SendEnabled)
+	suite.Require().Equal([]types.SendEnabled{
+		*types.NewSendEnabled("barcoin", false),
+		*types.NewSendEnabled("foocoin", false),
+	}, exportGenesis.SendEnabled)
+}
+
 func (suite *IntegrationTestSuite) TestTotalSupply() {
 	// Prepare some test data.
 	defaultGenesis := types.DefaultGenesisState()
@@ -243,3 +243,76 @@ func (k BaseKeeper) DenomOwners(
 
 	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
 }
+
+// DenomRestrictions implements the Query/DenomRestrictions gRPC method.
+func (k BaseKeeper) DenomRestrictions(goCtx context.Context, req *types.QueryDenomRestrictionsRequest) (*types.QueryDenomRestrictionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	restrictions, _ := k.GetDenomRestrictions(ctx, req.Denom)
+
+	return &types.QueryDenomRestrictionsResponse{Restrictions: restrictions}, nil
+}
+
+// DenomBlacklist implements the Query/DenomBlacklist gRPC method.
+func (k BaseKeeper) DenomBlacklist(goCtx context.Context, req *types.QueryDenomBlacklistRequest) (*types.QueryDenomBlacklistResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateDenomBlacklistPrefix(req.Denom))
+
+	var addresses []string
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		addresses = append(addresses, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomBlacklistResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+// SendEnabled implements the Query/SendEnabled gRPC method.
+func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabledRequest) (*types.QuerySendEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	res := &types.QuerySendEnabledResponse{}
+
+	if len(req.Denoms) > 0 {
+		for _, denom := range req.Denoms {
+			if entry, found := k.GetSendEnabledEntry(ctx, denom); found {
+				res.SendEnabled = append(res.SendEnabled, types.NewSendEnabled(entry.Denom, entry.Enabled))
+			}
+		}
+
+		return res, nil
+	}
+
+	store := k.getSendEnabledPrefixStore(ctx)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		res.SendEnabled = append(res.SendEnabled, types.NewSendEnabled(string(key), sendEnabledFromBytes(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
@@ -186,6 +186,41 @@ func (suite *IntegrationTestSuite) TestQueryParams() {
 	suite.Require().Equal(suite.app.BankKeeper.GetParams(suite.ctx), res.GetParams())
 }
 
+func (suite *IntegrationTestSuite) TestQuerySendEnabled() {
+	suite.app.BankKeeper.SetAllSendEnabledEntries(suite.ctx, []*types.SendEnabled{
+		types.NewSendEnabled("barcoin", false),
+		types.NewSendEnabled("bazcoin", true),
+		types.NewSendEnabled("foocoin", true),
+	})
+
+	// only the entries found are returned
+	res, err := suite.queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{
+		Denoms: []string{"foocoin", "unknown", "barcoin"},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{
+		types.NewSendEnabled("foocoin", true),
+		types.NewSendEnabled("barcoin", false),
+	}, res.SendEnabled)
+	suite.Require().Nil(res.Pagination)
+
+	res, err = suite.queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{
+		types.NewSendEnabled("barcoin", false),
+		types.NewSendEnabled("bazcoin", true),
+	}, res.SendEnabled)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = suite.queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{types.NewSendEnabled("foocoin", true)}, res.SendEnabled)
+}
+
 func (suite *IntegrationTestSuite) QueryDenomsMetadataRequest() {
 	var (
 		req         *types.QueryDenomsMetadataRequest
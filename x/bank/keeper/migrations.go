@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v043"
 	v046 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v046"
+	v047 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v047"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -26,6 +27,16 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v046.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
 
+// Migrate3to4 migrates x/bank storage from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v047.MigrateStore(ctx, m.keeper.storeKey, m.keeper.paramSpace, m.keeper.cdc)
+}
+
+// Migrate4to5 migrates x/bank storage from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v047.MigrateSendEnabled(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
 // Migrate3_V046_4_To_V046_5 fixes migrations from version 2 to for chains based on SDK 0.46.0 - v0.46.4 ONLY.
 // See v046.Migrate_V046_4_To_V046_5 for more details.
 func (m Migrator) Migrate3_V046_4_To_V046_5(ctx sdk.Context) error {
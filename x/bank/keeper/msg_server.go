@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/armon/go-metrics"
 
@@ -9,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type msgServer struct {
@@ -102,3 +104,166 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 
 	return &types.MsgMultiSendResponse{}, nil
 }
+
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (k msgServer) SetDenomAdmin(goCtx context.Context, msg *types.MsgSetDenomAdmin) (*types.MsgSetDenomAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	restrictions, _ := k.GetDenomRestrictions(ctx, msg.Denom)
+	if msg.Sender != k.GetAuthority() && (restrictions.Admin == "" || msg.Sender != restrictions.Admin) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of %s", msg.Sender, msg.Denom)
+	}
+
+	var admin sdk.AccAddress
+	if msg.Admin != "" {
+		var err error
+		admin, err = sdk.AccAddressFromBech32(msg.Admin)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	k.UpdateDenomAdmin(ctx, msg.Denom, admin)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyAdmin, msg.Admin),
+		),
+	)
+
+	return &types.MsgSetDenomAdminResponse{}, nil
+}
+
+func (k msgServer) SetDenomFrozen(goCtx context.Context, msg *types.MsgSetDenomFrozen) (*types.MsgSetDenomFrozenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateDenomAdmin(ctx, msg.Denom, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	k.UpdateDenomFrozen(ctx, msg.Denom, msg.Frozen)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomFrozen,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyFrozen, strconv.FormatBool(msg.Frozen)),
+		),
+	)
+
+	return &types.MsgSetDenomFrozenResponse{}, nil
+}
+
+func (k msgServer) SetBlacklisted(goCtx context.Context, msg *types.MsgSetBlacklisted) (*types.MsgSetBlacklistedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateDenomAdmin(ctx, msg.Denom, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	for _, address := range msg.Addresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+
+		k.UpdateBlacklisted(ctx, msg.Denom, addr, msg.Blacklisted)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetBlacklisted,
+				sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+				sdk.NewAttribute(types.AttributeKeyAddress, address),
+				sdk.NewAttribute(types.AttributeKeyBlacklisted, strconv.FormatBool(msg.Blacklisted)),
+			),
+		)
+	}
+
+	return &types.MsgSetBlacklistedResponse{}, nil
+}
+
+func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEnabled) (*types.MsgSetSendEnabledResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.SetAllSendEnabledEntries(ctx, msg.SendEnabled)
+	k.DeleteSendEnabledEntries(ctx, msg.UseDefaultFor...)
+
+	return &types.MsgSetSendEnabledResponse{}, nil
+}
+
+func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.BlockedAddr(from) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to burn funds", msg.FromAddress)
+	}
+
+	if err := k.CheckDenomRestrictions(ctx, msg.Amount, from); err != nil {
+		return nil, err
+	}
+
+	// the coins are removed from the balance and the supply, emitting the
+	// coin_spent and burn events
+	if err := k.BurnTokens(ctx, from, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range msg.Amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "burn"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress),
+		),
+	)
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// validateDenomAdmin returns an error if admin is not the admin of denom.
+func (k msgServer) validateDenomAdmin(ctx sdk.Context, denom, admin string) error {
+	restrictions, _ := k.GetDenomRestrictions(ctx, denom)
+	if restrictions.Admin == "" || restrictions.Admin != admin {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of %s", admin, denom)
+	}
+
+	return nil
+}
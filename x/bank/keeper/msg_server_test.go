@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func (suite *IntegrationTestSuite) TestMsgUpdateParams() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.BankKeeper)
+	authority := suite.app.BankKeeper.GetAuthority()
+
+	params := types.NewParams(false, []*types.SendEnabled{types.NewSendEnabled("foocoin", true)})
+	invalidParams := types.NewParams(true, []*types.SendEnabled{
+		types.NewSendEnabled("foocoin", true),
+		types.NewSendEnabled("foocoin", false),
+	})
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			req: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(types.ModuleName).String(),
+				Params:    params,
+			},
+			expErr:    true,
+			expErrMsg: "expected " + authority,
+		},
+		{
+			name: "invalid params",
+			req: &types.MsgUpdateParams{
+				Authority: authority,
+				Params:    invalidParams,
+			},
+			expErr:    true,
+			expErrMsg: "duplicate send enabled parameter found",
+		},
+		{
+			name: "all good",
+			req: &types.MsgUpdateParams{
+				Authority: authority,
+				Params:    params,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := msgServer.UpdateParams(suite.ctx, tc.req)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+
+				// the send enabled entries are moved out of the params
+				params := suite.app.BankKeeper.GetParams(suite.ctx)
+				suite.Require().Equal(tc.req.Params.DefaultSendEnabled, params.DefaultSendEnabled)
+				suite.Require().Empty(params.SendEnabled)
+				for _, entry := range tc.req.Params.SendEnabled {
+					stored, found := suite.app.BankKeeper.GetSendEnabledEntry(suite.ctx, entry.Denom)
+					suite.Require().True(found)
+					suite.Require().Equal(*entry, stored)
+				}
+			}
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestMsgDenomRestrictions() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.BankKeeper)
+	authority := sdk.MustAccAddressFromBech32(suite.app.BankKeeper.GetAuthority())
+
+	admin := sdk.AccAddress([]byte("admin_______________"))
+	newAdmin := sdk.AccAddress([]byte("newAdmin____________"))
+	addr := sdk.AccAddress([]byte("addr________________"))
+
+	// only the authority may set the admin of a denom without one
+	_, err := msgServer.SetDenomAdmin(suite.ctx, types.NewMsgSetDenomAdmin(admin, fooDenom, admin))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.SetDenomFrozen(suite.ctx, types.NewMsgSetDenomFrozen(admin, fooDenom, true))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.SetDenomAdmin(suite.ctx, types.NewMsgSetDenomAdmin(authority, fooDenom, admin))
+	suite.Require().NoError(err)
+
+	_, err = msgServer.SetDenomFrozen(suite.ctx, types.NewMsgSetDenomFrozen(admin, fooDenom, true))
+	suite.Require().NoError(err)
+
+	_, err = msgServer.SetBlacklisted(suite.ctx, types.NewMsgSetBlacklisted(newAdmin, fooDenom, true, addr))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.SetBlacklisted(suite.ctx, types.NewMsgSetBlacklisted(admin, fooDenom, true, addr))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.BankKeeper.IsBlacklisted(suite.ctx, fooDenom, addr))
+
+	// the admin may hand over the denom
+	_, err = msgServer.SetDenomAdmin(suite.ctx, types.NewMsgSetDenomAdmin(admin, fooDenom, newAdmin))
+	suite.Require().NoError(err)
+
+	_, err = msgServer.SetBlacklisted(suite.ctx, types.NewMsgSetBlacklisted(admin, fooDenom, false, addr))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.SetBlacklisted(suite.ctx, types.NewMsgSetBlacklisted(newAdmin, fooDenom, false, addr))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.BankKeeper.IsBlacklisted(suite.ctx, fooDenom, addr))
+
+	res, err := suite.queryClient.DenomRestrictions(suite.ctx, &types.QueryDenomRestrictionsRequest{Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DenomRestrictions{Denom: fooDenom, Admin: newAdmin.String(), Frozen: true}, res.Restrictions)
+}
+
+func (suite *IntegrationTestSuite) TestMsgSetSendEnabled() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.BankKeeper)
+	authority := suite.app.BankKeeper.GetAuthority()
+
+	_, err := msgServer.SetSendEnabled(suite.ctx, types.NewMsgSetSendEnabled(
+		authtypes.NewModuleAddress(types.ModuleName).String(),
+		[]*types.SendEnabled{types.NewSendEnabled("foocoin", false)}, nil,
+	))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.SetSendEnabled(suite.ctx, types.NewMsgSetSendEnabled(authority, []*types.SendEnabled{
+		types.NewSendEnabled("foocoin", false),
+		types.NewSendEnabled("barcoin", true),
+	}, nil))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.BankKeeper.IsSendEnabledDenom(suite.ctx, "foocoin"))
+	suite.Require().True(suite.app.BankKeeper.IsSendEnabledDenom(suite.ctx, "barcoin"))
+
+	// the entries are updated and removed in the same message
+	_, err = msgServer.SetSendEnabled(suite.ctx, types.NewMsgSetSendEnabled(authority,
+		[]*types.SendEnabled{types.NewSendEnabled("barcoin", false)},
+		[]string{"foocoin"},
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SendEnabled{*types.NewSendEnabled("barcoin", false)}, suite.app.BankKeeper.GetAllSendEnabledEntries(suite.ctx))
+}
+
+func (suite *IntegrationTestSuite) TestMsgBurn() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	addr := sdk.AccAddress([]byte("addr________________"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, balances))
+	supply := app.BankKeeper.GetSupply(ctx, fooDenom)
+
+	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, sdk.NewCoins(newFooCoin(101))))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// module accounts may not burn their coins
+	moduleAddr := authtypes.NewModuleAddress(minttypes.ModuleName)
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(moduleAddr, sdk.NewCoins(newFooCoin(1))))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// frozen denoms and blacklisted holders may not be burnt
+	app.BankKeeper.UpdateDenomFrozen(ctx, fooDenom, true)
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().ErrorIs(err, types.ErrDenomFrozen)
+	app.BankKeeper.UpdateDenomFrozen(ctx, fooDenom, false)
+
+	app.BankKeeper.UpdateBlacklisted(ctx, fooDenom, addr, true)
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().ErrorIs(err, types.ErrAddressBlacklisted)
+	app.BankKeeper.UpdateBlacklisted(ctx, fooDenom, addr, false)
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr))
+
+	app.BankKeeper.SetSendEnabledEntry(ctx, barDenom, false)
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, sdk.NewCoins(newFooCoin(10), newBarCoin(10))))
+	suite.Require().ErrorIs(err, types.ErrSendDisabled)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, sdk.NewCoins(newFooCoin(40))))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(60), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr))
+	suite.Require().Equal(supply.SubAmount(sdk.NewInt(40)), app.BankKeeper.GetSupply(ctx, fooDenom))
+
+	burnEvent := types.NewCoinBurnEvent(addr, sdk.NewCoins(newFooCoin(40)))
+	suite.Require().Contains(ctx.EventManager().ABCIEvents(), abci.Event(burnEvent))
+
+	// the balances still add up to the supply
+	_, broken := keeper.AllInvariants(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+	_, broken = keeper.NonnegativeBalanceInvariant(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+}
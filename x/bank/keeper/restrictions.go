@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// sendRestriction holds the send restrictions of a keeper. It is shared by the
+// copies of the keeper, so that the restrictions added after the keeper is
+// passed to other modules apply to them as well.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+}
+
+func newSendRestriction() *sendRestriction {
+	return &sendRestriction{}
+}
+
+// append adds restriction to be applied after the current ones.
+func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
+	r.fn = r.fn.Then(restriction)
+}
+
+// prepend adds restriction to be applied before the current ones.
+func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
+	r.fn = restriction.Then(r.fn)
+}
+
+// clear removes all the restrictions.
+func (r *sendRestriction) clear() {
+	r.fn = nil
+}
+
+// isSet returns true if there is any restriction.
+func (r *sendRestriction) isSet() bool {
+	return r.fn != nil
+}
+
+// apply applies the restrictions to a transfer, returning its recipient.
+func (r *sendRestriction) apply(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if r.fn == nil {
+		return toAddr, nil
+	}
+
+	newToAddr, err := r.fn(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+	if newToAddr.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "send restriction returned an empty recipient")
+	}
+
+	return newToAddr, nil
+}
+
+// AppendSendRestriction adds a restriction on the transfers of coins, applied
+// after the current ones. The restrictions are applied by SendCoins and
+// InputOutputCoins, hence to the transfers between modules and accounts too.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.append(restriction)
+}
+
+// PrependSendRestriction adds a restriction on the transfers of coins, applied
+// before the current ones.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.prepend(restriction)
+}
+
+// ClearSendRestriction removes all the restrictions on the transfers of coins.
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.clear()
+}
+
+// GetDenomRestrictions returns the restrictions of a denom, and false if it
+// has none.
+func (k BaseSendKeeper) GetDenomRestrictions(ctx sdk.Context, denom string) (types.DenomRestrictions, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CreateDenomRestrictionsKey(denom))
+	if bz == nil {
+		return types.DenomRestrictions{Denom: denom}, false
+	}
+
+	var restrictions types.DenomRestrictions
+	k.cdc.MustUnmarshal(bz, &restrictions)
+
+	return restrictions, true
+}
+
+// SetDenomRestrictions sets the restrictions of a denom. The blacklist of a
+// denom is only enforced once the denom has restrictions.
+func (k BaseSendKeeper) SetDenomRestrictions(ctx sdk.Context, restrictions types.DenomRestrictions) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreateDenomRestrictionsKey(restrictions.Denom), k.cdc.MustMarshal(&restrictions))
+}
+
+// IterateDenomRestrictions iterates over the restrictions of all the denoms.
+// The iteration stops if the callback returns true.
+func (k BaseSendKeeper) IterateDenomRestrictions(ctx sdk.Context, cb func(types.DenomRestrictions) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomRestrictionsPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var restrictions types.DenomRestrictions
+		k.cdc.MustUnmarshal(iterator.Value(), &restrictions)
+
+		if cb(restrictions) {
+			break
+		}
+	}
+}
+
+// UpdateDenomAdmin sets the admin of a denom, an empty admin removes it.
+func (k BaseSendKeeper) UpdateDenomAdmin(ctx sdk.Context, denom string, admin sdk.AccAddress) {
+	restrictions, _ := k.GetDenomRestrictions(ctx, denom)
+	restrictions.Admin = ""
+	if !admin.Empty() {
+		restrictions.Admin = admin.String()
+	}
+
+	k.SetDenomRestrictions(ctx, restrictions)
+}
+
+// UpdateDenomFrozen freezes or unfreezes all the transfers of a denom.
+func (k BaseSendKeeper) UpdateDenomFrozen(ctx sdk.Context, denom string, frozen bool) {
+	restrictions, _ := k.GetDenomRestrictions(ctx, denom)
+	restrictions.Frozen = frozen
+
+	k.SetDenomRestrictions(ctx, restrictions)
+}
+
+// IsBlacklisted returns true if an address can neither send nor receive a
+// denom.
+func (k BaseSendKeeper) IsBlacklisted(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.CreateDenomBlacklistKey(denom, addr))
+}
+
+// UpdateBlacklisted adds an address to the blacklist of a denom, or removes it.
+func (k BaseSendKeeper) UpdateBlacklisted(ctx sdk.Context, denom string, addr sdk.AccAddress, blacklisted bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.CreateDenomBlacklistKey(denom, addr)
+
+	if blacklisted {
+		store.Set(key, []byte{0})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// IterateBlacklistedAddresses iterates over the blacklisted addresses of all
+// the denoms. The iteration stops if the callback returns true.
+func (k BaseSendKeeper) IterateBlacklistedAddresses(ctx sdk.Context, cb func(denom string, addr sdk.AccAddress) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomBlacklistPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// key is in the format:
+		// 0x07<denomLen (1 Byte)><denom_Bytes><addr_Bytes>
+		key := iterator.Key()[len(types.DenomBlacklistPrefix):]
+		denomLen := int(key[0])
+		denom := string(key[1 : 1+denomLen])
+		addr := sdk.AccAddress(key[1+denomLen:])
+
+		if cb(denom, addr) {
+			break
+		}
+	}
+}
+
+// CheckDenomRestrictions returns an error if any of the coins is frozen, or if
+// any of the addresses is blacklisted for it. It is checked by the transfers and
+// burns of the coins.
+func (k BaseSendKeeper) CheckDenomRestrictions(ctx sdk.Context, amt sdk.Coins, addrs ...sdk.AccAddress) error {
+	for _, coin := range amt {
+		restrictions, found := k.GetDenomRestrictions(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+
+		if restrictions.Frozen {
+			return sdkerrors.Wrapf(types.ErrDenomFrozen, "%s transfers are frozen", coin.Denom)
+		}
+
+		for _, addr := range addrs {
+			if k.IsBlacklisted(ctx, coin.Denom, addr) {
+				return sdkerrors.Wrapf(types.ErrAddressBlacklisted, "%s is blacklisted for %s", addr, coin.Denom)
+			}
+		}
+	}
+
+	return nil
+}
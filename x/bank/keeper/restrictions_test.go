@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *IntegrationTestSuite) TestSendRestrictions() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+
+	// transfers of bar are rejected, transfers to addr2 are redirected to addr3
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if amt.AmountOf(barDenom).IsPositive() {
+			return nil, fmt.Errorf("%s cannot be sent", barDenom)
+		}
+		return toAddr, nil
+	})
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(addr2) {
+			return addr3, nil
+		}
+		return toAddr, nil
+	})
+
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).IsZero())
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	// the restrictions are applied to each output of a single input
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []types.Output{
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).IsZero())
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(30)), app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	// multiple inputs cannot be mapped to the outputs
+	inputs = []types.Input{
+		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	outputs = []types.Output{{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), types.ErrMultipleSenders)
+
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20)), app.BankKeeper.GetAllBalances(ctx, addr2))
+}
+
+func (suite *IntegrationTestSuite) TestSendRestrictionsOrder() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(100))))
+
+	var order []string
+	restriction := func(name string) types.SendRestrictionFn {
+		return func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			order = append(order, name)
+			return toAddr, nil
+		}
+	}
+
+	app.BankKeeper.AppendSendRestriction(restriction("second"))
+	app.BankKeeper.AppendSendRestriction(restriction("third"))
+	app.BankKeeper.PrependSendRestriction(restriction("first"))
+
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal([]string{"first", "second", "third"}, order)
+
+	// a restriction may not return an empty recipient
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return nil, nil
+	})
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+}
+
+func (suite *IntegrationTestSuite) TestDenomRestrictions() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+
+	_, found := app.BankKeeper.GetDenomRestrictions(ctx, fooDenom)
+	suite.Require().False(found)
+
+	// the blacklisted addresses can neither send nor receive the denom
+	app.BankKeeper.UpdateDenomAdmin(ctx, fooDenom, addr3)
+	app.BankKeeper.UpdateBlacklisted(ctx, fooDenom, addr2, true)
+	suite.Require().True(app.BankKeeper.IsBlacklisted(ctx, fooDenom, addr2))
+
+	suite.Require().ErrorIs(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))), types.ErrAddressBlacklisted)
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+
+	app.BankKeeper.UpdateBlacklisted(ctx, fooDenom, addr1, true)
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10))}}
+	outputs := []types.Output{{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(10))}}
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), types.ErrAddressBlacklisted)
+
+	app.BankKeeper.UpdateBlacklisted(ctx, fooDenom, addr1, false)
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+
+	// no transfer of a frozen denom is allowed
+	app.BankKeeper.UpdateDenomFrozen(ctx, fooDenom, true)
+	suite.Require().ErrorIs(app.BankKeeper.SendCoins(ctx, addr1, addr3, sdk.NewCoins(newFooCoin(10))), types.ErrDenomFrozen)
+
+	app.BankKeeper.UpdateDenomFrozen(ctx, fooDenom, false)
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr3, sdk.NewCoins(newFooCoin(10))))
+
+	restrictions, found := app.BankKeeper.GetDenomRestrictions(ctx, fooDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(types.DenomRestrictions{Denom: fooDenom, Admin: addr3.String()}, restrictions)
+
+	res, err := suite.queryClient.DenomBlacklist(ctx, &types.QueryDenomBlacklistRequest{Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{addr2.String()}, res.Addresses)
+}
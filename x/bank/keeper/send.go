@@ -21,12 +21,33 @@ type SendKeeper interface {
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 
 	GetParams(ctx sdk.Context) types.Params
-	SetParams(ctx sdk.Context, params types.Params)
+	SetParams(ctx sdk.Context, params types.Params) error
 
 	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
+	IsSendEnabledDenom(ctx sdk.Context, denom string) bool
+
+	GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool)
+	SetSendEnabledEntry(ctx sdk.Context, denom string, enabled bool)
+	SetAllSendEnabledEntries(ctx sdk.Context, entries []*types.SendEnabled)
+	DeleteSendEnabledEntries(ctx sdk.Context, denoms ...string)
+	IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, enabled bool) (stop bool))
+	GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled
 
 	BlockedAddr(addr sdk.AccAddress) bool
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
+
+	GetDenomRestrictions(ctx sdk.Context, denom string) (types.DenomRestrictions, bool)
+	UpdateDenomAdmin(ctx sdk.Context, denom string, admin sdk.AccAddress)
+	UpdateDenomFrozen(ctx sdk.Context, denom string, frozen bool)
+	IsBlacklisted(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
+	UpdateBlacklisted(ctx sdk.Context, denom string, addr sdk.AccAddress, blacklisted bool)
+	CheckDenomRestrictions(ctx sdk.Context, amt sdk.Coins, addrs ...sdk.AccAddress) error
+
+	GetAuthority() string
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -43,35 +64,75 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// the restrictions applied to the transfers of coins
+	sendRestriction *sendRestriction
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 func NewBaseSendKeeper(
 	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool,
+	authority string,
 ) BaseSendKeeper {
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		blockedAddrs:   blockedAddrs,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		paramSpace:      paramSpace,
+		blockedAddrs:    blockedAddrs,
+		sendRestriction: newSendRestriction(),
+		authority:       authority,
 	}
 }
 
+// GetAuthority returns the x/bank module's authority.
+func (k BaseSendKeeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
-// SetParams sets the total set of bank parameters.
-func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+// SetParams sets the total set of bank parameters. It returns an error if the
+// parameters are invalid.
+//
+// The deprecated params.SendEnabled entries are moved to the send enabled
+// store, the stored params never hold any.
+func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	if len(params.SendEnabled) > 0 {
+		k.SetAllSendEnabledEntries(ctx, params.SendEnabled)
+		params = types.NewParams(params.DefaultSendEnabled, nil)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+
+	return nil
 }
 
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
 // inputs and outputs don't lineup or if any single transfer of tokens fails.
+//
+// The send restrictions are applied to each output, they require a single
+// input.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -79,11 +140,21 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	if k.sendRestriction.isSet() && len(inputs) != 1 {
+		return sdkerrors.Wrap(types.ErrMultipleSenders, "send restrictions require a single input")
+	}
+
+	var fromAddr sdk.AccAddress
 	for _, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
 			return err
 		}
+		fromAddr = inAddress
+
+		if err := k.CheckDenomRestrictions(ctx, in.Coins, inAddress); err != nil {
+			return err
+		}
 
 		err = k.subUnlockedCoins(ctx, inAddress, in.Coins)
 		if err != nil {
@@ -103,6 +174,16 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		if err != nil {
 			return err
 		}
+
+		outAddress, err = k.sendRestriction.apply(ctx, fromAddr, outAddress, out.Coins)
+		if err != nil {
+			return err
+		}
+
+		if err := k.CheckDenomRestrictions(ctx, out.Coins, outAddress); err != nil {
+			return err
+		}
+
 		err = k.addCoins(ctx, outAddress, out.Coins)
 		if err != nil {
 			return err
@@ -111,7 +192,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+				sdk.NewAttribute(types.AttributeKeyRecipient, outAddress.String()),
 				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
 			),
 		)
@@ -131,9 +212,19 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// The send restrictions may reject the transfer or redirect it to another
+// recipient. An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := k.subUnlockedCoins(ctx, fromAddr, amt)
+	toAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	if err := k.CheckDenomRestrictions(ctx, amt, fromAddr, toAddr); err != nil {
+		return err
+	}
+
+	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -304,8 +395,13 @@ func (k BaseSendKeeper) setBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 // any of the coins are not configured for sending.  Returns nil if sending is enabled
 // for all provided coin
 func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error {
+	if len(coins) == 0 {
+		return nil
+	}
+
+	defaultEnabled := k.GetParams(ctx).DefaultSendEnabled
 	for _, coin := range coins {
-		if !k.IsSendEnabledCoin(ctx, coin) {
+		if !k.getSendEnabledOrDefault(ctx, coin.Denom, defaultEnabled) {
 			return sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", coin.Denom)
 		}
 	}
@@ -314,7 +410,118 @@ func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) e
 
 // IsSendEnabledCoin returns the current SendEnabled status of the provided coin's denom
 func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
-	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
+	return k.IsSendEnabledDenom(ctx, coin.Denom)
+}
+
+// IsSendEnabledDenom returns the current SendEnabled status of a denom, the
+// DefaultSendEnabled param if the denom has no send enabled entry.
+func (k BaseSendKeeper) IsSendEnabledDenom(ctx sdk.Context, denom string) bool {
+	enabled, found := k.getSendEnabled(ctx, denom)
+	if !found {
+		return k.GetParams(ctx).DefaultSendEnabled
+	}
+
+	return enabled
+}
+
+// GetSendEnabledEntry returns the send enabled entry of a denom, and false if
+// the denom has none.
+func (k BaseSendKeeper) GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool) {
+	enabled, found := k.getSendEnabled(ctx, denom)
+	if !found {
+		return types.SendEnabled{}, false
+	}
+
+	return types.SendEnabled{Denom: denom, Enabled: enabled}, true
+}
+
+// SetSendEnabledEntry sets the send enabled entry of a denom, overriding the
+// DefaultSendEnabled param for it.
+func (k BaseSendKeeper) SetSendEnabledEntry(ctx sdk.Context, denom string, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreateSendEnabledKey(denom), sendEnabledToBytes(enabled))
+}
+
+// SetAllSendEnabledEntries sets the send enabled entries of several denoms.
+func (k BaseSendKeeper) SetAllSendEnabledEntries(ctx sdk.Context, entries []*types.SendEnabled) {
+	for _, entry := range entries {
+		k.SetSendEnabledEntry(ctx, entry.Denom, entry.Enabled)
+	}
+}
+
+// DeleteSendEnabledEntries deletes the send enabled entries of denoms, which
+// then use the DefaultSendEnabled param.
+func (k BaseSendKeeper) DeleteSendEnabledEntries(ctx sdk.Context, denoms ...string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range denoms {
+		store.Delete(types.CreateSendEnabledKey(denom))
+	}
+}
+
+// IterateSendEnabledEntries iterates over the send enabled entries of all the
+// denoms. The iteration stops if the callback returns true.
+func (k BaseSendKeeper) IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, enabled bool) (stop bool)) {
+	store := k.getSendEnabledPrefixStore(ctx)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()), sendEnabledFromBytes(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllSendEnabledEntries returns the send enabled entries of all the denoms.
+func (k BaseSendKeeper) GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled {
+	var entries []types.SendEnabled
+	k.IterateSendEnabledEntries(ctx, func(denom string, enabled bool) bool {
+		entries = append(entries, types.SendEnabled{Denom: denom, Enabled: enabled})
+		return false
+	})
+
+	return entries
+}
+
+// getSendEnabled returns the send enabled status of a denom, and false if the
+// denom has no send enabled entry. It is a single store read, regardless of
+// the number of entries.
+func (k BaseSendKeeper) getSendEnabled(ctx sdk.Context, denom string) (enabled, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CreateSendEnabledKey(denom))
+	if bz == nil {
+		return false, false
+	}
+
+	return sendEnabledFromBytes(bz), true
+}
+
+// getSendEnabledOrDefault returns the send enabled status of a denom, or
+// defaultEnabled if the denom has no send enabled entry.
+func (k BaseSendKeeper) getSendEnabledOrDefault(ctx sdk.Context, denom string, defaultEnabled bool) bool {
+	enabled, found := k.getSendEnabled(ctx, denom)
+	if !found {
+		return defaultEnabled
+	}
+
+	return enabled
+}
+
+// getSendEnabledPrefixStore returns the prefix store of the send enabled
+// entries, keyed by denom.
+func (k BaseSendKeeper) getSendEnabledPrefixStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.SendEnabledPrefix)
+}
+
+func sendEnabledToBytes(enabled bool) []byte {
+	if enabled {
+		return []byte{0x01}
+	}
+	return []byte{0x00}
+}
+
+func sendEnabledFromBytes(bz []byte) bool {
+	return len(bz) > 0 && bz[0] == 0x01
 }
 
 // BlockedAddr checks if a given address is restricted from
@@ -72,11 +72,14 @@ func TestMigrateJSON(t *testing.T) {
 			]
 		}
 	],
+	"blacklisted_addresses": [],
 	"denom_metadata": [],
+	"denom_restrictions": [],
 	"params": {
 		"default_send_enabled": false,
 		"send_enabled": []
 	},
+	"send_enabled": [],
 	"supply": [
 		{
 			"amount": "20",
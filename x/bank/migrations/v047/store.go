@@ -0,0 +1,55 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+//
+// - Moving the params from the x/params subspace to the x/bank store.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	var params types.Params
+	legacySubspace.GetParamSet(ctx, &params)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
+
+// MigrateSendEnabled performs the in-place store migration of the send enabled
+// entries. The migration includes:
+//
+// - Moving the send enabled entries from the params to their own prefix
+// store, keyed by denom.
+func MigrateSendEnabled(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	cdc.MustUnmarshal(bz, &params)
+
+	for _, entry := range params.SendEnabled {
+		value := []byte{0x00}
+		if entry.Enabled {
+			value = []byte{0x01}
+		}
+		store.Set(types.CreateSendEnabledKey(entry.Denom), value)
+	}
+
+	params.SendEnabled = nil
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	bankKey := sdk.NewKVStoreKey("bank")
+	tBankKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(bankKey, tBankKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, bankKey, tBankKey, "bank").
+		WithKeyTable(types.ParamKeyTable())
+
+	legacyParams := types.NewParams(false, types.SendEnabledParams{types.NewSendEnabled("foocoin", true)})
+	paramstore.SetParamSet(ctx, &legacyParams)
+
+	// Check no params in the bank store
+	store := ctx.KVStore(bankKey)
+	require.False(t, store.Has(types.ParamsKey))
+
+	// Run migrations.
+	require.NoError(t, v047.MigrateStore(ctx, bankKey, paramstore, encCfg.Codec))
+
+	// Make sure the params were moved to the bank store.
+	var params types.Params
+	encCfg.Codec.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, legacyParams, params)
+}
+
+func TestMigrateSendEnabled(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	bankKey := sdk.NewKVStoreKey("bank")
+	ctx := testutil.DefaultContext(bankKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(bankKey)
+
+	params := types.NewParams(false, types.SendEnabledParams{
+		types.NewSendEnabled("foocoin", true),
+		types.NewSendEnabled("barcoin", false),
+	})
+	store.Set(types.ParamsKey, encCfg.Codec.MustMarshal(&params))
+
+	require.NoError(t, v047.MigrateSendEnabled(ctx, bankKey, encCfg.Codec))
+
+	// Make sure the entries were moved out of the params.
+	var migrated types.Params
+	encCfg.Codec.MustUnmarshal(store.Get(types.ParamsKey), &migrated)
+	require.Empty(t, migrated.SendEnabled)
+	require.False(t, migrated.DefaultSendEnabled)
+
+	require.Equal(t, []byte{0x01}, store.Get(types.CreateSendEnabledKey("foocoin")))
+	require.Equal(t, []byte{0x00}, store.Get(types.CreateSendEnabledKey("barcoin")))
+}
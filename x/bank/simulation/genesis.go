@@ -68,13 +68,16 @@ func RandomizedGenState(simState *module.SimulationState) {
 	totalSupply := simState.InitialStake.Mul(sdk.NewInt((numAccs + simState.NumBonded)))
 	supply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, totalSupply))
 
+	sendEnabled := make([]types.SendEnabled, 0, len(sendEnabledParams))
+	for _, entry := range sendEnabledParams {
+		sendEnabled = append(sendEnabled, *entry)
+	}
+
 	bankGenesis := types.GenesisState{
-		Params: types.Params{
-			SendEnabled:        sendEnabledParams,
-			DefaultSendEnabled: defaultSendEnabledParam,
-		},
-		Balances: RandomGenesisBalances(simState),
-		Supply:   supply,
+		Params:      types.NewParams(defaultSendEnabledParam, nil),
+		Balances:    RandomGenesisBalances(simState),
+		Supply:      supply,
+		SendEnabled: sendEnabled,
 	}
 
 	paramsBytes, err := json.MarshalIndent(&bankGenesis.Params, "", " ")
@@ -82,5 +85,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 		panic(err)
 	}
 	fmt.Printf("Selected randomly generated bank parameters:\n%s\n", paramsBytes)
+
+	sendEnabledBytes, err := json.MarshalIndent(bankGenesis.SendEnabled, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated bank send enabled entries:\n%s\n", sendEnabledBytes)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&bankGenesis)
 }
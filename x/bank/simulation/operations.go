@@ -20,13 +20,14 @@ import (
 const (
 	OpWeightMsgSend      = "op_weight_msg_send"      //nolint:gosec
 	OpWeightMsgMultiSend = "op_weight_msg_multisend" //nolint:gosec
+	OpWeightMsgBurn      = "op_weight_msg_burn"      //nolint:gosec
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
 func WeightedOperations(
 	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk keeper.Keeper,
 ) simulation.WeightedOperations {
-	var weightMsgSend, weightMsgMultiSend int
+	var weightMsgSend, weightMsgMultiSend, weightMsgBurn int
 	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
 		func(_ *rand.Rand) {
 			weightMsgSend = simappparams.DefaultWeightMsgSend
@@ -39,6 +40,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurn = simappparams.DefaultWeightMsgBurn
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSend,
@@ -48,6 +55,10 @@ func WeightedOperations(
 			weightMsgMultiSend,
 			SimulateMsgMultiSend(ak, bk),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgBurn,
+			SimulateMsgBurn(ak, bk),
+		),
 	}
 }
 
@@ -382,6 +393,47 @@ func sendMsgMultiSend(
 	return nil
 }
 
+// SimulateMsgBurn tests and runs a single msg burn of a random subset of the
+// spendable coins of an account.
+func SimulateMsgBurn(ak types.AccountKeeper, bk keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+
+		spendable := bk.SpendableCoins(ctx, from.Address)
+		coins := simtypes.RandSubsetCoins(r, spendable)
+		if coins.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurn, "empty coins slice"), nil, nil
+		}
+
+		// Check send_enabled status of each coin denom
+		if err := bk.IsSendEnabledCoins(ctx, coins...); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurn, err.Error()), nil, nil
+		}
+
+		msg := types.NewMsgBurn(from.Address, coins)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      from,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: coins,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 // randomSendFields returns the sender and recipient simulation accounts as well
 // as the transferred amount.
 func randomSendFields(
@@ -50,6 +50,7 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 	}{
 		{simappparams.DefaultWeightMsgSend, types.ModuleName, types.TypeMsgSend},
 		{simappparams.DefaultWeightMsgMultiSend, types.ModuleName, types.TypeMsgMultiSend},
+		{simappparams.DefaultWeightMsgBurn, types.ModuleName, types.TypeMsgBurn},
 	}
 
 	for i, w := range weightesOps {
@@ -123,6 +124,33 @@ func (suite *SimTestSuite) TestSimulateMsgMultiSend() {
 	require.Len(futureOperations, 0)
 }
 
+// TestSimulateMsgBurn tests the normal scenario of a valid message of type TypeMsgBurn.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func (suite *SimTestSuite) TestSimulateMsgBurn() {
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: suite.app.LastBlockHeight() + 1, AppHash: suite.app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgBurn(suite.app.AccountKeeper, suite.app.BankKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg types.MsgBurn
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal("4896096stake", msg.Amount.String())
+	suite.Require().Equal("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r", msg.FromAddress)
+	suite.Require().Equal(types.TypeMsgBurn, msg.Type())
+	suite.Require().Equal(types.ModuleName, msg.Route())
+	suite.Require().Len(futureOperations, 0)
+}
+
 func (suite *SimTestSuite) TestSimulateModuleAccountMsgSend() {
 	const (
 		accCount       = 1
@@ -5,7 +5,6 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -29,8 +28,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the bank module.
 type Params struct {
-	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
-	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// send_enabled is deprecated, the send enabled entries are stored per denom
+	// by the keeper and set with MsgSetSendEnabled or the send_enabled field of
+	// the genesis. The entries given here are moved to the keeper store when the
+	// params are set.
+	SendEnabled []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// default_send_enabled is the send enabled status of the denoms without a
+	// send enabled entry.
+	DefaultSendEnabled bool `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -441,6 +446,128 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// DenomRestrictions defines the restrictions applied to the transfers of a
+// denom by its admin.
+type DenomRestrictions struct {
+	// denom is the denom the restrictions apply to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// admin is the address of the account allowed to freeze the denom and to
+	// blacklist addresses.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// frozen is true if all the transfers of the denom are disabled.
+	Frozen bool `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
+}
+
+func (m *DenomRestrictions) Reset()         { *m = DenomRestrictions{} }
+func (m *DenomRestrictions) String() string { return proto.CompactTextString(m) }
+func (*DenomRestrictions) ProtoMessage()    {}
+func (*DenomRestrictions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *DenomRestrictions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRestrictions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRestrictions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRestrictions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRestrictions.Merge(m, src)
+}
+func (m *DenomRestrictions) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRestrictions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRestrictions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRestrictions proto.InternalMessageInfo
+
+func (m *DenomRestrictions) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomRestrictions) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *DenomRestrictions) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+// BlacklistedAddress defines an address which can neither send nor receive a
+// denom.
+type BlacklistedAddress struct {
+	// denom is the blacklisted denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// address is the blacklisted address.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *BlacklistedAddress) Reset()         { *m = BlacklistedAddress{} }
+func (m *BlacklistedAddress) String() string { return proto.CompactTextString(m) }
+func (*BlacklistedAddress) ProtoMessage()    {}
+func (*BlacklistedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{8}
+}
+func (m *BlacklistedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlacklistedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlacklistedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlacklistedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlacklistedAddress.Merge(m, src)
+}
+func (m *BlacklistedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlacklistedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlacklistedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlacklistedAddress proto.InternalMessageInfo
+
+func (m *BlacklistedAddress) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BlacklistedAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -449,53 +576,55 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*DenomRestrictions)(nil), "cosmos.bank.v1beta1.DenomRestrictions")
+	proto.RegisterType((*BlacklistedAddress)(nil), "cosmos.bank.v1beta1.BlacklistedAddress")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x34, 0x4d, 0xb2, 0x9d, 0xe8, 0x65, 0x0c, 0x3a, 0xed, 0x61, 0x13, 0xf6, 0x20, 0xb1,
-	0xd0, 0x24, 0xad, 0x9e, 0x82, 0x20, 0xb6, 0x8a, 0x46, 0x10, 0x65, 0x4b, 0x11, 0xbc, 0x84, 0x49,
-	0x76, 0x4c, 0x86, 0xee, 0xce, 0x2c, 0x3b, 0xb3, 0xa5, 0xb9, 0x0a, 0x82, 0x78, 0xf2, 0xe8, 0xb1,
-	0x57, 0x3d, 0x79, 0x28, 0xf8, 0x17, 0x8a, 0xa7, 0xe2, 0xc9, 0x53, 0x95, 0xf4, 0xa0, 0x3f, 0x43,
-	0x66, 0x66, 0x37, 0x4d, 0xa1, 0x8a, 0x07, 0x0f, 0x9e, 0xf6, 0x7d, 0xef, 0x7b, 0xf3, 0xbd, 0xef,
-	0xed, 0xbc, 0x5d, 0xe8, 0x0e, 0x85, 0x8c, 0x84, 0x6c, 0x0f, 0x08, 0xdf, 0x6d, 0xef, 0xad, 0x0f,
-	0xa8, 0x22, 0xeb, 0x06, 0xb4, 0xe2, 0x44, 0x28, 0x81, 0xae, 0x58, 0xbe, 0x65, 0x52, 0x19, 0xbf,
-	0x52, 0x1b, 0x89, 0x91, 0x30, 0x7c, 0x5b, 0x47, 0xb6, 0x74, 0x65, 0xd9, 0x96, 0xf6, 0x2d, 0x91,
-	0x9d, 0xb3, 0xd4, 0x59, 0x17, 0x49, 0x67, 0x5d, 0x86, 0x82, 0xf1, 0x8c, 0xbf, 0x96, 0xf1, 0x91,
-	0x1c, 0xb5, 0xf7, 0xd6, 0xf5, 0xc3, 0x12, 0xde, 0x2b, 0x00, 0xcb, 0x4f, 0x49, 0x42, 0x22, 0x89,
-	0xb6, 0xe0, 0x25, 0x49, 0x79, 0xd0, 0xa7, 0x9c, 0x0c, 0x42, 0x1a, 0x60, 0xd0, 0x28, 0x36, 0xab,
-	0x1b, 0x8d, 0xd6, 0x05, 0x06, 0x5b, 0xdb, 0x94, 0x07, 0xf7, 0x6d, 0x9d, 0x5f, 0x95, 0x67, 0x00,
-	0x75, 0x60, 0x2d, 0xa0, 0x2f, 0x48, 0x1a, 0xaa, 0xfe, 0x39, 0xb1, 0x85, 0x06, 0x68, 0x3a, 0x3e,
-	0xca, 0xb8, 0xb9, 0xe3, 0xdd, 0xc5, 0x77, 0x07, 0xf5, 0x82, 0xf7, 0x00, 0x56, 0xe7, 0x92, 0xa8,
-	0x06, 0x4b, 0x01, 0xe5, 0x22, 0xc2, 0xa0, 0x01, 0x9a, 0x4b, 0xbe, 0x05, 0x08, 0xc3, 0xca, 0x79,
-	0xbd, 0x1c, 0x76, 0x1d, 0x2d, 0xf2, 0xf3, 0xa0, 0x0e, 0xbc, 0x43, 0x00, 0x4b, 0x3d, 0x1e, 0xa7,
-	0x0a, 0x6d, 0xc0, 0x0a, 0x09, 0x82, 0x84, 0x4a, 0x69, 0x55, 0x36, 0xf1, 0x97, 0xc3, 0xb5, 0x5a,
-	0x36, 0xcd, 0x5d, 0xcb, 0x6c, 0xab, 0x84, 0xf1, 0x91, 0x9f, 0x17, 0x22, 0x02, 0x4b, 0xfa, 0xad,
-	0x49, 0xbc, 0x60, 0x86, 0x5f, 0x3e, 0x1b, 0x5e, 0xd2, 0xd9, 0xf0, 0x5b, 0x82, 0xf1, 0xcd, 0xce,
-	0xd1, 0x49, 0xbd, 0xf0, 0xe1, 0x5b, 0xbd, 0x39, 0x62, 0x6a, 0x9c, 0x0e, 0x5a, 0x43, 0x11, 0x65,
-	0x57, 0x92, 0x3d, 0xd6, 0x64, 0xb0, 0xdb, 0x56, 0x93, 0x98, 0x4a, 0x73, 0x40, 0xfa, 0x56, 0xb9,
-	0x5b, 0x7b, 0x6d, 0xad, 0x16, 0x5e, 0xfe, 0xf8, 0xb8, 0x9a, 0x37, 0xf6, 0xde, 0x03, 0x58, 0x7e,
-	0x92, 0xaa, 0xff, 0xd8, 0xb7, 0x93, 0xfb, 0xf6, 0x3e, 0x01, 0x58, 0xde, 0x4e, 0xe3, 0x38, 0x9c,
-	0xe8, 0xbe, 0x4a, 0x28, 0x12, 0x66, 0xcb, 0xf2, 0x6f, 0xfb, 0x1a, 0xe5, 0xee, 0xa3, 0xac, 0x2f,
-	0xf8, 0x7c, 0xb8, 0x76, 0x7b, 0xf5, 0x8f, 0xa7, 0xf7, 0xed, 0x57, 0x16, 0xb1, 0x51, 0x42, 0x14,
-	0x13, 0x5c, 0xb6, 0xf7, 0x3a, 0xb7, 0x3a, 0x2d, 0xeb, 0xb5, 0x87, 0x81, 0xf7, 0x0c, 0x2e, 0xdd,
-	0xd3, 0x9b, 0xb4, 0xc3, 0x99, 0xfa, 0xcd, 0x8e, 0xad, 0x40, 0x87, 0xee, 0xc7, 0x82, 0x53, 0xae,
-	0xcc, 0x92, 0x5d, 0xf6, 0x67, 0x58, 0xef, 0x1f, 0x09, 0x19, 0x91, 0x54, 0xe2, 0x62, 0xa3, 0xd8,
-	0x5c, 0xf2, 0x73, 0xe8, 0xbd, 0x59, 0x80, 0xce, 0x63, 0xaa, 0x48, 0x40, 0x14, 0x41, 0x0d, 0x58,
-	0x0d, 0xa8, 0x1c, 0x26, 0x2c, 0xd6, 0x26, 0x32, 0xf9, 0xf9, 0x14, 0xba, 0xa3, 0x2b, 0xb8, 0x88,
-	0xfa, 0x29, 0x67, 0x2a, 0xbf, 0x34, 0xf7, 0xc2, 0x2f, 0x6d, 0xe6, 0xd7, 0x87, 0x41, 0x1e, 0x4a,
-	0x84, 0xe0, 0xa2, 0x7e, 0xc5, 0xb8, 0x68, 0xb4, 0x4d, 0xac, 0xdd, 0x05, 0x4c, 0xc6, 0x21, 0x99,
-	0xe0, 0x45, 0x93, 0xce, 0xa1, 0xae, 0xe6, 0x24, 0xa2, 0xb8, 0x64, 0xab, 0x75, 0x8c, 0xae, 0xc2,
-	0xb2, 0x9c, 0x44, 0x03, 0x11, 0xe2, 0xb2, 0xc9, 0x66, 0x08, 0x2d, 0xc3, 0x62, 0x9a, 0x30, 0x5c,
-	0x31, 0x9b, 0x57, 0x99, 0x9e, 0xd4, 0x8b, 0x3b, 0x7e, 0xcf, 0xd7, 0x39, 0x74, 0x1d, 0x3a, 0x69,
-	0xc2, 0xfa, 0x63, 0x22, 0xc7, 0xd8, 0x31, 0x7c, 0x75, 0x7a, 0x52, 0xaf, 0xec, 0xf8, 0xbd, 0x87,
-	0x44, 0x8e, 0xfd, 0x4a, 0x9a, 0x30, 0x1d, 0x6c, 0x6e, 0x1d, 0x4d, 0x5d, 0x70, 0x3c, 0x75, 0xc1,
-	0xf7, 0xa9, 0x0b, 0xde, 0x9e, 0xba, 0x85, 0xe3, 0x53, 0xb7, 0xf0, 0xf5, 0xd4, 0x2d, 0x3c, 0xbf,
-	0xf1, 0x37, 0xd7, 0x67, 0x76, 0x60, 0x50, 0x36, 0xff, 0xa7, 0x9b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x8f, 0x9f, 0xc3, 0x47, 0x40, 0x05, 0x00, 0x00,
+	// 654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xb1, 0x6f, 0x13, 0x3d,
+	0x14, 0x8f, 0x93, 0x26, 0xb9, 0x3a, 0xdf, 0x37, 0x7c, 0xfe, 0x22, 0xb8, 0x76, 0xb8, 0x44, 0x37,
+	0xa0, 0x80, 0x44, 0xd2, 0xc2, 0x96, 0x05, 0x91, 0x16, 0x41, 0x07, 0x04, 0x72, 0x55, 0x55, 0x62,
+	0x89, 0x9c, 0xd8, 0x4d, 0xac, 0xde, 0xd9, 0xa7, 0xb3, 0xaf, 0x34, 0x8c, 0x48, 0x48, 0x88, 0x05,
+	0x46, 0xc4, 0xd4, 0x99, 0x89, 0x3f, 0xa3, 0x63, 0x47, 0xa6, 0x82, 0xd2, 0x01, 0xfe, 0x0c, 0x64,
+	0xfb, 0x2e, 0x6d, 0xa5, 0x96, 0x0d, 0x31, 0xdd, 0xfb, 0xbd, 0xf7, 0xee, 0xe7, 0xdf, 0xfb, 0xf9,
+	0xdd, 0xc1, 0x60, 0x2c, 0x55, 0x2c, 0x55, 0x6f, 0x44, 0xc4, 0x7e, 0xef, 0x60, 0x7d, 0xc4, 0x34,
+	0x59, 0xb7, 0xa0, 0x9b, 0xa4, 0x52, 0x4b, 0xf4, 0xbf, 0xab, 0x77, 0x6d, 0x2a, 0xaf, 0xaf, 0x36,
+	0x27, 0x72, 0x22, 0x6d, 0xbd, 0x67, 0x22, 0xd7, 0xba, 0x7a, 0x4e, 0xa5, 0xd8, 0x82, 0x6a, 0x2c,
+	0xb9, 0xc8, 0xeb, 0x37, 0xf3, 0x7a, 0xac, 0x26, 0xbd, 0x83, 0x75, 0xf3, 0x70, 0x85, 0xf0, 0x0d,
+	0x80, 0xb5, 0xe7, 0x24, 0x25, 0xb1, 0x42, 0x1b, 0xf0, 0x1f, 0xc5, 0x04, 0x1d, 0x32, 0x41, 0x46,
+	0x11, 0xa3, 0x3e, 0x68, 0x57, 0x3a, 0x8d, 0x7b, 0xed, 0xee, 0x15, 0x2a, 0xba, 0xdb, 0x4c, 0xd0,
+	0x47, 0xae, 0x0f, 0x37, 0xd4, 0x39, 0x40, 0x6b, 0xb0, 0x49, 0xd9, 0x1e, 0xc9, 0x22, 0x3d, 0xbc,
+	0x44, 0x56, 0x6e, 0x83, 0x8e, 0x87, 0x51, 0x5e, 0xbb, 0xf0, 0x7a, 0x7f, 0xe9, 0xe3, 0x51, 0xab,
+	0x14, 0x3e, 0x86, 0x8d, 0x0b, 0x49, 0xd4, 0x84, 0x55, 0xca, 0x84, 0x8c, 0x7d, 0xd0, 0x06, 0x9d,
+	0x65, 0xec, 0x00, 0xf2, 0x61, 0xfd, 0x32, 0x5f, 0x01, 0xfb, 0x9e, 0x21, 0xf9, 0x79, 0xd4, 0x02,
+	0xe1, 0x27, 0x00, 0xab, 0x5b, 0x22, 0xc9, 0xb4, 0xe9, 0x26, 0x94, 0xa6, 0x4c, 0xa9, 0x9c, 0xa5,
+	0x80, 0x88, 0xc0, 0xaa, 0xf1, 0x46, 0xf9, 0x65, 0x3b, 0xe2, 0xca, 0xf9, 0x88, 0x8a, 0x2d, 0x46,
+	0xdc, 0x90, 0x5c, 0x0c, 0xd6, 0x8e, 0x4f, 0x5b, 0xa5, 0xcf, 0xdf, 0x5a, 0x9d, 0x09, 0xd7, 0xd3,
+	0x6c, 0xd4, 0x1d, 0xcb, 0xb8, 0x97, 0x5b, 0xe9, 0x1e, 0x77, 0x15, 0xdd, 0xef, 0xe9, 0x59, 0xc2,
+	0x94, 0x7d, 0x41, 0x61, 0xc7, 0xdc, 0x6f, 0xbe, 0x75, 0x82, 0x4a, 0xaf, 0x7f, 0x7c, 0xb9, 0x53,
+	0x1c, 0x1c, 0xbe, 0x07, 0xb0, 0xf6, 0x2c, 0xd3, 0x7f, 0x5d, 0x9d, 0x57, 0xa8, 0x0b, 0x5f, 0xc2,
+	0xda, 0x76, 0x96, 0x24, 0xd1, 0xcc, 0x1c, 0xab, 0xa5, 0x26, 0x91, 0x0f, 0xfe, 0xc0, 0xb1, 0x96,
+	0xb9, 0x0f, 0xf3, 0x63, 0x81, 0x0f, 0xc2, 0x5d, 0xb8, 0xbc, 0x69, 0x2e, 0x75, 0x47, 0x70, 0x7d,
+	0xcd, 0x75, 0xaf, 0x42, 0x8f, 0x1d, 0x26, 0x52, 0x30, 0xa1, 0xed, 0x7d, 0xff, 0x8b, 0x17, 0xd8,
+	0xda, 0x17, 0x71, 0xa2, 0x98, 0xf2, 0x2b, 0xed, 0x8a, 0xb5, 0xcf, 0xc1, 0xf0, 0x5d, 0x19, 0x7a,
+	0x4f, 0x99, 0x26, 0x94, 0x68, 0x82, 0xda, 0xb0, 0x41, 0x99, 0x1a, 0xa7, 0x3c, 0xd1, 0x5c, 0x8a,
+	0x9c, 0xfe, 0x62, 0x0a, 0x3d, 0x30, 0x1d, 0x42, 0xc6, 0xc3, 0x4c, 0x70, 0x5d, 0x78, 0x1e, 0x5c,
+	0xb9, 0xf4, 0x0b, 0xbd, 0x18, 0xd2, 0x22, 0x54, 0x08, 0xc1, 0x25, 0x63, 0x91, 0x5f, 0xb1, 0xdc,
+	0x36, 0x36, 0xea, 0x28, 0x57, 0x49, 0x44, 0x66, 0xfe, 0x92, 0xbb, 0xdc, 0x1c, 0x9a, 0x6e, 0x41,
+	0x62, 0xe6, 0x57, 0x5d, 0xb7, 0x89, 0xd1, 0x0d, 0x58, 0x53, 0xb3, 0x78, 0x24, 0x23, 0xbf, 0x66,
+	0xb3, 0x39, 0x42, 0x2b, 0xb0, 0x92, 0xa5, 0xdc, 0xaf, 0x9b, 0xe4, 0xa0, 0x3e, 0x3f, 0x6d, 0x55,
+	0x76, 0xf0, 0x16, 0x36, 0x39, 0x74, 0x0b, 0x7a, 0x59, 0xca, 0x87, 0x53, 0xa2, 0xa6, 0xbe, 0x67,
+	0xeb, 0x8d, 0xf9, 0x69, 0xab, 0xbe, 0x83, 0xb7, 0x9e, 0x10, 0x35, 0xc5, 0xf5, 0x2c, 0xe5, 0x26,
+	0x08, 0x77, 0xe1, 0x7f, 0x56, 0x35, 0x66, 0x4a, 0xa7, 0x7c, 0x6c, 0x26, 0x56, 0xd7, 0xb8, 0xdd,
+	0x84, 0x55, 0x42, 0x63, 0x2e, 0xac, 0xd5, 0xcb, 0xd8, 0x01, 0xa3, 0x6d, 0x2f, 0x95, 0xaf, 0x98,
+	0xb0, 0xf3, 0x79, 0x38, 0x47, 0xe1, 0x26, 0x44, 0x83, 0x88, 0x8c, 0xf7, 0x23, 0xae, 0x34, 0xa3,
+	0x0f, 0xf3, 0xd5, 0xbd, 0xf6, 0xb3, 0x2d, 0x56, 0xbd, 0x7c, 0x69, 0xd5, 0x07, 0x1b, 0xc7, 0xf3,
+	0x00, 0x9c, 0xcc, 0x03, 0xf0, 0x7d, 0x1e, 0x80, 0x0f, 0x67, 0x41, 0xe9, 0xe4, 0x2c, 0x28, 0x7d,
+	0x3d, 0x0b, 0x4a, 0x2f, 0x6e, 0xff, 0x76, 0xb7, 0x0e, 0xdd, 0x3f, 0xd3, 0xae, 0xd8, 0xa8, 0x66,
+	0xff, 0x64, 0xf7, 0x7f, 0x0d, 0x00, 0x6c, 0x27, 0xaa, 0x10, 0x4f, 0x05, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -896,6 +1025,90 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomRestrictions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRestrictions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRestrictions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlacklistedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlacklistedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlacklistedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -1059,6 +1272,43 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *DenomRestrictions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *BlacklistedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2028,6 +2278,254 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomRestrictions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRestrictions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRestrictions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlacklistedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlacklistedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlacklistedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
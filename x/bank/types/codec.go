@@ -17,7 +17,14 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSend{}, "cosmos-sdk/MsgSend")
 	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomAdmin{}, "cosmos-sdk/MsgSetDenomAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomFrozen{}, "cosmos-sdk/MsgSetDenomFrozen")
+	legacy.RegisterAminoMsg(cdc, &MsgSetBlacklisted{}, "cosmos-sdk/MsgSetBlacklisted")
+	legacy.RegisterAminoMsg(cdc, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgBurn{}, "cosmos-sdk/MsgBurn")
 	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
+	cdc.RegisterConcrete(&PeriodicSendAuthorization{}, "cosmos-sdk/PeriodicSendAuthorization", nil)
 	cdc.RegisterConcrete(&MintTokensProposal{}, "rarimocore/MintTokensProposal", nil)
 }
 
@@ -25,10 +32,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
 		&MsgMultiSend{},
+		&MsgUpdateParams{},
+		&MsgSetDenomAdmin{},
+		&MsgSetDenomFrozen{},
+		&MsgSetBlacklisted{},
+		&MsgSetSendEnabled{},
+		&MsgBurn{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
 		&SendAuthorization{},
+		&PeriodicSendAuthorization{},
 	)
 
 	registry.RegisterImplementations(
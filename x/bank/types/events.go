@@ -23,6 +23,17 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// denom restrictions events name and attributes
+	EventTypeSetDenomAdmin  = "set_denom_admin"
+	EventTypeSetDenomFrozen = "set_denom_frozen"
+	EventTypeSetBlacklisted = "set_blacklisted"
+
+	AttributeKeyDenom       = "denom"
+	AttributeKeyAdmin       = "admin"
+	AttributeKeyFrozen      = "frozen"
+	AttributeKeyAddress     = "address"
+	AttributeKeyBlacklisted = "blacklisted"
 )
 
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
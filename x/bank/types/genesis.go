@@ -15,6 +15,21 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// the deprecated send enabled entries of the params are moved to the
+	// keeper store at genesis, along with the send enabled entries
+	seenSendEnabled := make(map[string]bool)
+	for _, entry := range gs.GetAllSendEnabled() {
+		if seenSendEnabled[entry.Denom] {
+			return fmt.Errorf("duplicate send enabled entry for denom %s", entry.Denom)
+		}
+
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+
+		seenSendEnabled[entry.Denom] = true
+	}
+
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
 
@@ -46,6 +61,37 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenRestrictions := make(map[string]bool)
+	for _, restrictions := range gs.DenomRestrictions {
+		if seenRestrictions[restrictions.Denom] {
+			return fmt.Errorf("duplicate restrictions for denom %s", restrictions.Denom)
+		}
+
+		if err := restrictions.Validate(); err != nil {
+			return err
+		}
+
+		seenRestrictions[restrictions.Denom] = true
+	}
+
+	seenBlacklisted := make(map[string]bool)
+	for _, blacklisted := range gs.BlacklistedAddresses {
+		key := blacklisted.Denom + "/" + blacklisted.Address
+		if seenBlacklisted[key] {
+			return fmt.Errorf("duplicate blacklisted address %s for denom %s", blacklisted.Address, blacklisted.Denom)
+		}
+
+		if err := blacklisted.Validate(); err != nil {
+			return err
+		}
+
+		if !seenRestrictions[blacklisted.Denom] {
+			return fmt.Errorf("blacklisted address %s for denom %s without restrictions", blacklisted.Address, blacklisted.Denom)
+		}
+
+		seenBlacklisted[key] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -71,6 +117,17 @@ func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomM
 	}
 }
 
+// GetAllSendEnabled returns the send enabled entries of the genesis state,
+// including the deprecated ones of its params.
+func (gs GenesisState) GetAllSendEnabled() []SendEnabled {
+	entries := make([]SendEnabled, 0, len(gs.Params.SendEnabled)+len(gs.SendEnabled))
+	for _, entry := range gs.Params.SendEnabled {
+		entries = append(entries, *entry)
+	}
+
+	return append(entries, gs.SendEnabled...)
+}
+
 // DefaultGenesisState returns a default bank module genesis state.
 func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(DefaultParams(), []Balance{}, sdk.Coins{}, []Metadata{})
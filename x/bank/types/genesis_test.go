@@ -54,6 +54,29 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid send enabled",
+			GenesisState{
+				SendEnabled: []SendEnabled{
+					{"", true},
+				},
+			},
+			true,
+		},
+		{
+			"dup send enabled",
+			GenesisState{
+				Params: Params{
+					SendEnabled: []*SendEnabled{
+						{"uatom", true},
+					},
+				},
+				SendEnabled: []SendEnabled{
+					{"uatom", false},
+				},
+			},
+			true,
+		},
 		{
 			"dup balances",
 			GenesisState{
@@ -140,6 +163,57 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid denom restrictions",
+			GenesisState{
+				DenomRestrictions: []DenomRestrictions{
+					{Denom: "uatom", Admin: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Frozen: true},
+				},
+				BlacklistedAddresses: []BlacklistedAddress{
+					{Denom: "uatom", Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"},
+				},
+			},
+			false,
+		},
+		{
+			"dup denom restrictions",
+			GenesisState{
+				DenomRestrictions: []DenomRestrictions{
+					{Denom: "uatom"},
+					{Denom: "uatom", Frozen: true},
+				},
+			},
+			true,
+		},
+		{
+			"invalid denom admin",
+			GenesisState{
+				DenomRestrictions: []DenomRestrictions{
+					{Denom: "uatom", Admin: "invalid"},
+				},
+			},
+			true,
+		},
+		{
+			"dup blacklisted address",
+			GenesisState{
+				DenomRestrictions: []DenomRestrictions{{Denom: "uatom"}},
+				BlacklistedAddresses: []BlacklistedAddress{
+					{Denom: "uatom", Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"},
+					{Denom: "uatom", Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"},
+				},
+			},
+			true,
+		},
+		{
+			"blacklisted address without denom restrictions",
+			GenesisState{
+				BlacklistedAddresses: []BlacklistedAddress{
+					{Denom: "uatom", Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
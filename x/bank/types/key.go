@@ -26,9 +26,24 @@ var (
 	DenomMetadataPrefix = []byte{0x1}
 	DenomAddressPrefix  = []byte{0x03}
 
+	// SendEnabledPrefix is the prefix for the send enabled entries of the
+	// denoms.
+	SendEnabledPrefix = []byte{0x04}
+
 	// BalancesPrefix is the prefix for the account balances store. We use a byte
 	// (instead of `[]byte("balances")` to save some disk space).
 	BalancesPrefix = []byte{0x02}
+
+	// ParamsKey is the key of the bank module params
+	ParamsKey = []byte{0x05}
+
+	// DenomRestrictionsPrefix is the prefix for the admin and freeze status of
+	// the restricted denoms.
+	DenomRestrictionsPrefix = []byte{0x06}
+
+	// DenomBlacklistPrefix is the prefix for the addresses blacklisted per
+	// denom.
+	DenomBlacklistPrefix = []byte{0x07}
 )
 
 // AddressAndDenomFromBalancesStore returns an account address and denom from a balances prefix
@@ -73,3 +88,25 @@ func CreateDenomAddressPrefix(denom string) []byte {
 	copy(key[len(DenomAddressPrefix):], denom)
 	return key
 }
+
+// CreateDenomRestrictionsKey returns the key of the restrictions of a denom.
+func CreateDenomRestrictionsKey(denom string) []byte {
+	return append(DenomRestrictionsPrefix, []byte(denom)...)
+}
+
+// CreateDenomBlacklistPrefix returns the prefix of the blacklisted addresses
+// of a denom.
+func CreateDenomBlacklistPrefix(denom string) []byte {
+	return append(DenomBlacklistPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// CreateDenomBlacklistKey returns the key of a blacklisted address of a
+// denom.
+func CreateDenomBlacklistKey(denom string, addr sdk.AccAddress) []byte {
+	return append(CreateDenomBlacklistPrefix(denom), addr...)
+}
+
+// CreateSendEnabledKey returns the key of the send enabled entry of a denom.
+func CreateSendEnabledKey(denom string) []byte {
+	return append(SendEnabledPrefix, []byte(denom)...)
+}
@@ -9,6 +9,16 @@ import (
 const (
 	TypeMsgSend      = "send"
 	TypeMsgMultiSend = "multisend"
+
+	TypeMsgUpdateParams = "update_params"
+
+	TypeMsgSetDenomAdmin  = "set_denom_admin"
+	TypeMsgSetDenomFrozen = "set_denom_frozen"
+	TypeMsgSetBlacklisted = "set_blacklisted"
+
+	TypeMsgSetSendEnabled = "set_send_enabled"
+
+	TypeMsgBurn = "burn"
 )
 
 var _ sdk.Msg = &MsgSend{}
@@ -184,3 +194,282 @@ func ValidateInputsOutputs(inputs []Input, outputs []Output) error {
 
 	return nil
 }
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// Route Implements Msg
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgSetDenomAdmin{}
+
+// NewMsgSetDenomAdmin creates a new MsgSetDenomAdmin instance.
+//
+//nolint:interfacer
+func NewMsgSetDenomAdmin(sender sdk.AccAddress, denom string, admin sdk.AccAddress) *MsgSetDenomAdmin {
+	msg := &MsgSetDenomAdmin{Sender: sender.String(), Denom: denom}
+	if !admin.Empty() {
+		msg.Admin = admin.String()
+	}
+	return msg
+}
+
+// Route Implements Msg
+func (msg MsgSetDenomAdmin) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetDenomAdmin) Type() string { return TypeMsgSetDenomAdmin }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetDenomAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if msg.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetDenomAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetDenomAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetDenomFrozen{}
+
+// NewMsgSetDenomFrozen creates a new MsgSetDenomFrozen instance.
+//
+//nolint:interfacer
+func NewMsgSetDenomFrozen(admin sdk.AccAddress, denom string, frozen bool) *MsgSetDenomFrozen {
+	return &MsgSetDenomFrozen{Admin: admin.String(), Denom: denom, Frozen: frozen}
+}
+
+// Route Implements Msg
+func (msg MsgSetDenomFrozen) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetDenomFrozen) Type() string { return TypeMsgSetDenomFrozen }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetDenomFrozen) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetDenomFrozen) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetDenomFrozen) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+var _ sdk.Msg = &MsgSetBlacklisted{}
+
+// NewMsgSetBlacklisted creates a new MsgSetBlacklisted instance.
+//
+//nolint:interfacer
+func NewMsgSetBlacklisted(admin sdk.AccAddress, denom string, blacklisted bool, addrs ...sdk.AccAddress) *MsgSetBlacklisted {
+	addresses := make([]string, len(addrs))
+	for i, addr := range addrs {
+		addresses[i] = addr.String()
+	}
+
+	return &MsgSetBlacklisted{Admin: admin.String(), Denom: denom, Addresses: addresses, Blacklisted: blacklisted}
+}
+
+// Route Implements Msg
+func (msg MsgSetBlacklisted) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetBlacklisted) Type() string { return TypeMsgSetBlacklisted }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetBlacklisted) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if len(msg.Addresses) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("addresses cannot be empty")
+	}
+
+	for _, addr := range msg.Addresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid address %s: %s", addr, err)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetBlacklisted) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetBlacklisted) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+var _ sdk.Msg = &MsgSetSendEnabled{}
+
+// NewMsgSetSendEnabled creates a new MsgSetSendEnabled instance.
+func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefaultFor []string) *MsgSetSendEnabled {
+	return &MsgSetSendEnabled{
+		Authority:     authority,
+		SendEnabled:   sendEnabled,
+		UseDefaultFor: useDefaultFor,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSetSendEnabled) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetSendEnabled) Type() string { return TypeMsgSetSendEnabled }
+
+// ValidateBasic Implements Msg. A denom may appear at most once, either in
+// the entries to set or in the denoms to reset to the default.
+func (msg MsgSetSendEnabled) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if len(msg.SendEnabled) == 0 && len(msg.UseDefaultFor) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("send enabled entries and use default denoms cannot both be empty")
+	}
+
+	seen := make(map[string]bool, len(msg.SendEnabled)+len(msg.UseDefaultFor))
+	for _, entry := range msg.SendEnabled {
+		if entry == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("send enabled entry cannot be nil")
+		}
+		if err := entry.Validate(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid send enabled denom %q: %s", entry.Denom, err)
+		}
+		if seen[entry.Denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom %s", entry.Denom)
+		}
+		seen[entry.Denom] = true
+	}
+
+	for _, denom := range msg.UseDefaultFor {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid use default denom %q: %s", denom, err)
+		}
+		if seen[denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetSendEnabled) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetSendEnabled) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgBurn{}
+
+// NewMsgBurn - construct a msg to burn coins from the balance of an account.
+//
+//nolint:interfacer
+func NewMsgBurn(fromAddr sdk.AccAddress, amount sdk.Coins) *MsgBurn {
+	return &MsgBurn{FromAddress: fromAddr.String(), Amount: amount}
+}
+
+// Route Implements Msg.
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBurn) Type() string { return TypeMsgBurn }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if !msg.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
@@ -276,3 +276,97 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, 1, len(res))
 	require.True(t, from.Equals(res[0]))
 }
+
+func TestMsgDenomRestrictionsValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from________________"))
+	addr2 := sdk.AccAddress([]byte("to__________________"))
+	addrEmpty := sdk.AccAddress([]byte(""))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         sdk.Msg
+	}{
+		{"", NewMsgSetDenomAdmin(addr1, "atom", addr2)},
+		{"", NewMsgSetDenomAdmin(addr1, "atom", addrEmpty)}, // removes the admin
+		{"invalid sender address: empty address string is not allowed: invalid address", NewMsgSetDenomAdmin(addrEmpty, "atom", addr2)},
+		{"invalid denom: 1atom: invalid coins", NewMsgSetDenomAdmin(addr1, "1atom", addr2)},
+		{"", NewMsgSetDenomFrozen(addr1, "atom", true)},
+		{"invalid admin address: empty address string is not allowed: invalid address", NewMsgSetDenomFrozen(addrEmpty, "atom", true)},
+		{"", NewMsgSetBlacklisted(addr1, "atom", true, addr2)},
+		{"addresses cannot be empty: invalid request", NewMsgSetBlacklisted(addr1, "atom", true)},
+		{"invalid denom: 1atom: invalid coins", NewMsgSetBlacklisted(addr1, "1atom", false, addr2)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSetSendEnabledValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________")).String()
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *MsgSetSendEnabled
+	}{
+		{"", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("atom", true)}, nil)},
+		{"", NewMsgSetSendEnabled(authority, nil, []string{"atom"})},
+		{"", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("atom", false)}, []string{"osmo"})},
+		{"invalid authority address: empty address string is not allowed: invalid address", NewMsgSetSendEnabled("", []*SendEnabled{NewSendEnabled("atom", true)}, nil)},
+		{"send enabled entries and use default denoms cannot both be empty: invalid request", NewMsgSetSendEnabled(authority, nil, nil)},
+		{"send enabled entry cannot be nil: invalid request", NewMsgSetSendEnabled(authority, []*SendEnabled{nil}, nil)},
+		{"invalid send enabled denom \"1atom\": invalid denom: 1atom: invalid request", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("1atom", true)}, nil)},
+		{"invalid use default denom \"1atom\": invalid denom: 1atom: invalid request", NewMsgSetSendEnabled(authority, nil, []string{"1atom"})},
+		{"duplicate denom atom: invalid request", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("atom", true), NewSendEnabled("atom", false)}, nil)},
+		{"duplicate denom atom: invalid request", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("atom", true)}, []string{"atom"})},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgBurnValidation(t *testing.T) {
+	addr := sdk.AccAddress([]byte("from________________"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.Coins{sdk.NewInt64Coin("atom", 0)}
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *MsgBurn
+	}{
+		{"", NewMsgBurn(addr, atom123)},
+		{"invalid from address: empty address string is not allowed: invalid address", NewMsgBurn(sdk.AccAddress{}, atom123)},
+		{": invalid coins", NewMsgBurn(addr, nil)},
+		{"0atom: invalid coins", NewMsgBurn(addr, atom0)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgBurnGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress([]byte("input"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	msg := NewMsgBurn(addr, coins)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"cosmos-sdk/MsgBurn","value":{"amount":[{"amount":"10","denom":"atom"}],"from_address":"cosmos1d9h8qat57ljhcm"}}`
+	require.Equal(t, expected, string(res))
+}
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.SpendLimitAuthorization = &PeriodicSendAuthorization{}
+
+// NewPeriodicSendAuthorization creates a new PeriodicSendAuthorization object.
+// The first period starts with the first send, spendLimit is optional.
+func NewPeriodicSendAuthorization(
+	spendLimit sdk.Coins, period time.Duration, periodSpendLimit sdk.Coins, allowed ...sdk.AccAddress,
+) *PeriodicSendAuthorization {
+	return &PeriodicSendAuthorization{
+		SpendLimit:       spendLimit,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		AllowList:        toBech32Addresses(allowed),
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PeriodicSendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept. The amount sent is deducted from
+// both the current period and the overall spend limit, the authorization is
+// deleted once the overall spend limit is spent.
+func (a PeriodicSendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mSend, ok := msg.(*MsgSend)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if err := checkAllowList(ctx, a.AllowList, mSend.ToAddress); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	a.tryResetPeriod(ctx.BlockTime())
+
+	// deduct from both the current period and the max amount
+	var isNeg bool
+	a.PeriodCanSpend, isNeg = a.PeriodCanSpend.SafeSub(mSend.Amount...)
+	if isNeg {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrap("requested amount is more than period spend limit")
+	}
+
+	if !a.SpendLimit.Empty() {
+		a.SpendLimit, isNeg = a.SpendLimit.SafeSub(mSend.Amount...)
+		if isNeg {
+			return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrap("requested amount is more than spend limit")
+		}
+		if a.SpendLimit.IsZero() {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+// SpentCoins implements SpendLimitAuthorization.SpentCoins.
+func (a PeriodicSendAuthorization) SpentCoins(msg sdk.Msg) sdk.Coins {
+	return sentCoins(msg)
+}
+
+// tryResetPeriod tops up PeriodCanSpend to min(PeriodSpendLimit, SpendLimit)
+// once PeriodReset is reached, and moves PeriodReset to the end of the new
+// period. The periods are stepped from the last PeriodReset if it ended less
+// than one period ago, from blockTime otherwise.
+func (a *PeriodicSendAuthorization) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	// set PeriodCanSpend to the lesser of SpendLimit and PeriodSpendLimit
+	if _, isNeg := a.SpendLimit.SafeSub(a.PeriodSpendLimit...); isNeg && !a.SpendLimit.Empty() {
+		a.PeriodCanSpend = a.SpendLimit
+	} else {
+		a.PeriodCanSpend = a.PeriodSpendLimit
+	}
+
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PeriodicSendAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit is invalid: %s", a.SpendLimit)
+	}
+	if !a.PeriodSpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period spend limit is invalid: %s", a.PeriodSpendLimit)
+	}
+	if !a.PeriodSpendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap("period spend limit must be positive")
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period can spend is invalid: %s", a.PeriodCanSpend)
+	}
+
+	// ensure PeriodSpendLimit can be subtracted from total (same coin types)
+	if !a.SpendLimit.Empty() && !a.PeriodSpendLimit.DenomsSubsetOf(a.SpendLimit) {
+		return sdkerrors.ErrInvalidCoins.Wrap("period spend limit has different currency than spend limit")
+	}
+
+	if a.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period must be positive")
+	}
+
+	return validateAllowList(a.AllowList)
+}
@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestPeriodicSendAuthorizationValidateBasic(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+
+	testCases := []struct {
+		name          string
+		authorization *types.PeriodicSendAuthorization
+		expErr        bool
+	}{
+		{"valid", types.NewPeriodicSendAuthorization(coins1000, time.Hour, coins500), false},
+		{"no spend limit", types.NewPeriodicSendAuthorization(nil, time.Hour, coins500), false},
+		{"allow list", types.NewPeriodicSendAuthorization(nil, time.Hour, coins500, toAddr), false},
+		{"no period spend limit", types.NewPeriodicSendAuthorization(coins1000, time.Hour, nil), true},
+		{"different currency", types.NewPeriodicSendAuthorization(coins1000, time.Hour, atom), true},
+		{"zero period", types.NewPeriodicSendAuthorization(coins1000, 0, coins500), true},
+		{"duplicate allow list address", types.NewPeriodicSendAuthorization(coins1000, time.Hour, coins500, toAddr, toAddr), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPeriodicSendAuthorization(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	coins300 := sdk.NewCoins(sdk.NewInt64Coin("stake", 300))
+	coins200 := sdk.NewCoins(sdk.NewInt64Coin("stake", 200))
+
+	authorization := types.NewPeriodicSendAuthorization(coins1000, time.Hour, coins500, toAddr)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+
+	t.Log("verify sends to addresses outside of the allow list are rejected")
+	_, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, sdk.AccAddress("_______other______"), coins300))
+	require.Error(t, err)
+
+	t.Log("verify the first send starts the period")
+	resp, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins300))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated := resp.Updated.(*types.PeriodicSendAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 700)), updated.SpendLimit)
+	require.Equal(t, coins200, updated.PeriodCanSpend)
+	require.Equal(t, now.Add(time.Hour), updated.PeriodReset)
+
+	t.Log("verify sends over the period spend limit are rejected")
+	_, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins300))
+	require.Error(t, err)
+
+	t.Log("verify the period spend limit is available again in the next period")
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	resp, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	updated = resp.Updated.(*types.PeriodicSendAuthorization)
+	require.Equal(t, coins200, updated.SpendLimit)
+	require.True(t, updated.PeriodCanSpend.IsZero())
+	require.Equal(t, now.Add(2*time.Hour), updated.PeriodReset)
+
+	t.Log("verify the period can spend is capped by the spend limit and the next period starts with the send")
+	ctx = ctx.WithBlockTime(now.Add(5 * time.Hour))
+	_, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins300))
+	require.Error(t, err)
+
+	t.Log("verify the authorization is deleted once the spend limit is spent")
+	resp, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins200))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+}
+
+func TestPeriodicSendAuthorizationNoSpendLimit(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	authorization := types.NewPeriodicSendAuthorization(nil, time.Hour, coins500)
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockTime(now.Add(time.Duration(i) * time.Hour))
+		resp, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+		require.NoError(t, err)
+		require.False(t, resp.Delete)
+		authorization = resp.Updated.(*types.PeriodicSendAuthorization)
+		require.Empty(t, authorization.SpendLimit)
+	}
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn is a restriction on the transfers of coins. It can reject
+// a transfer by returning an error, or redirect it by returning a recipient
+// other than toAddr.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
+
+// NoOpSendRestrictionFn is a SendRestrictionFn which neither rejects nor
+// redirects any transfer.
+func NoOpSendRestrictionFn(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	return toAddr, nil
+}
+
+// Then returns a SendRestrictionFn applying r then second, with the
+// recipient returned by r. A nil restriction is skipped.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	if r == nil {
+		return second
+	}
+	if second == nil {
+		return r
+	}
+
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		newToAddr, err := r(ctx, fromAddr, toAddr, amt)
+		if err != nil {
+			return newToAddr, err
+		}
+
+		return second(ctx, fromAddr, newToAddr, amt)
+	}
+}
+
+// ComposeSendRestrictions returns a SendRestrictionFn applying the given
+// restrictions in order, skipping the nil ones. It returns nil if all of them
+// are nil.
+func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionFn {
+	var composed SendRestrictionFn
+	for _, r := range restrictions {
+		composed = composed.Then(r)
+	}
+
+	return composed
+}
+
+// Validate performs a stateless validation of the denom restrictions.
+func (r DenomRestrictions) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+
+	if r.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(r.Admin); err != nil {
+			return fmt.Errorf("invalid admin address of denom %s: %w", r.Denom, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the blacklisted address.
+func (b BlacklistedAddress) Validate() error {
+	if err := sdk.ValidateDenom(b.Denom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(b.Address); err != nil {
+		return fmt.Errorf("invalid blacklisted address of denom %s: %w", b.Denom, err)
+	}
+
+	return nil
+}
@@ -6,12 +6,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
-var _ authz.Authorization = &SendAuthorization{}
+// TODO: Revisit this once we have propoer gas fee framework.
+// Tracking issues https://github.com/cosmos/cosmos-sdk/issues/9054, https://github.com/cosmos/cosmos-sdk/discussions/9072
+const gasCostPerIteration = uint64(10)
+
+var _ authz.SpendLimitAuthorization = &SendAuthorization{}
 
 // NewSendAuthorization creates a new SendAuthorization object.
-func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+func NewSendAuthorization(spendLimit sdk.Coins, allowed ...sdk.AccAddress) *SendAuthorization {
 	return &SendAuthorization{
 		SpendLimit: spendLimit,
+		AllowList:  toBech32Addresses(allowed),
 	}
 }
 
@@ -26,6 +31,10 @@ func (a SendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRes
 	if !ok {
 		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
 	}
+	if err := checkAllowList(ctx, a.AllowList, mSend.ToAddress); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
 	limitLeft, isNegative := a.SpendLimit.SafeSub(mSend.Amount...)
 	if isNegative {
 		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
@@ -34,7 +43,12 @@ func (a SendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRes
 		return authz.AcceptResponse{Accept: true, Delete: true}, nil
 	}
 
-	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &SendAuthorization{SpendLimit: limitLeft}}, nil
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &SendAuthorization{SpendLimit: limitLeft, AllowList: a.AllowList}}, nil
+}
+
+// SpentCoins implements SpendLimitAuthorization.SpentCoins.
+func (a SendAuthorization) SpentCoins(msg sdk.Msg) sdk.Coins {
+	return sentCoins(msg)
 }
 
 // ValidateBasic implements Authorization.ValidateBasic.
@@ -45,5 +59,60 @@ func (a SendAuthorization) ValidateBasic() error {
 	if !a.SpendLimit.IsAllPositive() {
 		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit must be positive")
 	}
+	return validateAllowList(a.AllowList)
+}
+
+// checkAllowList returns an error if the allow list is not empty and does not
+// contain the recipient.
+func checkAllowList(ctx sdk.Context, allowList []string, toAddr string) error {
+	if len(allowList) == 0 {
+		return nil
+	}
+
+	for _, addr := range allowList {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "send authorization")
+		if addr == toAddr {
+			return nil
+		}
+	}
+
+	return sdkerrors.ErrUnauthorized.Wrapf("cannot send to %s address", toAddr)
+}
+
+// validateAllowList returns an error if an address of the allow list is
+// invalid or duplicated.
+func validateAllowList(allowList []string) error {
+	found := make(map[string]bool, len(allowList))
+	for _, addr := range allowList {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid allow list address %s: %s", addr, err)
+		}
+		if found[addr] {
+			return sdkerrors.ErrInvalidAddress.Wrapf("duplicate allow list address %s", addr)
+		}
+		found[addr] = true
+	}
+
 	return nil
 }
+
+// sentCoins returns the coins sent by a MsgSend.
+func sentCoins(msg sdk.Msg) sdk.Coins {
+	mSend, ok := msg.(*MsgSend)
+	if !ok {
+		return nil
+	}
+	return mSend.Amount
+}
+
+func toBech32Addresses(addrs []sdk.AccAddress) []string {
+	if len(addrs) == 0 {
+		return nil
+	}
+
+	bech32Addrs := make([]string, len(addrs))
+	for i, addr := range addrs {
+		bech32Addrs[i] = addr.String()
+	}
+	return bech32Addrs
+}
@@ -56,3 +56,31 @@ func TestSendAuthorization(t *testing.T) {
 	require.True(t, resp.Delete)
 	require.Nil(t, resp.Updated)
 }
+
+func TestSendAuthorizationAllowList(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	otherAddr := sdk.AccAddress("_______other______")
+
+	t.Log("verify ValidateBasic rejects duplicate addresses")
+	authorization := types.NewSendAuthorization(coins1000, toAddr, toAddr)
+	require.Error(t, authorization.ValidateBasic())
+
+	t.Log("verify ValidateBasic rejects invalid addresses")
+	authorization = &types.SendAuthorization{SpendLimit: coins1000, AllowList: []string{"invalid"}}
+	require.Error(t, authorization.ValidateBasic())
+
+	authorization = types.NewSendAuthorization(coins1000, toAddr)
+	require.NoError(t, authorization.ValidateBasic())
+
+	t.Log("verify sends to addresses outside of the allow list are rejected")
+	_, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, otherAddr, coins500))
+	require.Error(t, err)
+
+	t.Log("verify updated authorization keeps the allow list")
+	resp, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, types.NewSendAuthorization(coins500, toAddr).String(), resp.Updated.String())
+}
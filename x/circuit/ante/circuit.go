@@ -0,0 +1,32 @@
+package ante
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CircuitBreakerDecorator rejects the txs containing a message disabled by
+// the circuit breaker, so that they do not enter the mempool. The messages
+// executed by other messages, e.g. by an authz MsgExec, are checked by the
+// MsgServiceRouter when they are executed.
+type CircuitBreakerDecorator struct {
+	circuitBreaker baseapp.CircuitBreaker
+}
+
+// NewCircuitBreakerDecorator creates a new CircuitBreakerDecorator.
+func NewCircuitBreakerDecorator(cb baseapp.CircuitBreaker) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{circuitBreaker: cb}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		typeURL := sdk.MsgTypeURL(msg)
+		if !cbd.circuitBreaker.IsAllowed(ctx, typeURL) {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrMsgDisabled, typeURL)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/circuit transaction commands.
+func NewTxCmd() *cobra.Command {
+	circuitTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit breaker transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitTxCmd.AddCommand(
+		NewTripCmd(),
+	)
+
+	return circuitTxCmd
+}
+
+// NewTripCmd returns a CLI command handler for creating a MsgTripCircuitBreaker transaction.
+func NewTripCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip [msg-type-url]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Disable the execution of messages",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Trip the circuit breaker of message types, disabling their execution until
+the governance resets it. Only the accounts authorized as trippers can trip
+circuit breakers. A type URL ending with ".*" disables all the messages of a
+package.
+
+Example:
+$ %s tx circuit trip /cosmos.authz.v1beta1.MsgExec --from mykey
+$ %s tx circuit trip "/cosmos.authz.v1beta1.*" --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress(), args)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
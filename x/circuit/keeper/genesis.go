@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// InitGenesis initializes the circuit module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	for _, typeURL := range genState.DisabledTypeUrls {
+		k.DisableMsg(ctx, typeURL)
+	}
+
+	for _, tripper := range genState.Trippers {
+		k.SetTripper(ctx, sdk.MustAccAddressFromBech32(tripper))
+	}
+}
+
+// ExportGenesis returns the circuit module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	trippers := k.GetTrippers(ctx)
+	bech32Trippers := make([]string, len(trippers))
+	for i, tripper := range trippers {
+		bech32Trippers[i] = tripper.String()
+	}
+
+	return types.NewGenesisState(k.GetDisabledList(ctx), bech32Trippers)
+}
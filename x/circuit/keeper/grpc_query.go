@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// DisabledList returns the disabled message type URLs.
+func (k Keeper) DisabledList(c context.Context, req *types.QueryDisabledListRequest) (*types.QueryDisabledListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DisabledMsgKeyPrefix)
+
+	var typeURLs []string
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		typeURLs = append(typeURLs, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDisabledListResponse{DisabledTypeUrls: typeURLs, Pagination: pageRes}, nil
+}
+
+// Trippers returns the accounts allowed to trip circuit breakers.
+func (k Keeper) Trippers(c context.Context, req *types.QueryTrippersRequest) (*types.QueryTrippersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TripperKeyPrefix)
+
+	var trippers []string
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		trippers = append(trippers, tripperFromKey(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTrippersResponse{Trippers: trippers, Pagination: pageRes}, nil
+}
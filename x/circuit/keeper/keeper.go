@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var _ baseapp.CircuitBreaker = Keeper{}
+
+// storedValue is the value of the disabled type URLs and trippers, which only
+// matter by their key.
+var storedValue = []byte{0x01}
+
+// Keeper of the circuit store
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	// the address capable of resetting circuit breakers and of managing the
+	// trippers, usually the gov module account
+	authority string
+}
+
+// NewKeeper creates a new circuit Keeper instance.
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, authority string) Keeper {
+	return Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the address of the circuit module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// IsAllowed implements the baseapp.CircuitBreaker interface. It returns false
+// if the message type URL, or one of its packages, is disabled. The circuit
+// messages, and the governance messages needed to reset the circuit breakers,
+// are always allowed.
+func (k Keeper) IsAllowed(ctx sdk.Context, typeURL string) bool {
+	if types.IsCircuitMsg(typeURL) || types.IsGovMsg(typeURL) {
+		return true
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, match := range types.MatchingMsgTypeURLs(typeURL) {
+		if store.Has(types.DisabledMsgKey(match)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsDisabled returns true if the given type URL is in the disabled list.
+func (k Keeper) IsDisabled(ctx sdk.Context, typeURL string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DisabledMsgKey(typeURL))
+}
+
+// DisableMsg adds a type URL to the disabled list.
+func (k Keeper) DisableMsg(ctx sdk.Context, typeURL string) {
+	ctx.KVStore(k.storeKey).Set(types.DisabledMsgKey(typeURL), storedValue)
+}
+
+// EnableMsg removes a type URL from the disabled list.
+func (k Keeper) EnableMsg(ctx sdk.Context, typeURL string) {
+	ctx.KVStore(k.storeKey).Delete(types.DisabledMsgKey(typeURL))
+}
+
+// GetDisabledList returns the disabled type URLs.
+func (k Keeper) GetDisabledList(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DisabledMsgKeyPrefix)
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	typeURLs := []string{}
+	for ; iter.Valid(); iter.Next() {
+		typeURLs = append(typeURLs, string(iter.Key()))
+	}
+
+	return typeURLs
+}
+
+// IsTripper returns true if the account is allowed to trip circuit breakers.
+func (k Keeper) IsTripper(ctx sdk.Context, tripper sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.TripperKey(tripper))
+}
+
+// SetTripper allows an account to trip circuit breakers.
+func (k Keeper) SetTripper(ctx sdk.Context, tripper sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.TripperKey(tripper), storedValue)
+}
+
+// RemoveTripper revokes the permission of an account to trip circuit
+// breakers.
+func (k Keeper) RemoveTripper(ctx sdk.Context, tripper sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.TripperKey(tripper))
+}
+
+// GetTrippers returns the accounts allowed to trip circuit breakers.
+func (k Keeper) GetTrippers(ctx sdk.Context) []sdk.AccAddress {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TripperKeyPrefix)
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	trippers := []sdk.AccAddress{}
+	for ; iter.Valid(); iter.Next() {
+		trippers = append(trippers, tripperFromKey(iter.Key()))
+	}
+
+	return trippers
+}
+
+// tripperFromKey returns the tripper address of a key of the trippers prefix
+// store, i.e. a length prefixed address.
+func tripperFromKey(key []byte) sdk.AccAddress {
+	return sdk.AccAddress(key[1:])
+}
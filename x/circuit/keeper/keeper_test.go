@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	authority   sdk.AccAddress
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.CircuitKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+	s.msgServer = keeper.NewMsgServerImpl(app.CircuitKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// send executes a bank MsgSend through the app's MsgServiceRouter.
+func (s *KeeperTestSuite) send() error {
+	msg := banktypes.NewMsgSend(s.addrs[0], s.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	_, err := s.app.MsgServiceRouter().Handler(msg)(s.ctx, msg)
+	return err
+}
+
+func (s *KeeperTestSuite) TestTripAndReset() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	require.NoError(s.send())
+
+	// only the authority and the trippers can trip circuit breakers
+	_, err := s.msgServer.TripCircuitBreaker(goCtx, types.NewMsgTripCircuitBreaker(s.addrs[0], []string{sendURL}))
+	require.ErrorIs(err, types.ErrNotTripper)
+
+	_, err = s.msgServer.TripCircuitBreaker(goCtx, types.NewMsgTripCircuitBreaker(s.authority, []string{sendURL}))
+	require.NoError(err)
+	require.ErrorIs(s.send(), sdkerrors.ErrMsgDisabled)
+	require.False(s.app.CircuitKeeper.IsAllowed(s.ctx, sendURL))
+	require.True(s.app.CircuitKeeper.IsAllowed(s.ctx, sdk.MsgTypeURL(&banktypes.MsgMultiSend{})))
+
+	res, err := s.queryClient.DisabledList(goCtx, &types.QueryDisabledListRequest{})
+	require.NoError(err)
+	require.Equal([]string{sendURL}, res.DisabledTypeUrls)
+
+	// only the authority can reset circuit breakers
+	_, err = s.msgServer.ResetCircuitBreaker(goCtx, types.NewMsgResetCircuitBreaker(s.addrs[0], []string{sendURL}))
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.ResetCircuitBreaker(goCtx, types.NewMsgResetCircuitBreaker(s.authority, []string{"/cosmos.bank.v1beta1.*"}))
+	require.ErrorIs(err, types.ErrNotDisabled)
+
+	_, err = s.msgServer.ResetCircuitBreaker(goCtx, types.NewMsgResetCircuitBreaker(s.authority, []string{sendURL}))
+	require.NoError(err)
+	require.NoError(s.send())
+	require.Empty(s.app.CircuitKeeper.GetDisabledList(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestTripPackage() {
+	require := s.Require()
+
+	s.app.CircuitKeeper.DisableMsg(s.ctx, "/cosmos.bank.*")
+	require.ErrorIs(s.send(), sdkerrors.ErrMsgDisabled)
+	require.False(s.app.CircuitKeeper.IsAllowed(s.ctx, sdk.MsgTypeURL(&banktypes.MsgMultiSend{})))
+	require.True(s.app.CircuitKeeper.IsAllowed(s.ctx, "/cosmos.gov.v1.MsgVote"))
+
+	// the circuit messages can never be disabled
+	s.app.CircuitKeeper.DisableMsg(s.ctx, "/cosmos.*")
+	require.True(s.app.CircuitKeeper.IsAllowed(s.ctx, sdk.MsgTypeURL(&types.MsgResetCircuitBreaker{})))
+
+	s.app.CircuitKeeper.EnableMsg(s.ctx, "/cosmos.bank.*")
+	require.ErrorIs(s.send(), sdkerrors.ErrMsgDisabled)
+
+	s.app.CircuitKeeper.EnableMsg(s.ctx, "/cosmos.*")
+	require.NoError(s.send())
+}
+
+func (s *KeeperTestSuite) TestGovMsgsAlwaysAllowed() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.AuthorizeTripper(goCtx, types.NewMsgAuthorizeTripper(s.authority, s.addrs[0]))
+	require.NoError(err)
+
+	// a tripper cannot lock the governance out, even with the widest packages
+	for _, typeURL := range []string{"/cosmos.*", "/cosmos.gov.*", "/cosmos.gov.v1.MsgVote"} {
+		_, err = s.msgServer.TripCircuitBreaker(goCtx, types.NewMsgTripCircuitBreaker(s.addrs[0], []string{typeURL}))
+		require.NoError(err)
+	}
+
+	for _, msg := range []sdk.Msg{
+		&govv1.MsgSubmitProposal{},
+		&govv1.MsgVote{},
+		&govv1.MsgVoteWeighted{},
+		&govv1.MsgDeposit{},
+		&govv1beta1.MsgSubmitProposal{},
+		&govv1beta1.MsgVote{},
+		&govv1beta1.MsgVoteWeighted{},
+		&govv1beta1.MsgDeposit{},
+	} {
+		require.True(s.app.CircuitKeeper.IsAllowed(s.ctx, sdk.MsgTypeURL(msg)), sdk.MsgTypeURL(msg))
+	}
+
+	// the other messages are disabled
+	require.False(s.app.CircuitKeeper.IsAllowed(s.ctx, sdk.MsgTypeURL(&govv1.MsgUpdateParams{})))
+	require.ErrorIs(s.send(), sdkerrors.ErrMsgDisabled)
+}
+
+func (s *KeeperTestSuite) TestTrippers() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	tripper := s.addrs[2]
+
+	_, err := s.msgServer.AuthorizeTripper(goCtx, types.NewMsgAuthorizeTripper(tripper, tripper))
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.AuthorizeTripper(goCtx, types.NewMsgAuthorizeTripper(s.authority, tripper))
+	require.NoError(err)
+
+	res, err := s.queryClient.Trippers(goCtx, &types.QueryTrippersRequest{})
+	require.NoError(err)
+	require.Equal([]string{tripper.String()}, res.Trippers)
+
+	// a tripper can trip but not reset circuit breakers
+	_, err = s.msgServer.TripCircuitBreaker(goCtx, types.NewMsgTripCircuitBreaker(tripper, []string{sendURL}))
+	require.NoError(err)
+	require.ErrorIs(s.send(), sdkerrors.ErrMsgDisabled)
+
+	_, err = s.msgServer.ResetCircuitBreaker(goCtx, types.NewMsgResetCircuitBreaker(tripper, []string{sendURL}))
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.RevokeTripper(goCtx, types.NewMsgRevokeTripper(s.authority, tripper))
+	require.NoError(err)
+	require.False(s.app.CircuitKeeper.IsTripper(s.ctx, tripper))
+
+	_, err = s.msgServer.RevokeTripper(goCtx, types.NewMsgRevokeTripper(s.authority, tripper))
+	require.ErrorIs(err, types.ErrNotTripper)
+
+	_, err = s.msgServer.TripCircuitBreaker(goCtx, types.NewMsgTripCircuitBreaker(tripper, []string{sendURL}))
+	require.ErrorIs(err, types.ErrNotTripper)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	require := s.Require()
+
+	genState := types.NewGenesisState(
+		[]string{"/cosmos.authz.v1beta1.MsgExec", "/cosmos.bank.v1beta1.*"},
+		[]string{s.addrs[0].String()},
+	)
+	require.NoError(types.ValidateGenesis(*genState))
+
+	s.app.CircuitKeeper.InitGenesis(s.ctx, genState)
+	require.Equal(genState, s.app.CircuitKeeper.ExportGenesis(s.ctx))
+	require.ErrorIs(s.send(), sdkerrors.ErrMsgDisabled)
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{Keeper: k}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// TripCircuitBreaker implements the Msg/TripCircuitBreaker Msg service.
+func (k msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != k.authority && !k.IsTripper(ctx, sender) {
+		return nil, sdkerrors.Wrap(types.ErrNotTripper, msg.Sender)
+	}
+
+	for _, typeURL := range msg.MsgTypeUrls {
+		k.DisableMsg(ctx, typeURL)
+	}
+
+	k.Logger(ctx).Info("circuit breaker tripped", "sender", msg.Sender, "msg_type_urls", msg.MsgTypeUrls)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCircuitBreakerTripped{
+		Sender:      msg.Sender,
+		MsgTypeUrls: msg.MsgTypeUrls,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker implements the Msg/ResetCircuitBreaker Msg service.
+func (k msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, typeURL := range msg.MsgTypeUrls {
+		if !k.IsDisabled(ctx, typeURL) {
+			return nil, sdkerrors.Wrap(types.ErrNotDisabled, typeURL)
+		}
+
+		k.EnableMsg(ctx, typeURL)
+	}
+
+	k.Logger(ctx).Info("circuit breaker reset", "msg_type_urls", msg.MsgTypeUrls)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCircuitBreakerReset{
+		Authority:   msg.Authority,
+		MsgTypeUrls: msg.MsgTypeUrls,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
+
+// AuthorizeTripper implements the Msg/AuthorizeTripper Msg service.
+func (k msgServer) AuthorizeTripper(goCtx context.Context, msg *types.MsgAuthorizeTripper) (*types.MsgAuthorizeTripperResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	tripper, err := sdk.AccAddressFromBech32(msg.Tripper)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetTripper(ctx, tripper)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTripperAuthorized{Tripper: msg.Tripper}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAuthorizeTripperResponse{}, nil
+}
+
+// RevokeTripper implements the Msg/RevokeTripper Msg service.
+func (k msgServer) RevokeTripper(goCtx context.Context, msg *types.MsgRevokeTripper) (*types.MsgRevokeTripperResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	tripper, err := sdk.AccAddressFromBech32(msg.Tripper)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsTripper(ctx, tripper) {
+		return nil, sdkerrors.Wrap(types.ErrNotTripper, msg.Tripper)
+	}
+
+	k.RemoveTripper(ctx, tripper)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTripperRevoked{Tripper: msg.Tripper}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeTripperResponse{}, nil
+}
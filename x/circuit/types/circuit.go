@@ -0,0 +1,93 @@
+package types
+
+import (
+	"strings"
+)
+
+// packageWildcard is the suffix of the type URLs matching all the messages of
+// a package.
+const packageWildcard = ".*"
+
+// circuitPackage is the prefix of the type URLs of the circuit messages, which
+// cannot be disabled.
+const circuitPackage = "/cosmos.circuit."
+
+// govMsgTypeURLs are the type URLs of the governance messages submitting,
+// voting on and funding proposals, which cannot be disabled so that the
+// governance can always reset the circuit breakers.
+var govMsgTypeURLs = map[string]bool{
+	"/cosmos.gov.v1.MsgSubmitProposal":      true,
+	"/cosmos.gov.v1.MsgVote":                true,
+	"/cosmos.gov.v1.MsgVoteWeighted":        true,
+	"/cosmos.gov.v1.MsgDeposit":             true,
+	"/cosmos.gov.v1beta1.MsgSubmitProposal": true,
+	"/cosmos.gov.v1beta1.MsgVote":           true,
+	"/cosmos.gov.v1beta1.MsgVoteWeighted":   true,
+	"/cosmos.gov.v1beta1.MsgDeposit":        true,
+}
+
+// ValidateMsgTypeURL validates a type URL given to a circuit breaker: either
+// the type URL of a message, e.g. "/cosmos.authz.v1beta1.MsgExec", or a
+// package ending with ".*", e.g. "/cosmos.authz.v1beta1.*".
+func ValidateMsgTypeURL(typeURL string) error {
+	name := strings.TrimSuffix(typeURL, packageWildcard)
+	if !strings.HasPrefix(name, "/") || len(name) == 1 {
+		return ErrInvalidMsgTypeURL.Wrapf("%q must start with '/' followed by a name", typeURL)
+	}
+
+	if strings.ContainsAny(name[1:], "/*") || strings.Contains(name, "..") || strings.HasSuffix(name, ".") {
+		return ErrInvalidMsgTypeURL.Wrapf("%q is not a message or package name", typeURL)
+	}
+
+	if strings.HasPrefix(typeURL+".", circuitPackage) {
+		return ErrInvalidMsgTypeURL.Wrapf("%q would disable the circuit messages", typeURL)
+	}
+
+	return nil
+}
+
+// ValidateMsgTypeURLs validates a list of type URLs given to a circuit
+// breaker.
+func ValidateMsgTypeURLs(typeURLs []string) error {
+	if len(typeURLs) == 0 {
+		return ErrInvalidMsgTypeURL.Wrap("no message type URL given")
+	}
+
+	seen := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if seen[typeURL] {
+			return ErrInvalidMsgTypeURL.Wrapf("duplicate %q", typeURL)
+		}
+		seen[typeURL] = true
+
+		if err := ValidateMsgTypeURL(typeURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MatchingMsgTypeURLs returns the entries of a disabled list which would
+// disable the messages of typeURL: the type URL itself, then the wildcards of
+// its packages, from the innermost to the outermost.
+func MatchingMsgTypeURLs(typeURL string) []string {
+	matches := []string{typeURL}
+	for i := strings.LastIndexByte(typeURL, '.'); i > 0; i = strings.LastIndexByte(typeURL[:i], '.') {
+		matches = append(matches, typeURL[:i]+packageWildcard)
+	}
+
+	return matches
+}
+
+// IsCircuitMsg returns true if typeURL is the type URL of a circuit message,
+// which can never be disabled.
+func IsCircuitMsg(typeURL string) bool {
+	return strings.HasPrefix(typeURL, circuitPackage)
+}
+
+// IsGovMsg returns true if typeURL is the type URL of a governance message
+// submitting, voting on or funding a proposal, which can never be disabled.
+func IsGovMsg(typeURL string) bool {
+	return govMsgTypeURLs[typeURL]
+}
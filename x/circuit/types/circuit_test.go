@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestValidateMsgTypeURL(t *testing.T) {
+	testCases := []struct {
+		typeURL   string
+		expectErr bool
+	}{
+		{"/cosmos.authz.v1beta1.MsgExec", false},
+		{"/cosmos.authz.v1beta1.*", false},
+		{"/cosmos.*", false},
+		{"", true},
+		{"/", true},
+		{"/*", true},
+		{".*", true},
+		{"cosmos.authz.v1beta1.MsgExec", true},
+		{"/cosmos.authz.v1beta1.", true},
+		{"/cosmos..MsgExec", true},
+		{"/cosmos.*.MsgExec", true},
+		{"/cosmos/authz", true},
+		{"/cosmos.circuit.v1beta1.MsgResetCircuitBreaker", true},
+		{"/cosmos.circuit.*", true},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expectErr, types.ValidateMsgTypeURL(tc.typeURL) != nil, tc.typeURL)
+	}
+}
+
+func TestValidateMsgTypeURLs(t *testing.T) {
+	require.Error(t, types.ValidateMsgTypeURLs(nil))
+	require.Error(t, types.ValidateMsgTypeURLs([]string{"/cosmos.bank.*", "/cosmos.bank.*"}))
+	require.NoError(t, types.ValidateMsgTypeURLs([]string{"/cosmos.bank.*", "/cosmos.authz.v1beta1.MsgExec"}))
+}
+
+func TestMatchingMsgTypeURLs(t *testing.T) {
+	require.Equal(t,
+		[]string{"/cosmos.authz.v1beta1.MsgExec", "/cosmos.authz.v1beta1.*", "/cosmos.authz.*", "/cosmos.*"},
+		types.MatchingMsgTypeURLs("/cosmos.authz.v1beta1.MsgExec"),
+	)
+	require.Equal(t, []string{"/Msg"}, types.MatchingMsgTypeURLs("/Msg"))
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	ErrInvalidMsgTypeURL = sdkerrors.Register(ModuleName, 2, "invalid message type URL")
+	ErrNotTripper        = sdkerrors.Register(ModuleName, 3, "account is not allowed to trip circuit breakers")
+	ErrNotDisabled       = sdkerrors.Register(ModuleName, 4, "message type URL is not disabled")
+)
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(disabledTypeURLs, trippers []string) *GenesisState {
+	return &GenesisState{
+		DisabledTypeUrls: disabledTypeURLs,
+		Trippers:         trippers,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]string{}, []string{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if len(data.DisabledTypeUrls) > 0 {
+		if err := ValidateMsgTypeURLs(data.DisabledTypeUrls); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[string]bool, len(data.Trippers))
+	for _, tripper := range data.Trippers {
+		if _, err := sdk.AccAddressFromBech32(tripper); err != nil {
+			return fmt.Errorf("invalid tripper address %s: %w", tripper, err)
+		}
+
+		if seen[tripper] {
+			return fmt.Errorf("duplicate tripper %s", tripper)
+		}
+		seen[tripper] = true
+	}
+
+	return nil
+}
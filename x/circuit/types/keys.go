@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the circuit module
+	ModuleName = "circuit"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the circuit module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the circuit module
+	QuerierRoute = ModuleName
+)
+
+// Keys for circuit store
+// Items are stored with the following key: values
+//
+// - 0x01<msgTypeURL_Bytes>: []byte{0x01}
+//
+// - 0x02<tripperAddressLen (1 Byte)><tripperAddress_Bytes>: []byte{0x01}
+var (
+	DisabledMsgKeyPrefix = []byte{0x01}
+	TripperKeyPrefix     = []byte{0x02}
+)
+
+// DisabledMsgKey returns the store key of a disabled message type URL.
+func DisabledMsgKey(typeURL string) []byte {
+	return append(DisabledMsgKeyPrefix, typeURL...)
+}
+
+// TripperKey returns the store key of a tripper.
+func TripperKey(tripper sdk.AccAddress) []byte {
+	return append(TripperKeyPrefix, address.MustLengthPrefix(tripper)...)
+}
@@ -0,0 +1,158 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// circuit message types
+const (
+	TypeMsgTripCircuitBreaker  = "trip_circuit_breaker"
+	TypeMsgResetCircuitBreaker = "reset_circuit_breaker"
+	TypeMsgAuthorizeTripper    = "authorize_tripper"
+	TypeMsgRevokeTripper       = "revoke_tripper"
+)
+
+var (
+	_ sdk.Msg            = &MsgTripCircuitBreaker{}
+	_ sdk.Msg            = &MsgResetCircuitBreaker{}
+	_ sdk.Msg            = &MsgAuthorizeTripper{}
+	_ sdk.Msg            = &MsgRevokeTripper{}
+	_ legacytx.LegacyMsg = &MsgTripCircuitBreaker{}
+	_ legacytx.LegacyMsg = &MsgResetCircuitBreaker{}
+	_ legacytx.LegacyMsg = &MsgAuthorizeTripper{}
+	_ legacytx.LegacyMsg = &MsgRevokeTripper{}
+)
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker instance
+func NewMsgTripCircuitBreaker(sender sdk.AccAddress, msgTypeURLs []string) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{Sender: sender.String(), MsgTypeUrls: msgTypeURLs}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgTripCircuitBreaker) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgTripCircuitBreaker) Type() string { return TypeMsgTripCircuitBreaker }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgTripCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgTripCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	return ValidateMsgTypeURLs(msg.MsgTypeUrls)
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker instance
+func NewMsgResetCircuitBreaker(authority sdk.AccAddress, msgTypeURLs []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{Authority: authority.String(), MsgTypeUrls: msgTypeURLs}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgResetCircuitBreaker) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgResetCircuitBreaker) Type() string { return TypeMsgResetCircuitBreaker }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return ValidateMsgTypeURLs(msg.MsgTypeUrls)
+}
+
+// NewMsgAuthorizeTripper creates a new MsgAuthorizeTripper instance
+func NewMsgAuthorizeTripper(authority, tripper sdk.AccAddress) *MsgAuthorizeTripper {
+	return &MsgAuthorizeTripper{Authority: authority.String(), Tripper: tripper.String()}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgAuthorizeTripper) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgAuthorizeTripper) Type() string { return TypeMsgAuthorizeTripper }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAuthorizeTripper) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgAuthorizeTripper) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAuthorizeTripper) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Tripper); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid tripper address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgRevokeTripper creates a new MsgRevokeTripper instance
+func NewMsgRevokeTripper(authority, tripper sdk.AccAddress) *MsgRevokeTripper {
+	return &MsgRevokeTripper{Authority: authority.String(), Tripper: tripper.String()}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgRevokeTripper) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgRevokeTripper) Type() string { return TypeMsgRevokeTripper }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevokeTripper) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRevokeTripper) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeTripper) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Tripper); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid tripper address: %s", err)
+	}
+
+	return nil
+}
@@ -7,7 +7,9 @@ import (
 
 // new crisis genesis
 func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
-	k.SetConstantFee(ctx, data.ConstantFee)
+	if err := k.SetConstantFee(ctx, data.ConstantFee); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
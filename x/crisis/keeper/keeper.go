@@ -6,6 +6,8 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -14,18 +16,25 @@ import (
 // Keeper - crisis keeper
 type Keeper struct {
 	routes         []types.InvarRoute
+	cdc            codec.BinaryCodec
+	storeKey       storetypes.StoreKey
 	paramSpace     paramtypes.Subspace
 	invCheckPeriod uint
 
 	supplyKeeper types.SupplyKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
-// NewKeeper creates a new Keeper object
+// NewKeeper creates a new Keeper object. The constant fee is stored in the
+// crisis store, paramSpace is the legacy subspace it is migrated from.
 func NewKeeper(
-	paramSpace paramtypes.Subspace, invCheckPeriod uint, supplyKeeper types.SupplyKeeper,
-	feeCollectorName string,
+	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	invCheckPeriod uint, supplyKeeper types.SupplyKeeper, feeCollectorName string, authority string,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -34,13 +43,21 @@ func NewKeeper(
 
 	return Keeper{
 		routes:           make([]types.InvarRoute, 0),
+		cdc:              cdc,
+		storeKey:         storeKey,
 		paramSpace:       paramSpace,
 		invCheckPeriod:   invCheckPeriod,
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 	}
 }
 
+// GetAuthority returns the x/crisis module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -5,13 +5,27 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
-// GetConstantFee get's the constant fee from the paramSpace
+// GetConstantFee get's the constant fee from the crisis store
 func (k Keeper) GetConstantFee(ctx sdk.Context) (constantFee sdk.Coin) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyConstantFee, &constantFee)
-	return
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConstantFeeKey)
+	if bz == nil {
+		return constantFee
+	}
+
+	k.cdc.MustUnmarshal(bz, &constantFee)
+	return constantFee
 }
 
-// GetConstantFee set's the constant fee in the paramSpace
-func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) {
-	k.paramSpace.Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
+// SetConstantFee set's the constant fee in the crisis store, it returns an
+// error if the fee is invalid
+func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) error {
+	if err := types.ValidateConstantFee(constantFee); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConstantFeeKey, k.cdc.MustMarshal(&constantFee))
+
+	return nil
 }
@@ -0,0 +1,26 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+//
+// - Moving the constant fee from the x/params subspace to the x/crisis store.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	var constantFee sdk.Coin
+	legacySubspace.Get(ctx, types.ParamStoreKeyConstantFee, &constantFee)
+	if err := types.ValidateConstantFee(constantFee); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ConstantFeeKey, cdc.MustMarshal(&constantFee))
+
+	return nil
+}
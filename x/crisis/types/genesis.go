@@ -1,8 +1,6 @@
 package types
 
 import (
-	"fmt"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,8 +20,5 @@ func DefaultGenesisState() *GenesisState {
 
 // ValidateGenesis - validate crisis genesis data
 func ValidateGenesis(data *GenesisState) error {
-	if !data.ConstantFee.IsPositive() {
-		return fmt.Errorf("constant fee must be positive: %s", data.ConstantFee)
-	}
-	return nil
+	return ValidateConstantFee(data.ConstantFee)
 }
@@ -3,4 +3,12 @@ package types
 const (
 	// module name
 	ModuleName = "crisis"
+
+	// StoreKey is the default store key for crisis
+	StoreKey = ModuleName
+)
+
+var (
+	// ConstantFeeKey is the key of the constant fee param in the crisis store
+	ConstantFeeKey = []byte{0x01}
 )
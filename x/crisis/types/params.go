@@ -17,6 +17,20 @@ func ParamKeyTable() paramtypes.KeyTable {
 	)
 }
 
+// ValidateConstantFee validates the constant fee param, which must be a valid
+// positive coin.
+func ValidateConstantFee(constantFee sdk.Coin) error {
+	if err := validateConstantFee(constantFee); err != nil {
+		return err
+	}
+
+	if !constantFee.IsPositive() {
+		return fmt.Errorf("constant fee must be positive: %s", constantFee)
+	}
+
+	return nil
+}
+
 func validateConstantFee(i interface{}) error {
 	v, ok := i.(sdk.Coin)
 	if !ok {
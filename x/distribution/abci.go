@@ -28,8 +28,14 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// TODO this is Tendermint-dependent
 	// ref https://github.com/cosmos/cosmos-sdk/issues/3095
 	if ctx.BlockHeight() > 1 {
+		communityPool := k.GetFeePoolCommunityCoins(ctx)
+
 		previousProposer := k.GetPreviousProposerConsAddr(ctx)
 		k.AllocateTokens(ctx, sumPreviousPrecommitPower, previousTotalPower, previousProposer, req.LastCommitInfo.GetVotes())
+
+		// pay the continuous funds their share of the community pool inflow
+		inflow := k.GetFeePoolCommunityCoins(ctx).Sub(communityPool)
+		k.DistributeContinuousFunds(ctx, inflow)
 	}
 
 	// record the proposer for when we payout on the next block
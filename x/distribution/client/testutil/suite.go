@@ -50,6 +50,8 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	mintData.Minter.Inflation = inflation
 	mintData.Params.InflationMin = inflation
 	mintData.Params.InflationMax = inflation
+	mintData.Params.Schedule = minttypes.ScheduleBondedRatio
+	mintData.Params.BlocksPerMonth = 6311520 / 12
 
 	mintDataBz, err := s.cfg.Codec.MarshalJSON(&mintData)
 	s.Require().NoError(err)
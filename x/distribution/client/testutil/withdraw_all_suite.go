@@ -12,7 +12,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	"github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
-	stakingcli "github.com/cosmos/cosmos-sdk/x/staking/client/cli"
+	epochingcli "github.com/cosmos/cosmos-sdk/x/epoching/client/cli"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -26,6 +27,14 @@ type WithdrawAllTestSuite struct {
 func (s *WithdrawAllTestSuite) SetupSuite() {
 	cfg := network.DefaultConfig()
 	cfg.NumValidators = 2
+
+	// execute the delegations queued by the epoching module in the block they
+	// are sent
+	epochingGenesis := epochingtypes.DefaultGenesisState()
+	epochingGenesis.Params.EpochLength = 1
+	bz, err := cfg.Codec.MarshalJSON(epochingGenesis)
+	s.Require().NoError(err)
+	cfg.GenesisState[epochingtypes.ModuleName] = bz
 	s.cfg = cfg
 
 	s.T().Log("setting up integration test suite")
@@ -77,7 +86,7 @@ func (s *WithdrawAllTestSuite) TestNewWithdrawAllRewardsGenerateOnly() {
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 	}
-	cmd := stakingcli.NewDelegateCmd()
+	cmd := epochingcli.NewDelegateCmd()
 	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
 	require.NoError(err)
 
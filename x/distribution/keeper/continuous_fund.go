@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetContinuousFund returns the continuous fund of a recipient.
+func (k Keeper) GetContinuousFund(ctx sdk.Context, recipient sdk.AccAddress) (cf types.ContinuousFund, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetContinuousFundKey(recipient))
+	if b == nil {
+		return cf, false
+	}
+
+	k.cdc.MustUnmarshal(b, &cf)
+	return cf, true
+}
+
+// SetContinuousFund sets the continuous fund of its recipient.
+func (k Keeper) SetContinuousFund(ctx sdk.Context, cf types.ContinuousFund) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&cf)
+	store.Set(types.GetContinuousFundKey(sdk.MustAccAddressFromBech32(cf.Recipient)), b)
+}
+
+// DeleteContinuousFund deletes the continuous fund of a recipient.
+func (k Keeper) DeleteContinuousFund(ctx sdk.Context, recipient sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetContinuousFundKey(recipient))
+}
+
+// IterateContinuousFunds iterates over the continuous funds, including the
+// expired ones which have not been removed yet.
+func (k Keeper) IterateContinuousFunds(ctx sdk.Context, handler func(cf types.ContinuousFund) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ContinuousFundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var cf types.ContinuousFund
+		k.cdc.MustUnmarshal(iter.Value(), &cf)
+		if handler(cf) {
+			break
+		}
+	}
+}
+
+// GetContinuousFunds returns all the continuous funds.
+func (k Keeper) GetContinuousFunds(ctx sdk.Context) []types.ContinuousFund {
+	funds := make([]types.ContinuousFund, 0)
+	k.IterateContinuousFunds(ctx, func(cf types.ContinuousFund) (stop bool) {
+		funds = append(funds, cf)
+		return false
+	})
+
+	return funds
+}
+
+// CreateContinuousFund adds a continuous fund, paying its recipient a
+// percentage of the community pool inflow of each block. A recipient may have
+// a single fund, and the active funds may not pay more than the whole inflow.
+func (k Keeper) CreateContinuousFund(ctx sdk.Context, cf types.ContinuousFund) error {
+	if err := cf.Validate(); err != nil {
+		return err
+	}
+
+	recipient := sdk.MustAccAddressFromBech32(cf.Recipient)
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", cf.Recipient)
+	}
+
+	if cf.IsExpired(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidContinuousFund, "expiry %s is not after the block time", cf.Expiry)
+	}
+
+	if _, found := k.GetContinuousFund(ctx, recipient); found {
+		return sdkerrors.Wrapf(types.ErrContinuousFundExists, "recipient %s", cf.Recipient)
+	}
+
+	funds := []types.ContinuousFund{cf}
+	k.IterateContinuousFunds(ctx, func(fund types.ContinuousFund) (stop bool) {
+		if !fund.IsExpired(ctx.BlockTime()) {
+			funds = append(funds, fund)
+		}
+		return false
+	})
+	if err := types.ValidateContinuousFunds(funds); err != nil {
+		return err
+	}
+
+	k.SetContinuousFund(ctx, cf)
+
+	return nil
+}
+
+// CancelContinuousFund removes the continuous fund of a recipient.
+func (k Keeper) CancelContinuousFund(ctx sdk.Context, recipient sdk.AccAddress) error {
+	if _, found := k.GetContinuousFund(ctx, recipient); !found {
+		return sdkerrors.Wrapf(types.ErrNoContinuousFundExists, "recipient %s", recipient)
+	}
+
+	k.DeleteContinuousFund(ctx, recipient)
+
+	return nil
+}
+
+// DistributeContinuousFunds removes the expired continuous funds and pays the
+// recipients of the active ones their percentage of inflow, the amount added
+// to the community pool in the current block. The payouts are truncated to
+// whole coins, the remainder staying in the community pool.
+//
+// A payout which fails, e.g. because the recipient can no longer receive
+// funds, is skipped and logged.
+func (k Keeper) DistributeContinuousFunds(ctx sdk.Context, inflow sdk.DecCoins) {
+	logger := k.Logger(ctx)
+
+	var funds []types.ContinuousFund
+	k.IterateContinuousFunds(ctx, func(cf types.ContinuousFund) (stop bool) {
+		funds = append(funds, cf)
+		return false
+	})
+
+	for _, cf := range funds {
+		recipient := sdk.MustAccAddressFromBech32(cf.Recipient)
+
+		if cf.IsExpired(ctx.BlockTime()) {
+			k.DeleteContinuousFund(ctx, recipient)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeContinuousFundExpired,
+					sdk.NewAttribute(types.AttributeKeyRecipient, cf.Recipient),
+					sdk.NewAttribute(types.AttributeKeyExpiry, cf.Expiry.Format(time.RFC3339)),
+				),
+			)
+			continue
+		}
+
+		amount, _ := inflow.MulDecTruncate(cf.Percentage).TruncateDecimal()
+		if amount.IsZero() {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.DistributeFromFeePool(cacheCtx, amount, recipient); err != nil {
+			logger.Error("failed to pay continuous fund", "recipient", cf.Recipient, "amount", amount.String(), "err", err)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeContinuousFundPayout,
+				sdk.NewAttribute(types.AttributeKeyRecipient, cf.Recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			),
+		)
+	}
+}
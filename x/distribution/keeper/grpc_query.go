@@ -19,8 +19,7 @@ var _ types.QueryServer = Keeper{}
 // Params queries params of distribution module
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
+	params := k.GetParams(ctx)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
@@ -243,3 +242,34 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// ContinuousFunds queries the active continuous funds of the community pool
+func (k Keeper) ContinuousFunds(c context.Context, req *types.QueryContinuousFundsRequest) (*types.QueryContinuousFundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	fundsStore := prefix.NewStore(store, types.ContinuousFundPrefix)
+
+	results, pageRes, err := query.GenericFilteredPaginate(k.cdc, fundsStore, req.Pagination, func(key []byte, result *types.ContinuousFund) (*types.ContinuousFund, error) {
+		if result.IsExpired(ctx.BlockTime()) {
+			return nil, nil
+		}
+
+		return result, nil
+	}, func() *types.ContinuousFund {
+		return &types.ContinuousFund{}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	funds := []types.ContinuousFund{}
+	for _, cf := range results {
+		funds = append(funds, *cf)
+	}
+
+	return &types.QueryContinuousFundsResponse{ContinuousFunds: funds, Pagination: pageRes}, nil
+}
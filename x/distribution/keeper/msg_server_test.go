@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMsgCommunityPoolSpend(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	authority := app.DistrKeeper.GetAuthority()
+
+	// reset fee pool
+	app.DistrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.ZeroInt())
+
+	pool := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, addrs[0], pool))
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, pool, addrs[0]))
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 40))
+
+	_, err := msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(addrs[0].String(), addrs[1], amount))
+	require.Error(t, err)
+
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(authority, authtypes.NewModuleAddress(types.ModuleName), amount))
+	require.Error(t, err)
+
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(authority, addrs[1], pool.Add(amount...)))
+	require.ErrorIs(t, err, types.ErrBadDistribution)
+
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(authority, addrs[1], amount))
+	require.NoError(t, err)
+	require.Equal(t, amount, app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(pool.Sub(amount...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+}
+
+func TestContinuousFunds(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	authority := app.DistrKeeper.GetAuthority()
+
+	// reset fee pool
+	app.DistrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.ZeroInt())
+	expiry := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+
+	_, err := msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(addrs[0].String(), addrs[1], sdk.NewDecWithPrec(3, 1), &expiry))
+	require.Error(t, err)
+
+	_, err = msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(authority, addrs[1], sdk.NewDecWithPrec(3, 1), &past))
+	require.ErrorIs(t, err, types.ErrInvalidContinuousFund)
+
+	_, err = msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(authority, addrs[1], sdk.NewDecWithPrec(3, 1), &expiry))
+	require.NoError(t, err)
+
+	_, err = msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(authority, addrs[1], sdk.NewDecWithPrec(1, 1), nil))
+	require.ErrorIs(t, err, types.ErrContinuousFundExists)
+
+	_, err = msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(authority, addrs[2], sdk.NewDecWithPrec(5, 1), nil))
+	require.NoError(t, err)
+
+	// the funds would pay more than the whole inflow
+	_, err = msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(authority, addrs[3], sdk.NewDecWithPrec(3, 1), nil))
+	require.ErrorIs(t, err, types.ErrInvalidContinuousFund)
+
+	res, err := app.DistrKeeper.ContinuousFunds(sdk.WrapSDKContext(ctx), &types.QueryContinuousFundsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.ContinuousFunds, 2)
+
+	// the funds are paid their share of the inflow
+	inflow := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, addrs[0], inflow))
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, inflow, addrs[0]))
+	app.DistrKeeper.DistributeContinuousFunds(ctx, sdk.NewDecCoinsFromCoins(inflow...))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), app.BankKeeper.GetAllBalances(ctx, addrs[2]))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 20)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	// the expired fund is removed
+	ctx = ctx.WithBlockTime(expiry)
+	res, err = app.DistrKeeper.ContinuousFunds(sdk.WrapSDKContext(ctx), &types.QueryContinuousFundsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.ContinuousFunds, 1)
+
+	app.DistrKeeper.DistributeContinuousFunds(ctx, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 10)))
+	_, found := app.DistrKeeper.GetContinuousFund(ctx, addrs[1])
+	require.False(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 55)), app.BankKeeper.GetAllBalances(ctx, addrs[2]))
+
+	// the funds are cancelled by the authority
+	_, err = msgServer.CancelContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCancelContinuousFund(addrs[0].String(), addrs[2]))
+	require.Error(t, err)
+
+	_, err = msgServer.CancelContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCancelContinuousFund(authority, addrs[1]))
+	require.ErrorIs(t, err, types.ErrNoContinuousFundExists)
+
+	_, err = msgServer.CancelContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCancelContinuousFund(authority, addrs[2]))
+	require.NoError(t, err)
+	require.Empty(t, app.DistrKeeper.GetContinuousFunds(ctx))
+}
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	stakingMsgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+	app.DistrKeeper.DeleteAllValidatorHistoricalRewards(ctx)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddr, delAddr, ownerAddr := sdk.ValAddress(addrs[0]), addrs[1], addrs[2]
+	ownerBalance := app.BankKeeper.GetAllBalances(ctx, ownerAddr)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	rewardTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, rewardTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with no commission
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	valTokens := tstaking.CreateValidatorWithValPower(valAddr, valConsPk1, 100, true)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// tokenize a delegation as large as the self-delegation
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, valTokens)
+	_, err := stakingMsgServer.Delegate(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgDelegate(delAddr, valAddr, bondCoin))
+	require.NoError(t, err)
+	_, err = stakingMsgServer.TokenizeShares(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgTokenizeShares(delAddr, valAddr, bondCoin, ownerAddr))
+	require.NoError(t, err)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddr)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, rewardTokens)))
+
+	// the owner receives the rewards of the tokenized half of the delegations
+	res, err := msgServer.WithdrawTokenizeShareRecordReward(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawTokenizeShareRecordReward(ownerAddr))
+	require.NoError(t, err)
+
+	expRewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, rewardTokens.QuoRaw(2)))
+	require.Equal(t, expRewards, res.Amount)
+	require.Equal(t, ownerBalance.Add(expRewards...), app.BankKeeper.GetAllBalances(ctx, ownerAddr))
+
+	// the rewards cannot be withdrawn twice
+	res, err = msgServer.WithdrawTokenizeShareRecordReward(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawTokenizeShareRecordReward(ownerAddr))
+	require.NoError(t, err)
+	require.True(t, res.Amount.IsZero())
+
+	// the delegator does not own any record
+	res, err = msgServer.WithdrawTokenizeShareRecordReward(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawTokenizeShareRecordReward(delAddr))
+	require.NoError(t, err)
+	require.True(t, res.Amount.IsZero())
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewContinuousFund creates a new ContinuousFund paying percentage of the
+// community pool inflow to recipient until expiry, if not nil.
+func NewContinuousFund(recipient sdk.AccAddress, percentage sdk.Dec, expiry *time.Time) ContinuousFund {
+	return ContinuousFund{
+		Recipient:  recipient.String(),
+		Percentage: percentage,
+		Expiry:     expiry,
+	}
+}
+
+// Validate performs a stateless validation of the continuous fund.
+func (cf ContinuousFund) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cf.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return validateContinuousFundPercentage(cf.Percentage)
+}
+
+// IsExpired returns true if the continuous fund expired at blockTime.
+func (cf ContinuousFund) IsExpired(blockTime time.Time) bool {
+	return cf.Expiry != nil && !cf.Expiry.After(blockTime)
+}
+
+// ValidateContinuousFunds validates a set of continuous funds: each fund must
+// be valid, a recipient may have a single fund, and the funds may not pay more
+// than the whole community pool inflow.
+func ValidateContinuousFunds(funds []ContinuousFund) error {
+	total := sdk.ZeroDec()
+	recipients := make(map[string]bool, len(funds))
+	for _, cf := range funds {
+		if err := cf.Validate(); err != nil {
+			return err
+		}
+
+		if recipients[cf.Recipient] {
+			return sdkerrors.Wrapf(ErrContinuousFundExists, "duplicate continuous fund for %s", cf.Recipient)
+		}
+		recipients[cf.Recipient] = true
+
+		total = total.Add(cf.Percentage)
+	}
+
+	if total.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidContinuousFund, "total percentage of the continuous funds cannot exceed 1: %s", total)
+	}
+
+	return nil
+}
+
+func validateContinuousFundPercentage(percentage sdk.Dec) error {
+	if percentage.IsNil() || !percentage.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidContinuousFund, "percentage must be positive: %s", percentage)
+	}
+	if percentage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidContinuousFund, "percentage cannot exceed 1: %s", percentage)
+	}
+
+	return nil
+}
@@ -5,20 +5,23 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -80,11 +83,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
@@ -464,6 +468,66 @@ func (m *CommunityPoolSpendProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposal proto.InternalMessageInfo
 
+// ContinuousFund pays a recipient a percentage of the inflow of the community
+// pool of each block, until its expiry.
+type ContinuousFund struct {
+	// recipient is the address receiving the funds.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// percentage is the fraction of the community pool inflow paid to the
+	// recipient.
+	Percentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=percentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"percentage"`
+	// expiry is the time after which the fund is removed, a fund without expiry
+	// runs until it is cancelled.
+	Expiry *time.Time `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+}
+
+func (m *ContinuousFund) Reset()         { *m = ContinuousFund{} }
+func (m *ContinuousFund) String() string { return proto.CompactTextString(m) }
+func (*ContinuousFund) ProtoMessage()    {}
+func (*ContinuousFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{9}
+}
+func (m *ContinuousFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContinuousFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContinuousFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousFund.Merge(m, src)
+}
+func (m *ContinuousFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContinuousFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousFund proto.InternalMessageInfo
+
+func (m *ContinuousFund) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ContinuousFund) GetExpiry() *time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
 // DelegatorStartingInfo represents the starting info for a delegator reward
 // period. It tracks the previous validator period, the delegation's amount of
 // staking token, and the creation height (to check later on if any slashes have
@@ -480,7 +544,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{9}
+	return fileDescriptor_cd78a31ea281a992, []int{10}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,7 +598,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{11}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,7 +641,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,6 +680,7 @@ func init() {
 	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvents")
 	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
+	proto.RegisterType((*ContinuousFund)(nil), "cosmos.distribution.v1beta1.ContinuousFund")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
@@ -626,66 +691,69 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 941 bytes of a gzipped FileDescriptorProto
+	// 977 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x34, 0x8e, 0x93, 0x4e, 0xbf, 0x4d, 0xbe, 0x4c, 0x9c, 0xd4, 0x71, 0x2b, 0x3b, 0x5a,
-	0x89, 0x12, 0xa8, 0xe2, 0x34, 0xed, 0x2d, 0xe2, 0x12, 0x3b, 0x41, 0x70, 0x6a, 0xb4, 0x41, 0x80,
-	0xb8, 0xac, 0xc6, 0xbb, 0x2f, 0xf6, 0x28, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x9e, 0x7b, 0xe0,
-	0xc7, 0x09, 0x89, 0x0b, 0xe2, 0x80, 0x72, 0x44, 0x9c, 0xf3, 0x0f, 0x70, 0xab, 0x38, 0x95, 0x5e,
-	0x40, 0x1c, 0x02, 0x4a, 0x2e, 0x88, 0xbf, 0x02, 0xcd, 0xce, 0x78, 0xed, 0x40, 0x88, 0x7a, 0x88,
-	0xc5, 0xc9, 0x9e, 0xf7, 0x66, 0x3f, 0x3f, 0xde, 0xbc, 0x7d, 0xb3, 0xb8, 0x15, 0x0a, 0x95, 0x08,
-	0xb5, 0x1e, 0x31, 0xa5, 0x25, 0xeb, 0x66, 0x9a, 0x09, 0xbe, 0x3e, 0xd8, 0xe8, 0x82, 0xa6, 0x1b,
-	0x17, 0x82, 0xad, 0x54, 0x0a, 0x2d, 0xc8, 0x5d, 0xbb, 0xbf, 0x75, 0x21, 0xe5, 0xf6, 0xd7, 0xab,
-	0x3d, 0xd1, 0x13, 0xf9, 0xbe, 0x75, 0xf3, 0xcf, 0x3e, 0x52, 0x6f, 0x38, 0x8a, 0x2e, 0x55, 0x50,
-	0x40, 0x87, 0x82, 0x39, 0xc8, 0xfa, 0xb2, 0xcd, 0x07, 0xf6, 0x41, 0x87, 0x9f, 0x2f, 0xbc, 0x4f,
-	0xa7, 0x70, 0x65, 0x97, 0x4a, 0x9a, 0x28, 0x42, 0xf1, 0xed, 0x50, 0x24, 0x49, 0xc6, 0x99, 0x7e,
-	0x1a, 0x68, 0x7a, 0x54, 0x43, 0x2b, 0x68, 0xf5, 0x66, 0xfb, 0xed, 0xe7, 0xa7, 0xcd, 0xd2, 0xaf,
-	0xa7, 0xcd, 0xfb, 0x3d, 0xa6, 0xfb, 0x59, 0xb7, 0x15, 0x8a, 0xc4, 0x41, 0xb8, 0x9f, 0x35, 0x15,
-	0x1d, 0xac, 0xeb, 0xa7, 0x29, 0xa8, 0xd6, 0x36, 0x84, 0x2f, 0x4f, 0xd6, 0xb0, 0x63, 0xd8, 0x86,
-	0xd0, 0xff, 0x5f, 0x01, 0xf9, 0x3e, 0x3d, 0x22, 0x1c, 0x57, 0x8d, 0x46, 0x23, 0x24, 0x15, 0x0a,
-	0x64, 0x20, 0xe1, 0x90, 0xca, 0xa8, 0x76, 0xe3, 0x1a, 0x98, 0x88, 0x41, 0xde, 0x75, 0xc0, 0x7e,
-	0x8e, 0x4b, 0x52, 0xbc, 0xd8, 0x15, 0x3c, 0x53, 0xff, 0x20, 0x9c, 0xba, 0x06, 0xc2, 0x85, 0x1c,
-	0xfa, 0x6f, 0x8c, 0x8f, 0xf0, 0xe2, 0x21, 0xd3, 0xfd, 0x48, 0xd2, 0xc3, 0x80, 0x46, 0x91, 0x0c,
-	0x80, 0xd3, 0x6e, 0x0c, 0x51, 0xad, 0xbc, 0x82, 0x56, 0x67, 0xfd, 0x85, 0x61, 0x72, 0x2b, 0x8a,
-	0xe4, 0x8e, 0x4d, 0x6d, 0x96, 0xbf, 0x3e, 0x6e, 0x96, 0xbc, 0x9f, 0x10, 0xae, 0x7f, 0x40, 0x63,
-	0x16, 0x51, 0x2d, 0xe4, 0xbb, 0x4c, 0x69, 0x21, 0x59, 0x48, 0x63, 0x8b, 0xab, 0xc8, 0xe7, 0x08,
-	0xdf, 0x09, 0xb3, 0x24, 0x8b, 0xa9, 0x66, 0x03, 0x70, 0x3e, 0x02, 0x49, 0x35, 0x13, 0x35, 0xb4,
-	0x32, 0xb5, 0x7a, 0xeb, 0xd1, 0x3d, 0xd7, 0x69, 0x2d, 0x53, 0x88, 0x61, 0xc7, 0x18, 0xa5, 0x1d,
-	0xc1, 0x78, 0xfb, 0xb1, 0xf1, 0xfa, 0xfd, 0x6f, 0xcd, 0x07, 0xaf, 0xe6, 0xd5, 0x3c, 0xa3, 0xfc,
-	0xc5, 0x11, 0xa3, 0xd5, 0xe1, 0x1b, 0x3e, 0xf2, 0x06, 0x9e, 0x97, 0xb0, 0x0f, 0x12, 0x78, 0x08,
-	0x41, 0x28, 0x32, 0xae, 0xf3, 0x13, 0xbc, 0xed, 0xcf, 0x15, 0xe1, 0x8e, 0x89, 0x7a, 0xdf, 0x22,
-	0x7c, 0xa7, 0xf0, 0xd4, 0xc9, 0xa4, 0x04, 0xae, 0x87, 0x86, 0x0e, 0xf0, 0x8c, 0x35, 0xa1, 0x26,
-	0xa7, 0x7f, 0xc8, 0x40, 0x96, 0x70, 0x25, 0x05, 0xc9, 0x84, 0x6d, 0xb5, 0xb2, 0xef, 0x56, 0xde,
-	0x57, 0x08, 0x37, 0x0a, 0x81, 0x5b, 0xa1, 0xb3, 0x0b, 0x51, 0x47, 0x24, 0x09, 0x53, 0x8a, 0x09,
-	0x4e, 0x3e, 0xc1, 0x38, 0x2c, 0x56, 0x93, 0x93, 0x3a, 0x46, 0xe2, 0x7d, 0x81, 0xf0, 0xdd, 0x42,
-	0xd5, 0x93, 0x4c, 0x2b, 0x4d, 0x79, 0xc4, 0x78, 0xef, 0xbf, 0x28, 0x9d, 0xf7, 0x0d, 0xc2, 0x0b,
-	0x85, 0x98, 0xbd, 0x98, 0xaa, 0xfe, 0xce, 0x00, 0xb8, 0x26, 0x6f, 0xe2, 0xff, 0x0f, 0x86, 0xe1,
-	0xc0, 0x15, 0x17, 0xe5, 0xc5, 0x9d, 0x2f, 0xe2, 0xbb, 0x79, 0x98, 0x7c, 0x84, 0x67, 0xf7, 0x25,
-	0x0d, 0xcd, 0x24, 0xbb, 0x96, 0x57, 0xbd, 0x40, 0x33, 0x95, 0xaa, 0x5e, 0x22, 0x4e, 0x91, 0x18,
-	0x2f, 0x8d, 0xd4, 0x29, 0x93, 0x08, 0x20, 0xcf, 0xb8, 0x8a, 0x3d, 0x6c, 0x5d, 0x31, 0x66, 0x5b,
-	0x97, 0x40, 0xb6, 0xcb, 0x46, 0xb2, 0x5f, 0x1d, 0x5c, 0xc2, 0xe6, 0xde, 0xe0, 0x67, 0x08, 0xcf,
-	0xbc, 0x03, 0xb0, 0x2b, 0x44, 0x4c, 0x8e, 0xf0, 0xdc, 0x68, 0x98, 0xa6, 0x42, 0xc4, 0x93, 0x3b,
-	0xa9, 0xd1, 0xd4, 0x36, 0xcc, 0xde, 0xb3, 0x1b, 0xb8, 0xde, 0x19, 0x8f, 0xec, 0xa5, 0xc0, 0x23,
-	0x3b, 0xa6, 0x68, 0x4c, 0xaa, 0x78, 0x5a, 0x33, 0x1d, 0x83, 0x9d, 0xee, 0xbe, 0x5d, 0x90, 0x15,
-	0x7c, 0x2b, 0x02, 0x15, 0x4a, 0x96, 0x8e, 0x0e, 0xc9, 0x1f, 0x0f, 0x91, 0x7b, 0xf8, 0xa6, 0x84,
-	0x90, 0xa5, 0x0c, 0xb8, 0xb6, 0xe3, 0xd3, 0x1f, 0x05, 0x48, 0x88, 0x2b, 0x34, 0xc9, 0x07, 0x41,
-	0x39, 0xb7, 0xb9, 0x7c, 0xa9, 0xcd, 0xdc, 0xe3, 0x43, 0xe7, 0x71, 0xf5, 0x15, 0x3c, 0x5a, 0x83,
-	0x0e, 0x7a, 0xf3, 0xad, 0xcf, 0x8e, 0x9b, 0x25, 0x53, 0xe9, 0x3f, 0x8e, 0x9b, 0xa5, 0x1f, 0x4f,
-	0xd6, 0xea, 0x8e, 0xa3, 0x27, 0x06, 0x63, 0x14, 0x5c, 0x03, 0xd7, 0xde, 0x0f, 0x08, 0x2f, 0x6e,
-	0x43, 0x0c, 0xbd, 0xfc, 0xa8, 0x34, 0x95, 0x9a, 0xf1, 0xde, 0x7b, 0x7c, 0x3f, 0x1f, 0x5e, 0xa9,
-	0x84, 0x01, 0x13, 0xe6, 0x5a, 0x18, 0x6f, 0xdb, 0xb9, 0x61, 0xd8, 0x75, 0xad, 0x8f, 0xa7, 0x95,
-	0xa6, 0x07, 0x70, 0x2d, 0x2d, 0x6b, 0xa1, 0xc8, 0x03, 0x5c, 0xe9, 0x03, 0xeb, 0xf5, 0x6d, 0x09,
-	0xcb, 0xed, 0x85, 0x3f, 0x4f, 0x9b, 0xf3, 0xa1, 0x04, 0x33, 0x56, 0x79, 0x60, 0x53, 0xbe, 0xdb,
-	0xe2, 0xfd, 0x8c, 0xf0, 0xb2, 0xf3, 0xc0, 0x04, 0x2f, 0xdc, 0xb8, 0x9b, 0x66, 0x07, 0xbf, 0x36,
-	0xea, 0x70, 0x73, 0xd5, 0x80, 0x52, 0xee, 0xca, 0xae, 0xbd, 0x3c, 0x59, 0xab, 0x3a, 0xf2, 0x2d,
-	0x9b, 0xd9, 0xd3, 0xd2, 0x0c, 0x90, 0xd1, 0x2b, 0xeb, 0xe2, 0x84, 0xe1, 0x4a, 0x71, 0x09, 0x4f,
-	0xa8, 0x41, 0x1d, 0xc1, 0xe6, 0xac, 0x3b, 0x3f, 0x64, 0x9c, 0xbd, 0xfe, 0xef, 0x3d, 0xfa, 0x21,
-	0xd3, 0xfd, 0x6d, 0x48, 0x85, 0x62, 0x7a, 0x42, 0xed, 0xba, 0x34, 0xd6, 0xae, 0x26, 0xe5, 0x56,
-	0xa4, 0x86, 0x67, 0x22, 0x4b, 0x5c, 0x9b, 0xce, 0x13, 0xc3, 0xe5, 0xe6, 0xfd, 0xa1, 0xf6, 0xab,
-	0xfb, 0xae, 0xfd, 0xe4, 0xbb, 0xb3, 0x06, 0x7a, 0x7e, 0xd6, 0x40, 0x2f, 0xce, 0x1a, 0xe8, 0xf7,
-	0xb3, 0x06, 0xfa, 0xf2, 0xbc, 0x51, 0x7a, 0x71, 0xde, 0x28, 0xfd, 0x72, 0xde, 0x28, 0x7d, 0xbc,
-	0x71, 0x65, 0xd9, 0x8e, 0x2e, 0x7e, 0x23, 0xe6, 0x55, 0xec, 0x56, 0xf2, 0xef, 0xb4, 0xc7, 0x7f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x8d, 0xa6, 0x48, 0x47, 0x0a, 0x00, 0x00,
+	0x17, 0xf7, 0xb4, 0x8e, 0x93, 0xbe, 0xb4, 0xc9, 0xf7, 0x3b, 0xf9, 0x51, 0xd7, 0xad, 0xec, 0xc8,
+	0x12, 0x10, 0x14, 0xb1, 0x6e, 0xd2, 0x0b, 0xca, 0x2d, 0x76, 0x5a, 0x01, 0x07, 0x1a, 0x6d, 0x2a,
+	0x90, 0xb8, 0x2c, 0xe3, 0xdd, 0xc9, 0x7a, 0x94, 0xdd, 0x99, 0x65, 0x66, 0xd6, 0x49, 0xce, 0x5c,
+	0x00, 0x71, 0xa8, 0xc4, 0x85, 0x13, 0xea, 0x11, 0x71, 0xe5, 0x02, 0x07, 0xee, 0x3d, 0x96, 0x1b,
+	0x42, 0x22, 0x45, 0x89, 0x90, 0x10, 0x7f, 0x05, 0x9a, 0x9d, 0xf1, 0xda, 0x29, 0xa1, 0xaa, 0x22,
+	0x45, 0x9c, 0x92, 0x79, 0x6f, 0xf6, 0x7d, 0x3e, 0x9f, 0x37, 0xef, 0x87, 0xc1, 0x0b, 0x85, 0x4a,
+	0x85, 0xea, 0x44, 0x4c, 0x69, 0xc9, 0xfa, 0xb9, 0x66, 0x82, 0x77, 0x86, 0xeb, 0x7d, 0xaa, 0xc9,
+	0xfa, 0x19, 0xa3, 0x97, 0x49, 0xa1, 0x05, 0xbe, 0x6d, 0xef, 0x7b, 0x67, 0x5c, 0xee, 0x7e, 0x63,
+	0x31, 0x16, 0xb1, 0x28, 0xee, 0x75, 0xcc, 0x7f, 0xf6, 0x93, 0x46, 0xd3, 0x41, 0xf4, 0x89, 0xa2,
+	0x65, 0xe8, 0x50, 0x30, 0x17, 0xb2, 0xd1, 0x8a, 0x85, 0x88, 0x13, 0xda, 0x29, 0x4e, 0xfd, 0x7c,
+	0xaf, 0xa3, 0x59, 0x4a, 0x95, 0x26, 0x69, 0x66, 0x2f, 0xb4, 0x7f, 0xbb, 0x02, 0xb5, 0x1d, 0x22,
+	0x49, 0xaa, 0xf0, 0x2e, 0xdc, 0x08, 0x45, 0x9a, 0xe6, 0x9c, 0xe9, 0xa3, 0x40, 0x93, 0xc3, 0x3a,
+	0x5a, 0x41, 0xab, 0xd7, 0xba, 0xde, 0xd3, 0xe3, 0x56, 0xe5, 0xd7, 0xe3, 0xd6, 0xeb, 0x31, 0xd3,
+	0x83, 0xbc, 0xef, 0x85, 0x22, 0xed, 0x38, 0x54, 0xfb, 0xe7, 0x2d, 0x15, 0xed, 0x77, 0xf4, 0x51,
+	0x46, 0x95, 0xb7, 0x4d, 0x43, 0xff, 0x7a, 0x19, 0xe4, 0x11, 0x39, 0xc4, 0x1f, 0xc3, 0xa2, 0xe1,
+	0x16, 0x64, 0x52, 0x64, 0x42, 0x51, 0x19, 0x48, 0x7a, 0x40, 0x64, 0x54, 0xbf, 0x72, 0xa1, 0xd8,
+	0xd8, 0xc4, 0xda, 0x71, 0xa1, 0xfc, 0x22, 0x12, 0xee, 0xc3, 0x52, 0x5f, 0xf0, 0x5c, 0xfd, 0x03,
+	0xe2, 0xea, 0x85, 0x20, 0x16, 0x8a, 0x60, 0x2f, 0x60, 0x6c, 0xc0, 0xd2, 0x01, 0xd3, 0x83, 0x48,
+	0x92, 0x83, 0x80, 0x44, 0x91, 0x0c, 0x28, 0x27, 0xfd, 0x84, 0x46, 0xf5, 0xea, 0x0a, 0x5a, 0x9d,
+	0xf1, 0x17, 0x46, 0xce, 0xad, 0x28, 0x92, 0xf7, 0xad, 0x6b, 0xb3, 0xfa, 0xf5, 0x93, 0x56, 0xa5,
+	0xfd, 0x33, 0x82, 0xc6, 0x07, 0x24, 0x61, 0x11, 0xd1, 0x42, 0xbe, 0xc3, 0x94, 0x16, 0x92, 0x85,
+	0x24, 0xb1, 0x71, 0x15, 0xfe, 0x1c, 0xc1, 0xcd, 0x30, 0x4f, 0xf3, 0x84, 0x68, 0x36, 0xa4, 0x8e,
+	0x79, 0x20, 0x89, 0x66, 0xa2, 0x8e, 0x56, 0xae, 0xae, 0xce, 0x6e, 0xdc, 0x71, 0x55, 0xe4, 0x19,
+	0xe9, 0xa3, 0x6a, 0x30, 0x4c, 0x7b, 0x82, 0xf1, 0xee, 0x3d, 0xa3, 0xee, 0xbb, 0xe7, 0xad, 0xb5,
+	0x57, 0x53, 0x67, 0xbe, 0x51, 0xfe, 0xd2, 0x18, 0xd1, 0xf2, 0xf0, 0x0d, 0x1e, 0x7e, 0x03, 0xe6,
+	0x25, 0xdd, 0xa3, 0x92, 0xf2, 0x90, 0x06, 0xa1, 0xc8, 0xb9, 0x2e, 0x5e, 0xe9, 0x86, 0x3f, 0x57,
+	0x9a, 0x7b, 0xc6, 0xda, 0xfe, 0x06, 0xc1, 0xcd, 0x52, 0x53, 0x2f, 0x97, 0x92, 0x72, 0x3d, 0x12,
+	0xb4, 0x0f, 0xd3, 0x56, 0x84, 0xba, 0x3c, 0xfe, 0x23, 0x04, 0xbc, 0x0c, 0xb5, 0x8c, 0x4a, 0x26,
+	0x6c, 0x39, 0x55, 0x7d, 0x77, 0x6a, 0x7f, 0x85, 0xa0, 0x59, 0x12, 0xdc, 0x0a, 0x9d, 0x5c, 0x1a,
+	0xf5, 0x44, 0x9a, 0x32, 0xa5, 0x98, 0xe0, 0xf8, 0x13, 0x80, 0xb0, 0x3c, 0x5d, 0x1e, 0xd5, 0x09,
+	0x90, 0xf6, 0x17, 0x08, 0x6e, 0x97, 0xac, 0x1e, 0xe6, 0x5a, 0x69, 0xc2, 0x23, 0xc6, 0xe3, 0xff,
+	0x22, 0x75, 0xed, 0x2f, 0x11, 0x2c, 0x94, 0x64, 0x76, 0x13, 0xa2, 0x06, 0xf7, 0x87, 0x94, 0x6b,
+	0xfc, 0x26, 0xfc, 0x6f, 0x38, 0x32, 0x07, 0x2e, 0xb9, 0xa8, 0x48, 0xee, 0x7c, 0x69, 0xdf, 0x29,
+	0xcc, 0xf8, 0x3d, 0x98, 0xd9, 0x93, 0x24, 0x34, 0x53, 0xea, 0x82, 0xed, 0x5c, 0x7e, 0x6f, 0x72,
+	0xb3, 0x78, 0x0e, 0x1d, 0x85, 0x13, 0x58, 0x1e, 0xf3, 0x51, 0xc6, 0x11, 0xd0, 0xc2, 0xe3, 0x72,
+	0x74, 0xd7, 0x7b, 0xc9, 0xd0, 0xf4, 0xce, 0x09, 0xd9, 0xad, 0x1a, 0x92, 0xfe, 0xe2, 0xf0, 0x1c,
+	0x34, 0xd7, 0xb3, 0x9f, 0x22, 0x98, 0x7e, 0x40, 0xe9, 0x8e, 0x10, 0x09, 0x3e, 0x84, 0xb9, 0xf1,
+	0x50, 0xcc, 0x84, 0x48, 0x2e, 0xef, 0x6d, 0xc6, 0xd3, 0xd7, 0x20, 0xb7, 0xff, 0x40, 0xd0, 0xe8,
+	0x4d, 0x5a, 0x76, 0x33, 0xca, 0x23, 0x3b, 0x98, 0x48, 0x82, 0x17, 0x61, 0x4a, 0x33, 0x9d, 0x50,
+	0x3b, 0xa5, 0x7d, 0x7b, 0xc0, 0x2b, 0x30, 0x1b, 0x51, 0x15, 0x4a, 0x96, 0x8d, 0x9f, 0xc5, 0x9f,
+	0x34, 0xe1, 0x3b, 0x70, 0x4d, 0xd2, 0x90, 0x65, 0x8c, 0x72, 0x6d, 0x47, 0xa4, 0x3f, 0x36, 0xe0,
+	0x10, 0x6a, 0x24, 0x2d, 0x5a, 0xbf, 0x5a, 0xc8, 0xbc, 0x75, 0xae, 0xcc, 0x42, 0xe3, 0x5d, 0xa7,
+	0x71, 0xf5, 0x15, 0x34, 0x5a, 0x81, 0x2e, 0xf4, 0xe6, 0xf5, 0xcf, 0x9e, 0xb4, 0x2a, 0x26, 0xd3,
+	0x7f, 0x9a, 0x6c, 0xff, 0x80, 0x60, 0xae, 0x27, 0xb8, 0x66, 0x3c, 0x17, 0xb9, 0x7a, 0x90, 0xf3,
+	0xe8, 0x2c, 0x47, 0xf4, 0x22, 0xc7, 0xf7, 0x01, 0x32, 0x2a, 0x43, 0xca, 0x35, 0x89, 0xe9, 0x05,
+	0x2b, 0x6f, 0x22, 0x02, 0x7e, 0x1b, 0x6a, 0xf4, 0x30, 0x63, 0xf2, 0xa8, 0x48, 0xc7, 0xec, 0x46,
+	0xc3, 0xb3, 0x4b, 0xd3, 0x1b, 0x2d, 0x4d, 0xef, 0xd1, 0x68, 0x69, 0x76, 0xab, 0x8f, 0x9f, 0xb7,
+	0x90, 0xef, 0xee, 0xb7, 0xbf, 0x47, 0xb0, 0xb4, 0x4d, 0x13, 0x1a, 0x17, 0x75, 0xa4, 0x89, 0xd4,
+	0x8c, 0xc7, 0xef, 0xf2, 0xbd, 0x62, 0x96, 0x66, 0x92, 0x0e, 0x99, 0x30, 0x7b, 0x69, 0xb2, 0x8b,
+	0xe6, 0x46, 0x66, 0xd7, 0x44, 0xdb, 0x30, 0xa5, 0x34, 0xd9, 0xbf, 0xa8, 0x0e, 0xfb, 0x31, 0x5e,
+	0x83, 0xda, 0x80, 0xb2, 0x78, 0x60, 0x5f, 0xb4, 0xda, 0x5d, 0xf8, 0xeb, 0xb8, 0x35, 0x1f, 0x4a,
+	0x6a, 0xe6, 0x3a, 0x0f, 0xac, 0xcb, 0x77, 0x57, 0xda, 0x3f, 0x21, 0xb8, 0xe5, 0x58, 0x33, 0xc1,
+	0x4b, 0xfe, 0x6e, 0xd5, 0xad, 0xc1, 0xff, 0xc7, 0x0d, 0x67, 0x76, 0x1d, 0x55, 0xca, 0xbd, 0xc1,
+	0x78, 0x32, 0x6c, 0x59, 0x3b, 0x66, 0x50, 0x2b, 0xf7, 0xf9, 0x25, 0x75, 0x85, 0x03, 0xd8, 0x9c,
+	0x71, 0x45, 0x83, 0xda, 0x3f, 0x22, 0x78, 0xed, 0xdf, 0x1b, 0xe3, 0x43, 0xa6, 0x07, 0xdb, 0x34,
+	0x13, 0x8a, 0xe9, 0x4b, 0xea, 0x91, 0xe5, 0x89, 0x1e, 0x31, 0x2e, 0x77, 0xc2, 0x75, 0x98, 0x8e,
+	0x2c, 0x70, 0x7d, 0xaa, 0x70, 0x8c, 0x8e, 0x63, 0xee, 0xdd, 0x87, 0xdf, 0x9e, 0x34, 0xd1, 0xd3,
+	0x93, 0x26, 0x7a, 0x76, 0xd2, 0x44, 0xbf, 0x9f, 0x34, 0xd1, 0xe3, 0xd3, 0x66, 0xe5, 0xd9, 0x69,
+	0xb3, 0xf2, 0xcb, 0x69, 0xb3, 0xf2, 0xd1, 0xfa, 0x4b, 0x13, 0x73, 0x78, 0xf6, 0x87, 0x64, 0x91,
+	0xa7, 0x7e, 0xad, 0x28, 0xd2, 0x7b, 0x7f, 0x0f, 0x00, 0x05, 0x59, 0x61, 0xf3, 0x6c, 0x0a, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -928,6 +996,40 @@ func (this *FeePool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ContinuousFund) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContinuousFund)
+	if !ok {
+		that2, ok := that.(ContinuousFund)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.Percentage.Equal(that1.Percentage) {
+		return false
+	}
+	if that1.Expiry == nil {
+		if this.Expiry != nil {
+			return false
+		}
+	} else if !this.Expiry.Equal(*that1.Expiry) {
+		return false
+	}
+	return true
+}
 func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1417,6 +1519,56 @@ func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ContinuousFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContinuousFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiry):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintDistribution(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1728,6 +1880,25 @@ func (m *CommunityPoolSpendProposal) Size() (n int) {
 	return n
 }
 
+func (m *ContinuousFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Percentage.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.Expiry != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
 func (m *DelegatorStartingInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2796,6 +2967,158 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
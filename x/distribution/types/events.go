@@ -9,8 +9,19 @@ const (
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
 
+	EventTypeWithdrawTokenizeShareReward = "withdraw_tokenize_share_reward"
+
+	EventTypeCommunityPoolSpend    = "community_pool_spend"
+	EventTypeCreateContinuousFund  = "create_continuous_fund"
+	EventTypeCancelContinuousFund  = "cancel_continuous_fund"
+	EventTypeContinuousFundPayout  = "continuous_fund_payout"
+	EventTypeContinuousFundExpired = "continuous_fund_expired"
+
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyPercentage      = "percentage"
+	AttributeKeyExpiry          = "expiry"
 
 	AttributeValueCategory = ModuleName
 )
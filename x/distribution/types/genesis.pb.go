@@ -5,7 +5,6 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -347,6 +346,9 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// continuous_funds defines the continuous funds of the community pool at
+	// genesis.
+	ContinuousFunds []ContinuousFund `protobuf:"bytes,11,rep,name=continuous_funds,json=continuousFunds,proto3" json:"continuous_funds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -398,63 +400,64 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x93, 0x92, 0x9b, 0x3b, 0xb9, 0x88, 0x8b, 0x6f, 0x6f, 0x71, 0x7b, 0x8b, 0xd3, 0x96,
-	0x2e, 0x8a, 0x50, 0x1d, 0x9a, 0x22, 0x40, 0x45, 0x20, 0x25, 0x69, 0xf9, 0x59, 0xb5, 0x4a, 0x10,
-	0x95, 0x90, 0x50, 0x34, 0xb1, 0x27, 0xce, 0x40, 0xe2, 0x89, 0x66, 0xc6, 0x6e, 0x91, 0x58, 0x21,
-	0x21, 0x75, 0x89, 0x04, 0x0f, 0xd0, 0x25, 0x42, 0x62, 0xc7, 0x33, 0xa0, 0x2e, 0x2b, 0x56, 0x2c,
-	0x50, 0x41, 0x29, 0x0b, 0x5e, 0x81, 0x1d, 0xf2, 0x78, 0xfc, 0xa7, 0xba, 0x26, 0x2d, 0xed, 0xaa,
-	0x1d, 0xcf, 0xf9, 0xf9, 0xbe, 0x73, 0xbe, 0x9c, 0x33, 0xe0, 0x55, 0x93, 0xb0, 0x31, 0x61, 0x75,
-	0x0b, 0x33, 0x4e, 0x71, 0xdf, 0xe5, 0x98, 0x38, 0x75, 0x6f, 0xab, 0x8f, 0x38, 0xdc, 0xaa, 0xdb,
-	0xc8, 0x41, 0x0c, 0x33, 0x63, 0x42, 0x09, 0x27, 0xea, 0xb3, 0xc0, 0xd4, 0x48, 0x9a, 0x1a, 0xd2,
-	0x74, 0x69, 0xde, 0x26, 0x36, 0x11, 0x76, 0x75, 0xff, 0xbf, 0xc0, 0x65, 0x49, 0x97, 0xd1, 0xfb,
-	0x90, 0xa1, 0x28, 0xaa, 0x49, 0xb0, 0x23, 0xef, 0x8d, 0xbc, 0xec, 0xa9, 0x3c, 0x81, 0xfd, 0x62,
-	0x60, 0xdf, 0x0b, 0x12, 0x49, 0x3c, 0xe2, 0xb0, 0xf6, 0x93, 0x02, 0x9e, 0xee, 0xa2, 0x11, 0xb2,
-	0x21, 0x27, 0xf4, 0x10, 0xf3, 0xa1, 0x45, 0xe1, 0xd1, 0x47, 0xce, 0x80, 0xa8, 0x7b, 0xe0, 0x45,
-	0x2b, 0xbc, 0xe8, 0x41, 0xcb, 0xa2, 0x88, 0x31, 0x4d, 0x59, 0x51, 0x36, 0x1e, 0xb6, 0xb4, 0x5f,
-	0x7f, 0xde, 0x9c, 0x97, 0x61, 0x9a, 0xc1, 0x4d, 0x97, 0x53, 0xec, 0xd8, 0x9d, 0xc7, 0x91, 0x8b,
-	0xfc, 0xae, 0xb6, 0xc1, 0xe3, 0x23, 0x19, 0x36, 0x8a, 0x52, 0xfc, 0x8f, 0x28, 0x2f, 0x84, 0x1e,
-	0xf2, 0xf3, 0x4e, 0xe5, 0xe4, 0xb4, 0x56, 0xf8, 0xfb, 0xb4, 0x56, 0x58, 0xfb, 0x47, 0x01, 0xab,
-	0x9f, 0xc0, 0x11, 0xb6, 0xfc, 0x1c, 0xfb, 0x2e, 0x67, 0x1c, 0x3a, 0x96, 0xef, 0x83, 0x8e, 0x20,
-	0xb5, 0x58, 0x07, 0x99, 0x84, 0x5a, 0x3e, 0x76, 0x2f, 0x34, 0x9a, 0x1d, 0x7b, 0xe4, 0x12, 0x62,
-	0xff, 0x5a, 0x01, 0x4f, 0x48, 0x9c, 0xa3, 0x47, 0x83, 0x24, 0x5a, 0x71, 0xa5, 0xb4, 0x51, 0x6d,
-	0x2c, 0xcb, 0x36, 0x18, 0x7e, 0x9b, 0xc2, 0x8e, 0x1a, 0xbb, 0xc8, 0x6c, 0x13, 0xec, 0xb4, 0xb6,
-	0xcf, 0x2e, 0x6a, 0x85, 0x1f, 0xff, 0xa8, 0xbd, 0x66, 0x63, 0x3e, 0x74, 0xfb, 0x86, 0x49, 0xc6,
-	0xb2, 0xf2, 0xf2, 0xcf, 0x26, 0xb3, 0xbe, 0xa8, 0xf3, 0x2f, 0x27, 0x88, 0x85, 0x3e, 0xac, 0xa3,
-	0x92, 0x2b, 0x8c, 0x12, 0xdc, 0x7f, 0x57, 0xc0, 0x7a, 0xc4, 0xbd, 0x69, 0x9a, 0xee, 0xd8, 0x1d,
-	0x41, 0x8e, 0xac, 0x36, 0x19, 0x8f, 0x31, 0x63, 0x98, 0x38, 0x77, 0x4b, 0xdf, 0x04, 0x55, 0x18,
-	0x67, 0x11, 0x5d, 0xab, 0x36, 0xde, 0x31, 0x72, 0xf4, 0x6c, 0xe4, 0xc3, 0x6b, 0xcd, 0xf9, 0x45,
-	0xe9, 0x24, 0xa3, 0x26, 0xe8, 0xfd, 0xa5, 0x80, 0x95, 0xc8, 0xff, 0x43, 0xcc, 0x38, 0xa1, 0xd8,
-	0x84, 0xa3, 0x7b, 0xe9, 0xec, 0x02, 0x28, 0x4f, 0x10, 0xc5, 0x24, 0x60, 0x35, 0xd7, 0x91, 0x27,
-	0xf5, 0x10, 0x3c, 0x08, 0x9b, 0x5c, 0x12, 0x74, 0xdf, 0x9a, 0x8d, 0xee, 0x15, 0xb8, 0x92, 0x6a,
-	0x18, 0x2d, 0x41, 0xf3, 0x17, 0x05, 0xbc, 0x1c, 0xf9, 0xb5, 0x5d, 0x4a, 0x91, 0xc3, 0xef, 0x85,
-	0xe3, 0xc7, 0x31, 0x97, 0xa0, 0x75, 0x6f, 0xcc, 0xc6, 0x25, 0x8d, 0xe9, 0x7a, 0x22, 0xdf, 0x17,
-	0xc1, 0xb3, 0x68, 0x74, 0x74, 0x39, 0xa4, 0x1c, 0x3b, 0xb6, 0x3f, 0x3a, 0x62, 0x1a, 0x77, 0x31,
-	0x40, 0x32, 0xab, 0x51, 0xbc, 0x71, 0x35, 0x3e, 0x03, 0xcf, 0x33, 0x89, 0xb1, 0x87, 0x9d, 0x01,
-	0x91, 0xfd, 0x6d, 0xe4, 0xd6, 0x24, 0x93, 0x9e, 0xac, 0xc8, 0x23, 0x96, 0xf8, 0x96, 0x28, 0xcb,
-	0x49, 0x11, 0x2c, 0x46, 0xb5, 0xec, 0x8e, 0x20, 0x1b, 0xee, 0x79, 0xa2, 0x9c, 0x77, 0xac, 0xdf,
-	0x21, 0xc2, 0xf6, 0x90, 0x87, 0xfa, 0x0d, 0x4e, 0x09, 0x5d, 0x97, 0x52, 0xba, 0xfe, 0x1c, 0x3c,
-	0x8d, 0xd3, 0x32, 0x1f, 0x54, 0x0f, 0xf9, 0xa8, 0xb4, 0x39, 0x51, 0x85, 0xd7, 0x67, 0x53, 0x46,
-	0xcc, 0x46, 0xd6, 0xe0, 0x89, 0x77, 0xf5, 0x2a, 0x51, 0x8a, 0x8b, 0x0a, 0x78, 0xf4, 0x41, 0xb0,
-	0x0c, 0xbb, 0x1c, 0x72, 0xa4, 0x36, 0x41, 0x79, 0x02, 0x29, 0x1c, 0x07, 0x94, 0xab, 0x8d, 0x57,
-	0x72, 0xf3, 0x1e, 0x08, 0x53, 0x99, 0x4a, 0x3a, 0xaa, 0x7b, 0xa0, 0x32, 0x40, 0xa8, 0x37, 0x21,
-	0x64, 0x24, 0x65, 0xbd, 0x9e, 0x1b, 0xe4, 0x7d, 0x84, 0x0e, 0x08, 0x19, 0x85, 0x32, 0x1e, 0x04,
-	0x47, 0x95, 0x02, 0x2d, 0x16, 0x67, 0xb4, 0xa0, 0x7c, 0x61, 0xf8, 0xbf, 0xfc, 0xd2, 0xec, 0xca,
-	0x48, 0xee, 0x4c, 0x99, 0x64, 0xc1, 0xca, 0xba, 0x14, 0x4a, 0x9e, 0x50, 0xe4, 0x61, 0xe2, 0x8a,
-	0x55, 0x3c, 0x21, 0x0c, 0x51, 0xd1, 0x80, 0xdc, 0xde, 0x87, 0x2e, 0x07, 0xd2, 0x43, 0x75, 0xb3,
-	0x97, 0xd2, 0x73, 0x02, 0xf5, 0x7b, 0xb3, 0x75, 0xf2, 0xba, 0xcd, 0x29, 0x19, 0x64, 0xec, 0x21,
-	0xf5, 0x3b, 0x05, 0xac, 0x26, 0xa4, 0x1b, 0x8f, 0xf0, 0x9e, 0x19, 0x0d, 0x78, 0xa6, 0x95, 0x05,
-	0x8a, 0xe6, 0xff, 0x58, 0x12, 0x29, 0x20, 0x35, 0x2f, 0xd7, 0x96, 0xa9, 0xdf, 0x28, 0x60, 0x39,
-	0x46, 0x35, 0x8c, 0xc6, 0x70, 0x54, 0x96, 0x07, 0x02, 0xd0, 0xbb, 0xb7, 0x1c, 0xe3, 0x29, 0x30,
-	0x4b, 0xde, 0xb5, 0x76, 0xea, 0x57, 0x60, 0x31, 0x86, 0x61, 0x06, 0x13, 0x34, 0xc2, 0x50, 0x11,
-	0x18, 0x76, 0x6e, 0x33, 0x7e, 0x53, 0x00, 0x5e, 0xf2, 0xb2, 0x8d, 0xd4, 0xe3, 0xa4, 0x9a, 0x53,
-	0x63, 0x8e, 0x69, 0x0f, 0x45, 0xf2, 0xb7, 0x6f, 0x3e, 0xe7, 0x52, 0xa9, 0x63, 0x4d, 0x27, 0x4d,
-	0x98, 0x4a, 0xc1, 0x42, 0xe6, 0x60, 0x61, 0x1a, 0x10, 0x79, 0xdf, 0xbc, 0xe9, 0x64, 0x49, 0x65,
-	0x9d, 0xcf, 0x98, 0x2f, 0x89, 0x15, 0xd4, 0xda, 0xff, 0x61, 0xaa, 0x2b, 0x67, 0x53, 0x5d, 0x39,
-	0x9f, 0xea, 0xca, 0x9f, 0x53, 0x5d, 0xf9, 0xf6, 0x52, 0x2f, 0x9c, 0x5f, 0xea, 0x85, 0xdf, 0x2e,
-	0xf5, 0xc2, 0xa7, 0x5b, 0xb9, 0x4f, 0xaf, 0xe3, 0xf4, 0xf3, 0x59, 0xbc, 0xc4, 0xfa, 0x65, 0xf1,
-	0x2a, 0xde, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x18, 0xd7, 0x00, 0x14, 0xe0, 0x0b, 0x00, 0x00,
+	// 900 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x9b, 0x92, 0x6d, 0x26, 0x8b, 0x36, 0x78, 0x77, 0x83, 0xb7, 0xbb, 0x38, 0xdd, 0x65,
+	0x0f, 0xbb, 0xaa, 0x70, 0x68, 0x16, 0x01, 0x5a, 0x04, 0x52, 0x9a, 0xb6, 0xc0, 0xa9, 0x55, 0x8a,
+	0xa8, 0x84, 0x40, 0xd1, 0xc4, 0x9e, 0x38, 0x03, 0x89, 0xc7, 0x9a, 0x19, 0xbb, 0xad, 0xc4, 0x09,
+	0x09, 0x89, 0x23, 0x12, 0xff, 0x40, 0xc5, 0x09, 0x21, 0xfe, 0x0a, 0x4e, 0x15, 0xa7, 0x1e, 0x39,
+	0xd1, 0x2a, 0xbd, 0x70, 0xe2, 0x6f, 0x40, 0x1e, 0x8f, 0x7f, 0x6d, 0x1d, 0x2b, 0x4d, 0x4f, 0x89,
+	0x67, 0xde, 0xbc, 0xef, 0xfb, 0xde, 0x7b, 0xf3, 0xd9, 0xe0, 0xb9, 0x49, 0xd8, 0x84, 0xb0, 0x96,
+	0x85, 0x19, 0xa7, 0x78, 0xe0, 0x71, 0x4c, 0x9c, 0x96, 0xbf, 0x31, 0x40, 0x1c, 0x6e, 0xb4, 0x6c,
+	0xe4, 0x20, 0x86, 0x99, 0xe1, 0x52, 0xc2, 0x89, 0xfa, 0x30, 0x0c, 0x35, 0xd2, 0xa1, 0x86, 0x0c,
+	0x5d, 0xbd, 0x67, 0x13, 0x9b, 0x88, 0xb8, 0x56, 0xf0, 0x2f, 0x3c, 0xb2, 0xaa, 0xcb, 0xec, 0x03,
+	0xc8, 0x50, 0x9c, 0xd5, 0x24, 0xd8, 0x91, 0xfb, 0x46, 0x11, 0x7a, 0x06, 0x47, 0xc4, 0x3f, 0x39,
+	0x06, 0xf7, 0xb7, 0xd0, 0x18, 0xd9, 0x90, 0x13, 0x7a, 0x80, 0xf9, 0xc8, 0xa2, 0xf0, 0xf0, 0x73,
+	0x67, 0x48, 0xd4, 0x75, 0xf0, 0x86, 0x15, 0x6d, 0xf4, 0xa1, 0x65, 0x51, 0xc4, 0x98, 0xa6, 0xac,
+	0x29, 0xcf, 0xaa, 0xbd, 0x7a, 0xbc, 0xd1, 0x09, 0xd7, 0xd5, 0xe7, 0xa0, 0x7e, 0x28, 0x0f, 0xc7,
+	0xb1, 0x4b, 0x22, 0xf6, 0x4e, 0xb4, 0x2e, 0x43, 0x5f, 0xae, 0xfc, 0x74, 0xd2, 0x2c, 0xfd, 0x7b,
+	0xd2, 0x2c, 0x3d, 0x39, 0x57, 0xc0, 0xe3, 0x2f, 0xe1, 0x18, 0x5b, 0x41, 0xa6, 0x5d, 0x8f, 0x33,
+	0x0e, 0x1d, 0x0b, 0x3b, 0x76, 0x0f, 0x1d, 0x42, 0x6a, 0xb1, 0x1e, 0x32, 0x09, 0xb5, 0x02, 0x1e,
+	0x7e, 0x14, 0xf4, 0x2a, 0x8f, 0x78, 0x23, 0xe2, 0xf1, 0x83, 0x02, 0xee, 0x92, 0x24, 0x53, 0x9f,
+	0x86, 0xa9, 0xb4, 0xa5, 0xb5, 0xf2, 0xb3, 0x5a, 0xfb, 0x91, 0x2c, 0x8e, 0x11, 0x14, 0x2f, 0xaa,
+	0xb3, 0xb1, 0x85, 0xcc, 0x2e, 0xc1, 0xce, 0xe6, 0x8b, 0xd3, 0x7f, 0x9a, 0xa5, 0xdf, 0xcf, 0x9b,
+	0xeb, 0x36, 0xe6, 0x23, 0x6f, 0x60, 0x98, 0x64, 0xd2, 0x92, 0xc5, 0x0c, 0x7f, 0xde, 0x61, 0xd6,
+	0x77, 0x2d, 0x7e, 0xec, 0x22, 0x16, 0x9d, 0x61, 0x3d, 0x95, 0x5c, 0xe1, 0x9d, 0x52, 0xf8, 0xa7,
+	0x02, 0x9e, 0xc6, 0x0a, 0x3b, 0xa6, 0xe9, 0x4d, 0xbc, 0x31, 0xe4, 0xc8, 0xea, 0x92, 0xc9, 0x04,
+	0x33, 0x86, 0x89, 0xb3, 0x88, 0x48, 0x13, 0xd4, 0x60, 0x92, 0x4b, 0xd4, 0xb9, 0xd6, 0xfe, 0xc8,
+	0x28, 0x98, 0x25, 0xa3, 0x98, 0xc4, 0xe6, 0x72, 0x20, 0xbd, 0x97, 0xce, 0x9a, 0x12, 0xf1, 0x97,
+	0x02, 0xd6, 0xe2, 0xf3, 0x9f, 0x61, 0xc6, 0x09, 0xc5, 0x26, 0x1c, 0xdf, 0xa0, 0x4b, 0x0d, 0x50,
+	0x71, 0x11, 0xc5, 0x24, 0xe4, 0xbe, 0xdc, 0x93, 0x4f, 0xea, 0x01, 0xb8, 0x15, 0x35, 0xac, 0x2c,
+	0x44, 0x7d, 0x30, 0x9f, 0xa8, 0x2b, 0xa4, 0xa4, 0xa0, 0x28, 0x5b, 0x4a, 0xcc, 0x1f, 0x0a, 0x78,
+	0x2b, 0x3e, 0xd7, 0xf5, 0x28, 0x45, 0x0e, 0xbf, 0x81, 0x92, 0x2f, 0x12, 0xc6, 0x61, 0x1b, 0xde,
+	0x9b, 0x8f, 0x71, 0x16, 0x79, 0x36, 0xdd, 0x0b, 0x05, 0x3c, 0x8c, 0xaf, 0xe7, 0x3e, 0x87, 0x94,
+	0x63, 0xc7, 0x0e, 0xae, 0x67, 0x42, 0x76, 0xfe, 0x4b, 0x9a, 0xab, 0x6c, 0x69, 0x86, 0xb2, 0x6f,
+	0xc0, 0xeb, 0x4c, 0xe2, 0xf5, 0xb1, 0x33, 0x24, 0xb2, 0x23, 0xed, 0x42, 0x7d, 0xb9, 0x54, 0xa5,
+	0xba, 0xdb, 0x2c, 0xb5, 0x96, 0x92, 0xf8, 0x9f, 0x02, 0x1e, 0xc4, 0x75, 0xd9, 0x1f, 0x43, 0x36,
+	0xda, 0xf6, 0x45, 0x69, 0x16, 0x9a, 0xab, 0x11, 0xc2, 0xf6, 0x88, 0x47, 0x73, 0x15, 0x3e, 0xa5,
+	0xe6, 0xad, 0x9c, 0x99, 0xb7, 0x6f, 0xc1, 0xfd, 0x24, 0x39, 0x0b, 0xa0, 0xfb, 0x28, 0xc0, 0xd6,
+	0x96, 0x85, 0xd6, 0x77, 0xe7, 0xeb, 0x65, 0xc2, 0x59, 0x2a, 0xbd, 0xeb, 0x5f, 0xdd, 0x4a, 0x09,
+	0xfe, 0xb5, 0x0a, 0x6e, 0x7f, 0x1a, 0xbe, 0x06, 0xf6, 0x39, 0xe4, 0x48, 0xed, 0x80, 0x8a, 0x0b,
+	0x29, 0x9c, 0x84, 0xc2, 0x6a, 0xed, 0xb7, 0x0b, 0x71, 0xf7, 0x44, 0xa8, 0x84, 0x92, 0x07, 0xd5,
+	0x6d, 0xb0, 0x32, 0x44, 0xa8, 0xef, 0x12, 0x32, 0x96, 0x83, 0xf8, 0xb4, 0x30, 0xc9, 0x0e, 0x42,
+	0x7b, 0x84, 0x8c, 0xa3, 0xc1, 0x1b, 0x86, 0x8f, 0x2a, 0x05, 0x5a, 0x32, 0x4e, 0xb1, 0xa1, 0x07,
+	0xed, 0x0f, 0x6e, 0x64, 0x79, 0xfe, 0xfe, 0xa7, 0xdf, 0x24, 0x12, 0xa4, 0x61, 0xe5, 0x6d, 0x8a,
+	0xa9, 0x74, 0x29, 0xf2, 0x31, 0xf1, 0x58, 0xdf, 0xa5, 0xc4, 0x25, 0x0c, 0x51, 0xd1, 0x80, 0x6a,
+	0xaf, 0x1e, 0x6d, 0xec, 0xc9, 0x75, 0xd5, 0xcb, 0xb7, 0xf7, 0xd7, 0x04, 0xb7, 0x4f, 0xe6, 0xeb,
+	0xd7, 0xac, 0x37, 0x8d, 0xe4, 0x99, 0xe3, 0xe8, 0xea, 0x2f, 0x0a, 0x78, 0x9c, 0x1a, 0xc3, 0xc4,
+	0x26, 0xfb, 0x66, 0x6c, 0xa2, 0x4c, 0xab, 0x08, 0x16, 0x9d, 0x1b, 0x18, 0x71, 0x86, 0x48, 0xd3,
+	0x2f, 0x8c, 0x65, 0xea, 0x8f, 0x0a, 0x78, 0x94, 0xb0, 0x1a, 0xc5, 0x26, 0x18, 0x97, 0xe5, 0x96,
+	0x20, 0xf4, 0xf1, 0x82, 0x26, 0x9a, 0x21, 0xb3, 0xea, 0xcf, 0x8c, 0x53, 0xbf, 0x07, 0x0f, 0x12,
+	0x1a, 0x66, 0xe8, 0x6c, 0x31, 0x87, 0x15, 0xc1, 0xe1, 0xe5, 0x22, 0xb6, 0x98, 0x21, 0xf0, 0xa6,
+	0x9f, 0x1f, 0xa4, 0x1e, 0xa5, 0x67, 0x36, 0x63, 0x59, 0x4c, 0xab, 0x0a, 0xf0, 0x0f, 0xaf, 0xef,
+	0x59, 0x19, 0xe8, 0x86, 0x95, 0x17, 0xc2, 0x54, 0x0a, 0x1a, 0xb9, 0xf6, 0xc1, 0x34, 0x20, 0x70,
+	0xdf, 0xbf, 0xae, 0x7f, 0x64, 0x50, 0xef, 0xe5, 0xb8, 0x08, 0x53, 0xbf, 0x06, 0x75, 0x93, 0x38,
+	0x1c, 0x3b, 0x5e, 0x70, 0x5f, 0x86, 0x9e, 0x63, 0x31, 0xad, 0x26, 0xd0, 0xd6, 0x0b, 0xd1, 0xba,
+	0xf1, 0xa1, 0x1d, 0xcf, 0x89, 0x20, 0xee, 0x98, 0x99, 0xd5, 0xd4, 0x8b, 0x67, 0x73, 0xf7, 0xb7,
+	0xa9, 0xae, 0x9c, 0x4e, 0x75, 0xe5, 0x6c, 0xaa, 0x2b, 0x17, 0x53, 0x5d, 0xf9, 0xf9, 0x52, 0x2f,
+	0x9d, 0x5d, 0xea, 0xa5, 0xbf, 0x2f, 0xf5, 0xd2, 0x57, 0x1b, 0x85, 0x9f, 0x48, 0x47, 0xd9, 0x8f,
+	0x4f, 0xf1, 0xc5, 0x34, 0xa8, 0x88, 0xcf, 0xcd, 0x17, 0xff, 0x0f, 0x00, 0xe5, 0x04, 0xfc, 0x7a,
+	0x1e, 0x0b, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -780,6 +783,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContinuousFunds) > 0 {
+		for iNdEx := len(m.ContinuousFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContinuousFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1099,6 +1116,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContinuousFunds) > 0 {
+		for _, e := range m.ContinuousFunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2367,6 +2390,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuousFunds = append(m.ContinuousFunds, ContinuousFund{})
+			if err := m.ContinuousFunds[len(m.ContinuousFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -42,6 +42,10 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09: Params
+//
+// - 0x0A<accAddrLen (1 Byte)><accAddr_Bytes>: ContinuousFund
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -53,6 +57,10 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+
+	ParamsKey = []byte{0x09} // key for distribution module params
+
+	ContinuousFundPrefix = []byte{0x0A} // key for the continuous funds of the community pool
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -213,3 +221,8 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetContinuousFundKey creates the key for the continuous fund of a recipient.
+func GetContinuousFundKey(recipient sdk.AccAddress) []byte {
+	return append(ContinuousFundPrefix, address.MustLengthPrefix(recipient.Bytes())...)
+}
@@ -93,3 +93,72 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgCommunityPoolSpend
+func TestMsgCommunityPoolSpend(t *testing.T) {
+	tests := []struct {
+		authority  string
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		expectPass bool
+	}{
+		{delAddr1.String(), delAddr2, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), true},
+		{"", delAddr2, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), false},
+		{delAddr1.String(), emptyDelAddr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), false},
+		{delAddr1.String(), delAddr2, sdk.Coins{}, false},
+		{delAddr1.String(), delAddr2, sdk.Coins{sdk.NewInt64Coin("uatom", 10), sdk.NewInt64Coin("uatom", 10)}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCommunityPoolSpend(tc.authority, tc.recipient, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCreateContinuousFund
+func TestMsgCreateContinuousFund(t *testing.T) {
+	tests := []struct {
+		authority  string
+		recipient  sdk.AccAddress
+		percentage sdk.Dec
+		expectPass bool
+	}{
+		{delAddr1.String(), delAddr2, sdk.NewDecWithPrec(5, 1), true},
+		{delAddr1.String(), delAddr2, sdk.OneDec(), true},
+		{"", delAddr2, sdk.NewDecWithPrec(5, 1), false},
+		{delAddr1.String(), emptyDelAddr, sdk.NewDecWithPrec(5, 1), false},
+		{delAddr1.String(), delAddr2, sdk.ZeroDec(), false},
+		{delAddr1.String(), delAddr2, sdk.NewDecWithPrec(-5, 1), false},
+		{delAddr1.String(), delAddr2, sdk.NewDecWithPrec(11, 1), false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCreateContinuousFund(tc.authority, tc.recipient, tc.percentage, nil)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgWithdrawTokenizeShareRecordReward
+func TestMsgWithdrawTokenizeShareRecordReward(t *testing.T) {
+	tests := []struct {
+		ownerAddr  sdk.AccAddress
+		expectPass bool
+	}{
+		{delAddr1, true},
+		{emptyDelAddr, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawTokenizeShareRecordReward(tc.ownerAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package epoching
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// BeginBlocker records the time of the first block of every epoch.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.BeginEpoch(ctx)
+}
+
+// EndBlocker executes the messages queued during the epoch if the current block
+// is the last block of the epoch and starts the next epoch. At most
+// MaxMsgsPerBlock messages are executed, the others are left for the next epoch.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if !k.IsEpochLastBlock(ctx) {
+		return
+	}
+
+	epoch := k.GetEpoch(ctx)
+	k.ExecuteQueuedMessages(ctx, k.GetParams(ctx).MaxMsgsPerBlock)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEpochEnded{
+		EpochNumber: epoch.EpochNumber,
+		Height:      ctx.BlockHeight(),
+	}); err != nil {
+		panic(err)
+	}
+
+	k.IncrementEpoch(ctx)
+}
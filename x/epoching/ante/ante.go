@@ -0,0 +1,53 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// RejectUnwrappedMsgDecorator rejects the txs containing a message which must
+// be queued by the epoching module, e.g. a MsgDelegate which is not wrapped in
+// a MsgWrappedDelegate, so that the validator set only changes at the end of
+// an epoch. The messages executed by an authz MsgExec are checked as well. The
+// genesis txs are not checked so that the genesis validators can be created.
+type RejectUnwrappedMsgDecorator struct{}
+
+// NewRejectUnwrappedMsgDecorator creates a new RejectUnwrappedMsgDecorator.
+func NewRejectUnwrappedMsgDecorator() RejectUnwrappedMsgDecorator {
+	return RejectUnwrappedMsgDecorator{}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (RejectUnwrappedMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := rejectUnwrappedMsgs(tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func rejectUnwrappedMsgs(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if types.IsQueueable(msg) {
+			return types.ErrUnwrappedMessage.Wrap(sdk.MsgTypeURL(msg))
+		}
+
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			execMsgs, err := exec.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := rejectUnwrappedMsgs(execMsgs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/ante"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRejectUnwrappedMsgDecorator(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))
+
+	delegateMsg := stakingtypes.NewMsgDelegate(addr, valAddr, coin)
+	execDelegate := authz.NewMsgExec(addr, []sdk.Msg{delegateMsg})
+	execWrappedDelegate := authz.NewMsgExec(addr, []sdk.Msg{types.NewMsgWrappedDelegate(delegateMsg)})
+
+	testCases := []struct {
+		name   string
+		height int64
+		msgs   []sdk.Msg
+		expErr bool
+	}{
+		{"bank message", 1, []sdk.Msg{banktypes.NewMsgSend(addr, addr, sdk.NewCoins(coin))}, false},
+		{"wrapped delegate", 1, []sdk.Msg{types.NewMsgWrappedDelegate(delegateMsg)}, false},
+		{"delegate", 1, []sdk.Msg{delegateMsg}, true},
+		{"undelegate", 1, []sdk.Msg{stakingtypes.NewMsgUndelegate(addr, valAddr, coin)}, true},
+		{"unjail", 1, []sdk.Msg{slashingtypes.NewMsgUnjail(valAddr)}, true},
+		{"delegate after a bank message", 1, []sdk.Msg{banktypes.NewMsgSend(addr, addr, sdk.NewCoins(coin)), delegateMsg}, true},
+		{"delegate executed by authz", 1, []sdk.Msg{&execDelegate}, true},
+		{"wrapped delegate executed by authz", 1, []sdk.Msg{&execWrappedDelegate}, false},
+		{"genesis delegate", 0, []sdk.Msg{delegateMsg}, false},
+	}
+
+	app := simapp.Setup(t, false)
+	txConfig := simapp.MakeTestEncodingConfig().TxConfig
+	decorator := ante.NewRejectUnwrappedMsgDecorator()
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: tc.height})
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, next)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrUnwrappedMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
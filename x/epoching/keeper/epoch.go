@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// GetEpoch returns the current epoch.
+func (k Keeper) GetEpoch(ctx sdk.Context) types.Epoch {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.EpochKey)
+	if bz == nil {
+		panic("epoch has not been initialized")
+	}
+
+	var epoch types.Epoch
+	k.cdc.MustUnmarshal(bz, &epoch)
+
+	return epoch
+}
+
+// SetEpoch sets the current epoch.
+func (k Keeper) SetEpoch(ctx sdk.Context, epoch types.Epoch) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EpochKey, k.cdc.MustMarshal(&epoch))
+}
+
+// GetEpochLastBlockHeight returns the height of the last block of the current
+// epoch, at the end of which the queued messages are executed.
+func (k Keeper) GetEpochLastBlockHeight(ctx sdk.Context) int64 {
+	return k.GetEpoch(ctx).LastBlockHeight(k.GetParams(ctx).EpochLength)
+}
+
+// IsEpochLastBlock returns true if the current block is the last block of the
+// current epoch. Shortening the epoch length mid-epoch ends the epoch at the
+// next block.
+func (k Keeper) IsEpochLastBlock(ctx sdk.Context) bool {
+	return ctx.BlockHeight() >= k.GetEpochLastBlockHeight(ctx)
+}
+
+// BeginEpoch records the time of the first block of the current epoch if the
+// current block is that block.
+func (k Keeper) BeginEpoch(ctx sdk.Context) {
+	epoch := k.GetEpoch(ctx)
+	if epoch.FirstBlockHeight != ctx.BlockHeight() {
+		return
+	}
+
+	epoch.FirstBlockTime = ctx.BlockTime()
+	k.SetEpoch(ctx, epoch)
+}
+
+// IncrementEpoch ends the current epoch and starts the next one at the next
+// block.
+func (k Keeper) IncrementEpoch(ctx sdk.Context) types.Epoch {
+	epoch := types.NewEpoch(k.GetEpoch(ctx).EpochNumber+1, ctx.BlockHeight()+1)
+	k.SetEpoch(ctx, epoch)
+
+	return epoch
+}
+
+// GetEstimatedEpochEndTime returns the estimated time of the last block of the
+// current epoch, based on the average block time observed since the epoch
+// began. If no block time could be observed yet, the current block time is
+// returned.
+func (k Keeper) GetEstimatedEpochEndTime(ctx sdk.Context) time.Time {
+	epoch := k.GetEpoch(ctx)
+	currentHeight := ctx.BlockHeight()
+	currentTime := ctx.BlockTime()
+
+	elapsedBlocks := currentHeight - epoch.FirstBlockHeight
+	if elapsedBlocks <= 0 || epoch.FirstBlockTime.IsZero() {
+		return currentTime
+	}
+
+	remainingBlocks := epoch.LastBlockHeight(k.GetParams(ctx).EpochLength) - currentHeight
+	if remainingBlocks <= 0 {
+		return currentTime
+	}
+
+	avgBlockTime := currentTime.Sub(epoch.FirstBlockTime) / time.Duration(elapsedBlocks)
+
+	return currentTime.Add(avgBlockTime * time.Duration(remainingBlocks))
+}
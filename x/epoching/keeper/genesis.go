@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// InitGenesis initializes the epoching module's state from a given genesis
+// state. The current epoch starts at the first block of the chain and all the
+// queued messages, including the ones left over from the previous epochs, are
+// executed at the end of it in their original order. The epoching module
+// account must hold the coins escrowed by the queued messages.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
+	firstBlockHeight := ctx.BlockHeight()
+	if firstBlockHeight < 1 {
+		firstBlockHeight = 1
+	}
+	k.SetEpoch(ctx, types.NewEpoch(genState.EpochNumber, firstBlockHeight))
+
+	var escrowed sdk.Coins
+	for _, qm := range genState.QueuedMessages {
+		k.SetQueuedMessage(ctx, qm)
+
+		msg, err := qm.GetSdkMsg()
+		if err != nil {
+			panic(err)
+		}
+		if _, coins, ok := types.EscrowedCoins(msg); ok {
+			escrowed = escrowed.Add(coins...)
+		}
+	}
+	k.SetNextQueuedMessageID(ctx, genState.NextQueuedMessageId)
+
+	// check if the module account exists
+	moduleAcc := k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	balances := k.bankKeeper.GetAllBalances(ctx, moduleAcc.GetAddress())
+	if balances.IsZero() {
+		k.authKeeper.SetModuleAccount(ctx, moduleAcc)
+	}
+	if !balances.IsEqual(escrowed) {
+		panic(fmt.Sprintf("epoching module balance does not match the escrowed coins: %s <-> %s", balances, escrowed))
+	}
+}
+
+// ExportGenesis returns the epoching module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	epoch := k.GetEpoch(ctx)
+	return types.NewGenesisState(k.GetParams(ctx), epoch.EpochNumber, k.GetAllQueuedMessages(ctx), k.GetNextQueuedMessageID(ctx))
+}
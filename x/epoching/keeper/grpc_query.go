@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the epoching module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// CurrentEpoch returns the current epoch and its estimated end.
+func (k Keeper) CurrentEpoch(c context.Context, _ *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCurrentEpochResponse{
+		Epoch:            k.GetEpoch(ctx),
+		LastBlockHeight:  k.GetEpochLastBlockHeight(ctx),
+		EstimatedEndTime: k.GetEstimatedEpochEndTime(ctx),
+	}, nil
+}
+
+// QueuedMessages returns the messages waiting to be executed, in execution order.
+func (k Keeper) QueuedMessages(c context.Context, req *types.QueryQueuedMessagesRequest) (*types.QueryQueuedMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedMessageKeyPrefix)
+
+	var msgs []types.QueuedMessage
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var qm types.QueuedMessage
+		if err := k.cdc.Unmarshal(value, &qm); err != nil {
+			return err
+		}
+
+		msgs = append(msgs, qm)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQueuedMessagesResponse{Messages: msgs, Pagination: pageRes}, nil
+}
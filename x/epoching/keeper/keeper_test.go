@@ -0,0 +1,408 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	valAddr     sdk.ValAddress
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Unix(1000, 0).UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.EpochingKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.msgServer = keeper.NewMsgServerImpl(app.EpochingKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+	s.valAddr = app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// endEpoch runs the epoching end blocker at the last block of the current epoch.
+func (s *KeeperTestSuite) endEpoch() {
+	s.ctx = s.ctx.WithBlockHeight(s.app.EpochingKeeper.GetEpochLastBlockHeight(s.ctx)).
+		WithEventManager(sdk.NewEventManager())
+	epoching.EndBlocker(s.ctx, s.app.EpochingKeeper)
+}
+
+func (s *KeeperTestSuite) TestQueueAndExecuteDelegation() {
+	require := s.Require()
+	delAddr := s.addrs[0]
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+
+	res, err := s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(delAddr, s.valAddr, amount),
+	))
+	require.NoError(err)
+	require.Equal(uint64(1), res.Id)
+
+	queued := s.app.EpochingKeeper.GetQueuedMessages(s.ctx, types.DefaultEpochNumber)
+	require.Len(queued, 1)
+	require.Equal(sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}), queued[0].Msg.GetTypeUrl())
+
+	// the delegation is not executed before the end of the epoch
+	s.app.EpochingKeeper.BeginEpoch(s.ctx)
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, delAddr, s.valAddr)
+	require.False(found)
+
+	s.endEpoch()
+
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, delAddr, s.valAddr)
+	require.True(found)
+	require.Empty(s.app.EpochingKeeper.GetQueuedMessages(s.ctx, types.DefaultEpochNumber))
+
+	epoch := s.app.EpochingKeeper.GetEpoch(s.ctx)
+	require.Equal(types.DefaultEpochNumber+1, epoch.EpochNumber)
+	require.Equal(s.ctx.BlockHeight()+1, epoch.FirstBlockHeight)
+
+	executed := s.executedEvents()
+	require.Len(executed, 1)
+	require.True(executed[0].Success)
+	require.Equal(uint64(1), executed[0].Id)
+}
+
+func (s *KeeperTestSuite) TestFailedQueuedMessage() {
+	require := s.Require()
+	delAddr := s.addrs[0]
+	balance := s.app.BankKeeper.GetBalance(s.ctx, delAddr, sdk.DefaultBondDenom)
+
+	// there is no delegation to undelegate from
+	_, err := s.msgServer.WrappedUndelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedUndelegate(
+		stakingtypes.NewMsgUndelegate(delAddr, s.valAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))),
+	))
+	require.NoError(err)
+
+	// a valid message queued after a failing one is still executed
+	_, err = s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(s.addrs[1], s.valAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))),
+	))
+	require.NoError(err)
+
+	s.endEpoch()
+
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, delAddr, s.valAddr)
+	require.False(found)
+	require.Equal(balance, s.app.BankKeeper.GetBalance(s.ctx, delAddr, sdk.DefaultBondDenom))
+
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, s.addrs[1], s.valAddr)
+	require.True(found)
+
+	executed := s.executedEvents()
+	require.Len(executed, 2)
+	require.False(executed[0].Success)
+	require.NotEmpty(executed[0].Error)
+	require.True(executed[1].Success)
+}
+
+func (s *KeeperTestSuite) TestEscrowedCoins() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	delAddr := s.addrs[0]
+	valAddr := sdk.ValAddress(delAddr)
+	moduleAddr := s.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	balance := s.app.BankKeeper.GetBalance(s.ctx, delAddr, sdk.DefaultBondDenom)
+	selfDelegation := sdk.NewCoin(sdk.DefaultBondDenom, balance.Amount.QuoRaw(2))
+
+	newMsg := func() *types.MsgWrappedCreateValidator {
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			valAddr, simapp.CreateTestPubKeys(1)[0], selfDelegation, stakingtypes.NewDescription("moniker", "", "", "", ""),
+			stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+		)
+		require.NoError(err)
+		return types.NewMsgWrappedCreateValidator(msg)
+	}
+
+	// the self delegation is escrowed when the message is queued
+	_, err := s.msgServer.WrappedCreateValidator(goCtx, newMsg())
+	require.NoError(err)
+	require.Equal(selfDelegation, s.app.BankKeeper.GetBalance(s.ctx, moduleAddr, sdk.DefaultBondDenom))
+	require.Equal(balance.Sub(selfDelegation), s.app.BankKeeper.GetBalance(s.ctx, delAddr, sdk.DefaultBondDenom))
+
+	// the second message for the same validator fails at the end of the epoch
+	_, err = s.msgServer.WrappedCreateValidator(goCtx, newMsg())
+	require.NoError(err)
+	require.True(s.app.BankKeeper.GetBalance(s.ctx, delAddr, sdk.DefaultBondDenom).IsZero())
+
+	// the escrowed coins cannot be spent
+	_, err = s.msgServer.WrappedDelegate(goCtx, types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(delAddr, s.valAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+	))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	s.endEpoch()
+
+	_, found := s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
+	require.True(found)
+
+	// the coins escrowed by the failed message are returned to the delegator
+	require.True(s.app.BankKeeper.GetAllBalances(s.ctx, moduleAddr).IsZero())
+	require.Equal(balance.Sub(selfDelegation), s.app.BankKeeper.GetBalance(s.ctx, delAddr, sdk.DefaultBondDenom))
+
+	executed := s.executedEvents()
+	require.Len(executed, 2)
+	require.True(executed[0].Success)
+	require.False(executed[1].Success)
+}
+
+func (s *KeeperTestSuite) TestQueueValidation() {
+	require := s.Require()
+	unknownVal := sdk.ValAddress(s.addrs[1])
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.WrappedDelegate(goCtx, types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(s.addrs[0], unknownVal, amount),
+	))
+	require.ErrorIs(err, types.ErrUnknownValidator)
+
+	_, err = s.msgServer.WrappedUndelegate(goCtx, types.NewMsgWrappedUndelegate(
+		stakingtypes.NewMsgUndelegate(s.addrs[0], unknownVal, amount),
+	))
+	require.ErrorIs(err, types.ErrUnknownValidator)
+
+	_, err = s.msgServer.WrappedBeginRedelegate(goCtx, types.NewMsgWrappedBeginRedelegate(
+		stakingtypes.NewMsgBeginRedelegate(s.addrs[0], s.valAddr, unknownVal, amount),
+	))
+	require.ErrorIs(err, types.ErrUnknownValidator)
+
+	_, err = s.msgServer.WrappedDelegate(goCtx, types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, sdk.NewCoin("atom", sdk.NewInt(1000))),
+	))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = s.app.EpochingKeeper.QueueMsg(s.ctx, &stakingtypes.MsgEditValidator{})
+	require.ErrorIs(err, types.ErrInvalidQueuedMessage)
+
+	require.Empty(s.app.EpochingKeeper.GetQueuedMessages(s.ctx, types.DefaultEpochNumber))
+}
+
+func (s *KeeperTestSuite) TestRejectUnqueuedMsgs() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+
+	// the router rejects the staking messages unless they are queued
+	msg := stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, amount)
+	_, err := s.app.MsgServiceRouter().Handler(msg)(s.ctx, msg)
+	require.ErrorIs(err, types.ErrUnwrappedMessage)
+
+	// a group policy cannot delegate without going through the epoching module
+	createReq, err := group.NewMsgCreateGroupWithPolicy(
+		s.addrs[0].String(), []group.MemberRequest{{Address: s.addrs[0].String(), Weight: "1"}}, "", "", false,
+		group.NewThresholdDecisionPolicy("1", time.Second, 0),
+	)
+	require.NoError(err)
+	createRes, err := s.app.GroupKeeper.CreateGroupWithPolicy(goCtx, createReq)
+	require.NoError(err)
+	policyAddr, err := sdk.AccAddressFromBech32(createRes.GroupPolicyAddress)
+	require.NoError(err)
+	require.NoError(s.app.BankKeeper.SendCoins(s.ctx, s.addrs[1], policyAddr, sdk.NewCoins(amount)))
+
+	submitProposal := func(msg sdk.Msg) uint64 {
+		req, err := group.NewMsgSubmitProposal(policyAddr.String(), []string{s.addrs[0].String()}, []sdk.Msg{msg}, "", group.Exec_EXEC_TRY)
+		require.NoError(err)
+		res, err := s.app.GroupKeeper.SubmitProposal(goCtx, req)
+		require.NoError(err)
+		return res.ProposalId
+	}
+
+	delegate := stakingtypes.NewMsgDelegate(policyAddr, s.valAddr, amount)
+	proposalRes, err := s.app.GroupKeeper.Proposal(goCtx, &group.QueryProposalRequest{ProposalId: submitProposal(delegate)})
+	require.NoError(err)
+	require.Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, proposalRes.Proposal.ExecutorResult)
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, policyAddr, s.valAddr)
+	require.False(found)
+
+	// the wrapped message is queued and executed at the end of the epoch, the
+	// executed proposal being pruned
+	submitProposal(types.NewMsgWrappedDelegate(delegate))
+	require.Len(s.app.EpochingKeeper.GetQueuedMessages(s.ctx, types.DefaultEpochNumber), 1)
+
+	s.endEpoch()
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, policyAddr, s.valAddr)
+	require.True(found)
+}
+
+func (s *KeeperTestSuite) TestMaxMsgsPerBlock() {
+	require := s.Require()
+	k := s.app.EpochingKeeper
+	require.NoError(k.SetParams(s.ctx, types.NewParams(types.DefaultEpochLength, 1)))
+
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+	for _, delAddr := range s.addrs {
+		_, err := s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(
+			stakingtypes.NewMsgDelegate(delAddr, s.valAddr, amount),
+		))
+		require.NoError(err)
+	}
+
+	// only the first message is executed, the second one is left for the next epoch
+	s.endEpoch()
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, s.addrs[0], s.valAddr)
+	require.True(found)
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, s.addrs[1], s.valAddr)
+	require.False(found)
+
+	left := k.GetAllQueuedMessages(s.ctx)
+	require.Len(left, 1)
+	require.Equal(uint64(2), left[0].Id)
+	require.Equal(types.DefaultEpochNumber, left[0].EpochNumber)
+
+	// the left over message is executed before the messages of the next epoch
+	_, err := s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, amount),
+	))
+	require.NoError(err)
+
+	s.endEpoch()
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, s.addrs[1], s.valAddr)
+	require.True(found)
+
+	left = k.GetAllQueuedMessages(s.ctx)
+	require.Len(left, 1)
+	require.Equal(uint64(3), left[0].Id)
+}
+
+func (s *KeeperTestSuite) TestEstimatedEpochEndTime() {
+	require := s.Require()
+	k := s.app.EpochingKeeper
+	require.NoError(k.SetParams(s.ctx, types.NewParams(10, types.DefaultMaxMsgsPerBlock)))
+
+	// no block time has been observed yet
+	k.BeginEpoch(s.ctx)
+	require.Equal(s.ctx.BlockTime(), k.GetEstimatedEpochEndTime(s.ctx))
+
+	// 4 blocks of 5 seconds, 5 blocks remaining
+	ctx := s.ctx.WithBlockHeight(5).WithBlockTime(s.ctx.BlockTime().Add(20 * time.Second))
+	require.Equal(ctx.BlockTime().Add(25*time.Second), k.GetEstimatedEpochEndTime(ctx))
+}
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	require := s.Require()
+
+	_, err := s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))),
+	))
+	require.NoError(err)
+
+	paramsRes, err := s.queryClient.Params(s.ctx.Context(), &types.QueryParamsRequest{})
+	require.NoError(err)
+	require.Equal(types.DefaultParams(), paramsRes.Params)
+
+	epochRes, err := s.queryClient.CurrentEpoch(s.ctx.Context(), &types.QueryCurrentEpochRequest{})
+	require.NoError(err)
+	require.Equal(types.DefaultEpochNumber, epochRes.Epoch.EpochNumber)
+	require.Equal(int64(types.DefaultEpochLength), epochRes.LastBlockHeight)
+
+	queuedRes, err := s.queryClient.QueuedMessages(s.ctx.Context(), &types.QueryQueuedMessagesRequest{})
+	require.NoError(err)
+	require.Len(queuedRes.Messages, 1)
+	require.Equal(uint64(1), queuedRes.Pagination.Total)
+}
+
+func (s *KeeperTestSuite) TestGenesisExportImport() {
+	require := s.Require()
+	require.NoError(s.app.EpochingKeeper.SetParams(s.ctx, types.NewParams(types.DefaultEpochLength, 1)))
+
+	// the second delegation is left over from the first epoch
+	for _, delAddr := range s.addrs {
+		_, err := s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(
+			stakingtypes.NewMsgDelegate(delAddr, s.valAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))),
+		))
+		require.NoError(err)
+	}
+	s.endEpoch()
+
+	_, err := s.msgServer.WrappedUndelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedUndelegate(
+		stakingtypes.NewMsgUndelegate(s.addrs[0], s.valAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5))),
+	))
+	require.NoError(err)
+
+	genState := s.app.EpochingKeeper.ExportGenesis(s.ctx)
+	require.NoError(types.ValidateGenesis(*genState))
+	require.Equal(types.DefaultEpochNumber+1, genState.EpochNumber)
+	require.Len(genState.QueuedMessages, 2)
+	require.Equal(types.DefaultEpochNumber, genState.QueuedMessages[0].EpochNumber)
+
+	app2 := simapp.Setup(s.T(), false)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	escrowed := s.app.BankKeeper.GetAllBalances(s.ctx, s.app.AccountKeeper.GetModuleAddress(types.ModuleName))
+	require.NoError(banktestutil.FundModuleAccount(app2.BankKeeper, ctx2, types.ModuleName, escrowed))
+	app2.EpochingKeeper.InitGenesis(ctx2, genState)
+
+	require.Equal(genState, app2.EpochingKeeper.ExportGenesis(ctx2))
+	require.Equal(uint64(4), app2.EpochingKeeper.GetNextQueuedMessageID(ctx2))
+}
+
+func (s *KeeperTestSuite) executedEvents() []types.EventMessageExecuted {
+	var executed []types.EventMessageExecuted
+	for _, event := range s.ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+
+		if e, ok := msg.(*types.EventMessageExecuted); ok {
+			executed = append(executed, *e)
+		}
+	}
+
+	return executed
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	authority := s.app.EpochingKeeper.GetAuthority()
+
+	_, err := s.msgServer.UpdateParams(goCtx, &types.MsgUpdateParams{
+		Authority: s.addrs[0].String(),
+		Params:    types.NewParams(5, 10),
+	})
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(goCtx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    types.NewParams(0, 10),
+	})
+	require.Error(err)
+
+	_, err = s.msgServer.UpdateParams(goCtx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    types.NewParams(5, 10),
+	})
+	require.NoError(err)
+	require.Equal(types.NewParams(5, 10), s.app.EpochingKeeper.GetParams(s.ctx))
+}
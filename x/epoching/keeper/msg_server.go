@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the epoching MsgServer interface
+// for the provided Keeper. Every message is validated against the current
+// state and queued for execution at the end of the epoch.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// WrappedCreateValidator defines a method for queueing a MsgCreateValidator.
+func (k msgServer) WrappedCreateValidator(goCtx context.Context, msg *types.MsgWrappedCreateValidator) (*types.MsgWrappedCreateValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); found {
+		return nil, types.ErrValidatorExists.Wrap(msg.Msg.ValidatorAddress)
+	}
+
+	if err := k.validateBondDenom(ctx, msg.Msg.Value); err != nil {
+		return nil, err
+	}
+
+	qm, err := k.QueueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedCreateValidatorResponse{Id: qm.Id}, nil
+}
+
+// WrappedDelegate defines a method for queueing a MsgDelegate.
+func (k msgServer) WrappedDelegate(goCtx context.Context, msg *types.MsgWrappedDelegate) (*types.MsgWrappedDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateValidatorExists(ctx, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	qm, err := k.QueueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedDelegateResponse{Id: qm.Id}, nil
+}
+
+// WrappedUndelegate defines a method for queueing a MsgUndelegate.
+func (k msgServer) WrappedUndelegate(goCtx context.Context, msg *types.MsgWrappedUndelegate) (*types.MsgWrappedUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateValidatorExists(ctx, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	qm, err := k.QueueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedUndelegateResponse{Id: qm.Id}, nil
+}
+
+// WrappedBeginRedelegate defines a method for queueing a MsgBeginRedelegate.
+func (k msgServer) WrappedBeginRedelegate(goCtx context.Context, msg *types.MsgWrappedBeginRedelegate) (*types.MsgWrappedBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateValidatorExists(ctx, msg.Msg.ValidatorSrcAddress); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateValidatorExists(ctx, msg.Msg.ValidatorDstAddress); err != nil {
+		return nil, err
+	}
+
+	qm, err := k.QueueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedBeginRedelegateResponse{Id: qm.Id}, nil
+}
+
+// WrappedUnjail defines a method for queueing a MsgUnjail.
+func (k msgServer) WrappedUnjail(goCtx context.Context, msg *types.MsgWrappedUnjail) (*types.MsgWrappedUnjailResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateValidatorExists(ctx, msg.Msg.ValidatorAddr); err != nil {
+		return nil, err
+	}
+
+	qm, err := k.QueueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedUnjailResponse{Id: qm.Id}, nil
+}
+
+// UpdateParams updates the params.
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != req.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (k msgServer) validateValidatorExists(ctx sdk.Context, bech32Addr string) error {
+	valAddr, err := sdk.ValAddressFromBech32(bech32Addr)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return types.ErrUnknownValidator.Wrap(bech32Addr)
+	}
+
+	return nil
+}
+
+func (k msgServer) validateBondDenom(ctx sdk.Context, amount sdk.Coin) error {
+	if bondDenom := k.stakingKeeper.BondDenom(ctx); amount.Denom != bondDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", amount.Denom, bondDenom)
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+var _ baseapp.MsgRestriction = Keeper{}
+
+// queuedExecutionKey is the context key marking the execution of the queued
+// messages at the end of an epoch.
+type queuedExecutionKey struct{}
+
+// CheckMsg implements the baseapp.MsgRestriction interface. It rejects the
+// messages which must be queued, e.g. a MsgDelegate, unless they are executed
+// by the epoching module at the end of an epoch, so that the validator set
+// cannot change in the middle of an epoch through an authz MsgExec, a group or
+// a governance proposal. The genesis txs are not checked so that the genesis
+// validators can be created.
+func (k Keeper) CheckMsg(ctx sdk.Context, msg sdk.Msg) error {
+	if ctx.BlockHeight() == 0 || !types.IsQueueable(msg) {
+		return nil
+	}
+
+	if queued, _ := ctx.Value(queuedExecutionKey{}).(bool); queued {
+		return nil
+	}
+
+	return types.ErrUnwrappedMessage.Wrap(sdk.MsgTypeURL(msg))
+}
+
+// GetNextQueuedMessageID returns the ID to be used for the next queued message.
+func (k Keeper) GetNextQueuedMessageID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.NextQueuedMessageIDKey)
+	if bz == nil {
+		return types.DefaultNextQueuedMessageID
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextQueuedMessageID sets the ID to be used for the next queued message.
+func (k Keeper) SetNextQueuedMessageID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextQueuedMessageIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// QueueMsg buffers the given message for execution at the end of the current
+// epoch and returns the queued message.
+func (k Keeper) QueueMsg(ctx sdk.Context, msg sdk.Msg) (types.QueuedMessage, error) {
+	if !types.IsQueueable(msg) {
+		return types.QueuedMessage{}, types.ErrInvalidQueuedMessage.Wrapf("message %s cannot be queued", sdk.MsgTypeURL(msg))
+	}
+
+	if k.router.Handler(msg) == nil {
+		return types.QueuedMessage{}, sdkerrors.Wrap(types.ErrNoHandler, sdk.MsgTypeURL(msg))
+	}
+
+	var txHash []byte
+	if len(ctx.TxBytes()) > 0 {
+		txHash = tmhash.Sum(ctx.TxBytes())
+	}
+
+	id := k.GetNextQueuedMessageID(ctx)
+	epochNumber := k.GetEpoch(ctx).EpochNumber
+
+	qm, err := types.NewQueuedMessage(id, epochNumber, ctx.BlockHeight(), txHash, msg)
+	if err != nil {
+		return types.QueuedMessage{}, err
+	}
+
+	// lock the delegated coins so that the message cannot fail at the end of
+	// the epoch because the delegator spent them in the meantime
+	if delAddr, coins, ok := types.EscrowedCoins(msg); ok {
+		if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, coins); err != nil {
+			return types.QueuedMessage{}, err
+		}
+	}
+
+	k.SetQueuedMessage(ctx, qm)
+	k.SetNextQueuedMessageID(ctx, id+1)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMessageQueued{
+		EpochNumber: epochNumber,
+		Id:          id,
+		MsgTypeUrl:  sdk.MsgTypeURL(msg),
+	}); err != nil {
+		return types.QueuedMessage{}, err
+	}
+
+	return qm, nil
+}
+
+// SetQueuedMessage stores a queued message.
+func (k Keeper) SetQueuedMessage(ctx sdk.Context, qm types.QueuedMessage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.QueuedMessageKey(qm.EpochNumber, qm.Id), k.cdc.MustMarshal(&qm))
+}
+
+// DeleteQueuedMessage removes a queued message.
+func (k Keeper) DeleteQueuedMessage(ctx sdk.Context, epochNumber, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.QueuedMessageKey(epochNumber, id))
+}
+
+// IterateQueuedMessages iterates over the messages queued for the given epoch
+// in the order they were queued and performs a callback function. The
+// iteration stops when the callback returns true.
+func (k Keeper) IterateQueuedMessages(ctx sdk.Context, epochNumber uint64, cb func(qm types.QueuedMessage) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedMessagesByEpochKey(epochNumber))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var qm types.QueuedMessage
+		k.cdc.MustUnmarshal(iterator.Value(), &qm)
+
+		if cb(qm) {
+			break
+		}
+	}
+}
+
+// IterateAllQueuedMessages iterates over all the queued messages in execution
+// order, i.e. by epoch and then in the order they were queued, and performs a
+// callback function. The iteration stops when the callback returns true.
+func (k Keeper) IterateAllQueuedMessages(ctx sdk.Context, cb func(qm types.QueuedMessage) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedMessageKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var qm types.QueuedMessage
+		k.cdc.MustUnmarshal(iterator.Value(), &qm)
+
+		if cb(qm) {
+			break
+		}
+	}
+}
+
+// GetAllQueuedMessages returns all the queued messages in execution order.
+func (k Keeper) GetAllQueuedMessages(ctx sdk.Context) []types.QueuedMessage {
+	msgs := []types.QueuedMessage{}
+	k.IterateAllQueuedMessages(ctx, func(qm types.QueuedMessage) bool {
+		msgs = append(msgs, qm)
+		return false
+	})
+
+	return msgs
+}
+
+// GetQueuedMessages returns all the messages queued for the given epoch.
+func (k Keeper) GetQueuedMessages(ctx sdk.Context, epochNumber uint64) []types.QueuedMessage {
+	msgs := []types.QueuedMessage{}
+	k.IterateQueuedMessages(ctx, epochNumber, func(qm types.QueuedMessage) bool {
+		msgs = append(msgs, qm)
+		return false
+	})
+
+	return msgs
+}
+
+// ExecuteQueuedMessages executes and dequeues at most limit queued messages in
+// execution order, so that the messages left over from the previous epochs are
+// executed first. Every message is executed in its own cached context so that
+// a failing message does not affect the others. A typed EventMessageExecuted is
+// emitted for every message.
+func (k Keeper) ExecuteQueuedMessages(ctx sdk.Context, limit uint64) {
+	var msgs []types.QueuedMessage
+	k.IterateAllQueuedMessages(ctx, func(qm types.QueuedMessage) bool {
+		msgs = append(msgs, qm)
+		return uint64(len(msgs)) >= limit
+	})
+
+	for _, qm := range msgs {
+		k.DeleteQueuedMessage(ctx, qm.EpochNumber, qm.Id)
+
+		event := types.EventMessageExecuted{
+			EpochNumber: qm.EpochNumber,
+			Id:          qm.Id,
+			MsgTypeUrl:  qm.Msg.GetTypeUrl(),
+		}
+
+		if err := k.executeQueuedMessage(ctx, qm); err != nil {
+			k.Logger(ctx).Info("failed to execute queued message", "id", qm.Id, "type", qm.Msg.GetTypeUrl(), "err", err)
+			event.Error = err.Error()
+		} else {
+			event.Success = true
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (k Keeper) executeQueuedMessage(ctx sdk.Context, qm types.QueuedMessage) error {
+	msg, err := qm.GetSdkMsg()
+	if err != nil {
+		return err
+	}
+
+	// the escrowed coins are returned to the delegator even if the message
+	// fails, the message handler delegates them again otherwise
+	if delAddr, coins, ok := types.EscrowedCoins(msg); ok {
+		if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, coins); err != nil {
+			return err
+		}
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return sdkerrors.Wrap(types.ErrNoHandler, sdk.MsgTypeURL(msg))
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	res, err := handler(cacheCtx.WithValue(queuedExecutionKey{}, true), msg)
+	if err != nil {
+		return err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding epoching type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key[:1], types.NextQueuedMessageIDKey):
+			idA := sdk.BigEndianToUint64(kvA.Value)
+			idB := sdk.BigEndianToUint64(kvB.Value)
+			return fmt.Sprintf("%d\n%d", idA, idB)
+
+		case bytes.Equal(kvA.Key[:1], types.EpochKey):
+			var epochA, epochB types.Epoch
+			cdc.MustUnmarshal(kvA.Value, &epochA)
+			cdc.MustUnmarshal(kvB.Value, &epochB)
+			return fmt.Sprintf("%v\n%v", epochA, epochB)
+
+		case bytes.Equal(kvA.Key[:1], types.QueuedMessageKeyPrefix):
+			var qmA, qmB types.QueuedMessage
+			cdc.MustUnmarshal(kvA.Value, &qmA)
+			cdc.MustUnmarshal(kvB.Value, &qmB)
+			return fmt.Sprintf("%v\n%v", qmA, qmB)
+
+		default:
+			panic(fmt.Sprintf("invalid epoching key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// Simulation parameter constants
+const (
+	EpochLength     = "epoch_length"
+	MaxMsgsPerBlock = "max_msgs_per_block"
+)
+
+// GenEpochLength randomized EpochLength
+func GenEpochLength(r *rand.Rand) uint64 {
+	return uint64(r.Intn(20) + 1)
+}
+
+// GenMaxMsgsPerBlock randomized MaxMsgsPerBlock
+func GenMaxMsgsPerBlock(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for epoching
+func RandomizedGenState(simState *module.SimulationState) {
+	var epochLength uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EpochLength, &epochLength, simState.Rand,
+		func(r *rand.Rand) { epochLength = GenEpochLength(r) },
+	)
+
+	var maxMsgsPerBlock uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxMsgsPerBlock, &maxMsgsPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxMsgsPerBlock = GenMaxMsgsPerBlock(r) },
+	)
+
+	epochingGenesis := types.NewGenesisState(types.NewParams(epochLength, maxMsgsPerBlock), types.DefaultEpochNumber, []types.QueuedMessage{}, types.DefaultNextQueuedMessageID)
+
+	bz, err := json.MarshalIndent(&epochingGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated epoching parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(epochingGenesis)
+}
@@ -0,0 +1,439 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgWrappedCreateValidator = "op_weight_msg_wrapped_create_validator" //nolint:gosec
+	OpWeightMsgWrappedDelegate        = "op_weight_msg_wrapped_delegate"         //nolint:gosec
+	OpWeightMsgWrappedUndelegate      = "op_weight_msg_wrapped_undelegate"       //nolint:gosec
+	OpWeightMsgWrappedBeginRedelegate = "op_weight_msg_wrapped_begin_redelegate" //nolint:gosec
+	OpWeightMsgWrappedUnjail          = "op_weight_msg_wrapped_unjail"           //nolint:gosec
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper,
+	bk types.BankKeeper, sk types.StakingKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgWrappedCreateValidator int
+		weightMsgWrappedDelegate        int
+		weightMsgWrappedUndelegate      int
+		weightMsgWrappedBeginRedelegate int
+		weightMsgWrappedUnjail          int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgWrappedCreateValidator, &weightMsgWrappedCreateValidator, nil,
+		func(_ *rand.Rand) {
+			weightMsgWrappedCreateValidator = simappparams.DefaultWeightMsgWrappedCreateValidator
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgWrappedDelegate, &weightMsgWrappedDelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgWrappedDelegate = simappparams.DefaultWeightMsgWrappedDelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgWrappedUndelegate, &weightMsgWrappedUndelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgWrappedUndelegate = simappparams.DefaultWeightMsgWrappedUndelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgWrappedBeginRedelegate, &weightMsgWrappedBeginRedelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgWrappedBeginRedelegate = simappparams.DefaultWeightMsgWrappedBeginRedelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgWrappedUnjail, &weightMsgWrappedUnjail, nil,
+		func(_ *rand.Rand) {
+			weightMsgWrappedUnjail = simappparams.DefaultWeightMsgWrappedUnjail
+		},
+	)
+
+	stakeKeeper := sk.(stakingkeeper.Keeper)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgWrappedCreateValidator,
+			SimulateMsgWrappedCreateValidator(ak, bk, stakeKeeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWrappedDelegate,
+			SimulateMsgWrappedDelegate(ak, bk, stakeKeeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWrappedUndelegate,
+			SimulateMsgWrappedUndelegate(ak, bk, stakeKeeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWrappedBeginRedelegate,
+			SimulateMsgWrappedBeginRedelegate(ak, bk, stakeKeeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWrappedUnjail,
+			SimulateMsgWrappedUnjail(ak, bk, stakeKeeper),
+		),
+	}
+}
+
+// SimulateMsgWrappedCreateValidator generates a MsgWrappedCreateValidator with random values
+func SimulateMsgWrappedCreateValidator(ak types.AccountKeeper, bk types.BankKeeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		address := sdk.ValAddress(simAccount.Address)
+
+		// ensure the validator doesn't exist already
+		_, found := sk.GetValidator(ctx, address)
+		if found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedCreateValidator, "validator already exists"), nil, nil
+		}
+
+		denom := sk.BondDenom(ctx)
+
+		balance := bk.GetBalance(ctx, simAccount.Address, denom).Amount
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedCreateValidator, "balance is negative"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedCreateValidator, "unable to generate positive amount"), nil, err
+		}
+
+		selfDelegation := sdk.NewCoin(denom, amount)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		var fees sdk.Coins
+
+		coins, hasNeg := spendable.SafeSub(selfDelegation)
+		if !hasNeg {
+			fees, err = simtypes.RandomFees(r, ctx, coins)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedCreateValidator, "unable to generate fees"), nil, err
+			}
+		}
+
+		description := stakingtypes.NewDescription(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+		)
+
+		maxCommission := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 100)), 2)
+		commission := stakingtypes.NewCommissionRates(
+			simtypes.RandomDecAmount(r, maxCommission),
+			maxCommission,
+			simtypes.RandomDecAmount(r, maxCommission),
+		)
+
+		createValidatorMsg, err := stakingtypes.NewMsgCreateValidator(address, simAccount.ConsKey.PubKey(), selfDelegation, description, commission, sdk.OneInt())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedCreateValidator, "unable to create CreateValidator message"), nil, err
+		}
+
+		msg := types.NewMsgWrappedCreateValidator(createValidatorMsg)
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           msg,
+			MsgType:       msg.Type(),
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTx(txCtx, fees)
+	}
+}
+
+// SimulateMsgWrappedDelegate generates a MsgWrappedDelegate with random values
+func SimulateMsgWrappedDelegate(ak types.AccountKeeper, bk types.BankKeeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom := sk.BondDenom(ctx)
+
+		if len(sk.GetAllValidators(ctx)) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedDelegate, "number of validators equal zero"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		val, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedDelegate, "unable to pick a validator"), nil, nil
+		}
+
+		if val.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedDelegate, "validator's invalid echange rate"), nil, nil
+		}
+
+		amount := bk.GetBalance(ctx, simAccount.Address, denom).Amount
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedDelegate, "balance is negative"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedDelegate, "unable to generate positive amount"), nil, err
+		}
+
+		bondAmt := sdk.NewCoin(denom, amount)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		var fees sdk.Coins
+
+		coins, hasNeg := spendable.SafeSub(bondAmt)
+		if !hasNeg {
+			fees, err = simtypes.RandomFees(r, ctx, coins)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedDelegate, "unable to generate fees"), nil, err
+			}
+		}
+
+		msg := types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(simAccount.Address, val.GetOperator(), bondAmt))
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           msg,
+			MsgType:       msg.Type(),
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTx(txCtx, fees)
+	}
+}
+
+// SimulateMsgWrappedUndelegate generates a MsgWrappedUndelegate with random values
+func SimulateMsgWrappedUndelegate(ak types.AccountKeeper, bk types.BankKeeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random validator
+		validator, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedUndelegate, "validator is not ok"), nil, nil
+		}
+
+		valAddr := validator.GetOperator()
+		delegations := sk.GetValidatorDelegations(ctx, validator.GetOperator())
+		if delegations == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedUndelegate, "keeper does have any delegation entries"), nil, nil
+		}
+
+		// get random delegator from validator
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		if sk.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedUndelegate, "keeper does have a max unbonding delegation entries"), nil, nil
+		}
+
+		totalBond := validator.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedUndelegate, "total bond is negative"), nil, nil
+		}
+
+		unbondAmt, err := simtypes.RandPositiveInt(r, totalBond)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedUndelegate, "invalid unbond amount"), nil, err
+		}
+
+		if unbondAmt.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedUndelegate, "unbond amount is zero"), nil, nil
+		}
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		simAccount, found := simtypes.FindAccount(accs, delAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedUndelegate, "account private key is nil"), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+		}
+
+		msg := types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(
+			delAddr, valAddr, sdk.NewCoin(sk.BondDenom(ctx), unbondAmt),
+		))
+
+		account := ak.GetAccount(ctx, delAddr)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgWrappedBeginRedelegate generates a MsgWrappedBeginRedelegate with random values
+func SimulateMsgWrappedBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random source validator
+		srcVal, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "unable to pick validator"), nil, nil
+		}
+
+		srcAddr := srcVal.GetOperator()
+		delegations := sk.GetValidatorDelegations(ctx, srcAddr)
+		if delegations == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "keeper does have any delegation entries"), nil, nil
+		}
+
+		// get random delegator from src validator
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		if sk.HasReceivingRedelegation(ctx, delAddr, srcAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "receveing redelegation is not allowed"), nil, nil // skip
+		}
+
+		// get random destination validator
+		destVal, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "unable to pick validator"), nil, nil
+		}
+
+		destAddr := destVal.GetOperator()
+		if srcAddr.Equals(destAddr) || destVal.InvalidExRate() || sk.HasMaxRedelegationEntries(ctx, delAddr, srcAddr, destAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "checks failed"), nil, nil
+		}
+
+		totalBond := srcVal.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "total bond is negative"), nil, nil
+		}
+
+		redAmt, err := simtypes.RandPositiveInt(r, totalBond)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "unable to generate positive amount"), nil, err
+		}
+
+		if redAmt.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "amount is zero"), nil, nil
+		}
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		simAccount, found := simtypes.FindAccount(accs, delAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "account private key is nil"), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+		}
+
+		account := ak.GetAccount(ctx, delAddr)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := types.NewMsgWrappedBeginRedelegate(stakingtypes.NewMsgBeginRedelegate(
+			delAddr, srcAddr, destAddr,
+			sdk.NewCoin(sk.BondDenom(ctx), redAmt),
+		))
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgWrappedUnjail generates a MsgWrappedUnjail with random values
+func SimulateMsgWrappedUnjail(ak types.AccountKeeper, bk types.BankKeeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		validator, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedUnjail, "validator is not ok"), nil, nil // skip
+		}
+
+		if !validator.IsJailed() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedUnjail, "validator is not jailed"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(validator.GetOperator()))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedUnjail, "unable to find account"), nil, nil // skip
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		// the unjail itself is only executed at the end of the epoch, so
+		// queueing it succeeds even if the validator is still jailed then
+		msg := types.NewMsgWrappedUnjail(slashingtypes.NewMsgUnjail(validator.GetOperator()))
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
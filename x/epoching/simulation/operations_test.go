@@ -0,0 +1,321 @@
+package simulation_test
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/simulation"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TestWeightedOperations tests the weights of the operations.
+func TestWeightedOperations(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	app, ctx, accs := createTestApp(t, false, r, 3)
+
+	ctx.WithChainID("test-chain")
+
+	cdc := app.AppCodec()
+	appParams := make(simtypes.AppParams)
+
+	weightesOps := simulation.WeightedOperations(appParams, cdc, app.AccountKeeper,
+		app.BankKeeper, app.StakingKeeper,
+	)
+
+	expected := []struct {
+		weight     int
+		opMsgRoute string
+		opMsgName  string
+	}{
+		{simappparams.DefaultWeightMsgWrappedCreateValidator, types.ModuleName, types.TypeMsgWrappedCreateValidator},
+		{simappparams.DefaultWeightMsgWrappedDelegate, types.ModuleName, types.TypeMsgWrappedDelegate},
+		{simappparams.DefaultWeightMsgWrappedUndelegate, types.ModuleName, types.TypeMsgWrappedUndelegate},
+		{simappparams.DefaultWeightMsgWrappedBeginRedelegate, types.ModuleName, types.TypeMsgWrappedBeginRedelegate},
+		{simappparams.DefaultWeightMsgWrappedUnjail, types.ModuleName, types.TypeMsgWrappedUnjail},
+	}
+
+	for i, w := range weightesOps {
+		operationMsg, _, _ := w.Op()(r, app.BaseApp, ctx, accs, ctx.ChainID())
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		require.Equal(t, expected[i].weight, w.Weight(), "weight should be the same")
+		require.Equal(t, expected[i].opMsgRoute, operationMsg.Route, "route should be the same")
+		require.Equal(t, expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+// TestSimulateMsgWrappedCreateValidator tests the normal scenario of a valid message of type TypeMsgWrappedCreateValidator.
+// Abonormal scenarios, where the message are created by an errors are not tested here.
+func TestSimulateMsgWrappedCreateValidator(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	app, ctx, accounts := createTestApp(t, false, r, 3)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgWrappedCreateValidator(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgWrappedCreateValidator
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgWrappedCreateValidator, msg.Type())
+	require.Equal(t, "0.080000000000000000", msg.Msg.Commission.MaxChangeRate.String())
+	require.Equal(t, "0.080000000000000000", msg.Msg.Commission.MaxRate.String())
+	require.Equal(t, "0.019527679037870745", msg.Msg.Commission.Rate.String())
+	require.Equal(t, "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r", msg.Msg.DelegatorAddress)
+	require.Equal(t, "cosmosvaloper1ghekyjucln7y67ntx7cf27m9dpuxxemnsvnaes", msg.Msg.ValidatorAddress)
+	require.Len(t, futureOperations, 0)
+}
+
+// TestSimulateMsgWrappedDelegate tests the normal scenario of a valid message of type TypeMsgWrappedDelegate.
+// Abonormal scenarios, where the message is created by an errors are not tested here.
+func TestSimulateMsgWrappedDelegate(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	app, ctx, accounts := createTestApp(t, false, r, 3)
+
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// execute operation
+	op := simulation.SimulateMsgWrappedDelegate(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgWrappedDelegate
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgWrappedDelegate, msg.Type())
+	require.Equal(t, "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r", msg.Msg.DelegatorAddress)
+	require.Equal(t, "98100858108421259236", msg.Msg.Amount.Amount.String())
+	require.Equal(t, "stake", msg.Msg.Amount.Denom)
+	require.Equal(t, "cosmosvaloper1tnh2q55v8wyygtt9srz5safamzdengsn9dsd7z", msg.Msg.ValidatorAddress)
+	require.Len(t, futureOperations, 0)
+}
+
+// TestSimulateMsgWrappedUndelegate tests the normal scenario of a valid message of type TypeMsgWrappedUndelegate.
+// Abonormal scenarios, where the message is created by an errors are not tested here.
+func TestSimulateMsgWrappedUndelegate(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	app, ctx, accounts := createTestApp(t, false, r, 3)
+
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// remove genesis validator account
+	accounts = accounts[1:]
+
+	// setup accounts[0] as validator
+	validator0 := getTestingValidator0(t, app, ctx, accounts)
+
+	// setup delegation
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 2)
+	validator0, issuedShares := validator0.AddTokensFromDel(delTokens)
+	delegator := accounts[1]
+	delegation := stakingtypes.NewDelegation(delegator.Address, validator0.GetOperator(), issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+	app.DistrKeeper.SetDelegatorStartingInfo(ctx, validator0.GetOperator(), delegator.Address, distrtypes.NewDelegatorStartingInfo(2, sdk.OneDec(), 200))
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgWrappedUndelegate(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgWrappedUndelegate
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgWrappedUndelegate, msg.Type())
+	require.Equal(t, "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r", msg.Msg.DelegatorAddress)
+	require.Equal(t, "280623462081924937", msg.Msg.Amount.Amount.String())
+	require.Equal(t, "stake", msg.Msg.Amount.Denom)
+	require.Equal(t, "cosmosvaloper1p8wcgrjr4pjju90xg6u9cgq55dxwq8j7epjs3u", msg.Msg.ValidatorAddress)
+	require.Len(t, futureOperations, 0)
+}
+
+// TestSimulateMsgWrappedBeginRedelegate tests the normal scenario of a valid message of type TypeMsgWrappedBeginRedelegate.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func TestSimulateMsgWrappedBeginRedelegate(t *testing.T) {
+	s := rand.NewSource(12)
+	r := rand.New(s)
+	app, ctx, accounts := createTestApp(t, false, r, 4)
+
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// remove genesis validator account
+	accounts = accounts[1:]
+
+	// setup accounts[0] as validator0 and accounts[1] as validator1
+	validator0 := getTestingValidator0(t, app, ctx, accounts)
+	validator1 := getTestingValidator1(t, app, ctx, accounts)
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 2)
+	validator0, issuedShares := validator0.AddTokensFromDel(delTokens)
+
+	// setup accounts[2] as delegator
+	delegator := accounts[2]
+	delegation := stakingtypes.NewDelegation(delegator.Address, validator1.GetOperator(), issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+	app.DistrKeeper.SetDelegatorStartingInfo(ctx, validator1.GetOperator(), delegator.Address, distrtypes.NewDelegatorStartingInfo(2, sdk.OneDec(), 200))
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgWrappedBeginRedelegate(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgWrappedBeginRedelegate
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgWrappedBeginRedelegate, msg.Type())
+	require.Equal(t, "cosmos1092v0qgulpejj8y8hs6dmlw82x9gv8f7jfc7jl", msg.Msg.DelegatorAddress)
+	require.Equal(t, "1883752832348281252", msg.Msg.Amount.Amount.String())
+	require.Equal(t, "stake", msg.Msg.Amount.Denom)
+	require.Equal(t, "cosmosvaloper1gnkw3uqzflagcqn6ekjwpjanlne928qhruemah", msg.Msg.ValidatorDstAddress)
+	require.Equal(t, "cosmosvaloper1kk653svg7ksj9fmu85x9ygj4jzwlyrgs89nnn2", msg.Msg.ValidatorSrcAddress)
+	require.Len(t, futureOperations, 0)
+}
+
+// TestSimulateMsgWrappedUnjail tests the normal scenario of a valid message of type TypeMsgWrappedUnjail.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func TestSimulateMsgWrappedUnjail(t *testing.T) {
+	s := rand.NewSource(5)
+	r := rand.New(s)
+	app, ctx, accounts := createTestApp(t, false, r, 3)
+
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// remove genesis validator account
+	accounts = accounts[1:]
+
+	// setup accounts[0] as validator0 and put it in jail
+	validator0 := getTestingValidator0(t, app, ctx, accounts)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator0)
+	val0ConsAddress, err := validator0.GetConsAddr()
+	require.NoError(t, err)
+	app.StakingKeeper.Jail(ctx, val0ConsAddress)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgWrappedUnjail(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgWrappedUnjail
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgWrappedUnjail, msg.Type())
+	require.Equal(t, slashingtypes.TypeMsgUnjail, msg.Msg.Type())
+	require.Equal(t, validator0.OperatorAddress, msg.Msg.ValidatorAddr)
+	require.Len(t, futureOperations, 0)
+}
+
+// returns context and an app with updated mint keeper
+func createTestApp(t *testing.T, isCheckTx bool, r *rand.Rand, n int) (*simapp.SimApp, sdk.Context, []simtypes.Account) {
+	sdk.DefaultPowerReduction = sdk.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+
+	accounts := simtypes.RandomAccounts(r, n)
+	// create validator set with single validator
+	account := accounts[0]
+	tmPk, err := cryptocodec.ToTmPubKeyInterface(account.PubKey)
+	require.NoError(t, err)
+	validator := tmtypes.NewValidator(tmPk, 1)
+
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+
+	// generate genesis account
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+	}
+
+	app := simapp.SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{acc}, balance)
+
+	ctx := app.BaseApp.NewContext(isCheckTx, tmproto.Header{})
+	app.MintKeeper.SetParams(ctx, minttypes.DefaultParams())
+	app.MintKeeper.SetMinter(ctx, minttypes.DefaultInitialMinter())
+
+	initAmt := app.StakingKeeper.TokensFromConsensusPower(ctx, 200)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	// remove genesis validator account
+	accs := accounts[1:]
+
+	// add coins to the accounts
+	for _, account := range accs {
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, account.Address)
+		app.AccountKeeper.SetAccount(ctx, acc)
+		require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, account.Address, initCoins))
+	}
+
+	return app, ctx, accounts
+}
+
+func getTestingValidator0(t *testing.T, app *simapp.SimApp, ctx sdk.Context, accounts []simtypes.Account) stakingtypes.Validator {
+	commission0 := stakingtypes.NewCommission(sdk.ZeroDec(), sdk.OneDec(), sdk.OneDec())
+	return getTestingValidator(t, app, ctx, accounts, commission0, 0)
+}
+
+func getTestingValidator1(t *testing.T, app *simapp.SimApp, ctx sdk.Context, accounts []simtypes.Account) stakingtypes.Validator {
+	commission1 := stakingtypes.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	return getTestingValidator(t, app, ctx, accounts, commission1, 1)
+}
+
+func getTestingValidator(t *testing.T, app *simapp.SimApp, ctx sdk.Context, accounts []simtypes.Account, commission stakingtypes.Commission, n int) stakingtypes.Validator {
+	account := accounts[n]
+	valPubKey := account.PubKey
+	valAddr := sdk.ValAddress(account.PubKey.Address().Bytes())
+	validator := teststaking.NewValidator(t, valAddr, valPubKey)
+	validator, err := validator.SetInitialCommission(commission)
+	require.NoError(t, err)
+
+	validator.DelegatorShares = sdk.NewDec(100)
+	validator.Tokens = app.StakingKeeper.TokensFromConsensusPower(ctx, 100)
+
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	return validator
+}
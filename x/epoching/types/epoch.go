@@ -0,0 +1,70 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// DefaultEpochNumber is the number of the first epoch.
+const DefaultEpochNumber uint64 = 1
+
+// DefaultNextQueuedMessageID is the id of the first queued message.
+const DefaultNextQueuedMessageID uint64 = 1
+
+// NewEpoch creates a new Epoch starting at the given height. The time of the
+// first block is set once that block begins.
+func NewEpoch(epochNumber uint64, firstBlockHeight int64) Epoch {
+	return Epoch{
+		EpochNumber:      epochNumber,
+		FirstBlockHeight: firstBlockHeight,
+	}
+}
+
+// LastBlockHeight returns the height of the last block of the epoch for the
+// given epoch length.
+func (e Epoch) LastBlockHeight(epochLength uint64) int64 {
+	return e.FirstBlockHeight + int64(epochLength) - 1
+}
+
+// IsQueueable returns true if the given message is buffered by the epoching
+// module instead of being executed immediately.
+func IsQueueable(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *stakingtypes.MsgCreateValidator,
+		*stakingtypes.MsgDelegate,
+		*stakingtypes.MsgUndelegate,
+		*stakingtypes.MsgBeginRedelegate,
+		*slashingtypes.MsgUnjail:
+		return true
+	default:
+		return false
+	}
+}
+
+// EscrowedCoins returns the coins escrowed by the epoching module while the
+// given message is queued and the account they belong to, i.e. the amount
+// delegated by a MsgCreateValidator or a MsgDelegate. It returns false if the
+// message does not delegate any coins.
+func EscrowedCoins(msg sdk.Msg) (sdk.AccAddress, sdk.Coins, bool) {
+	var (
+		delegator string
+		amount    sdk.Coin
+	)
+
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgCreateValidator:
+		delegator, amount = msg.DelegatorAddress, msg.Value
+	case *stakingtypes.MsgDelegate:
+		delegator, amount = msg.DelegatorAddress, msg.Amount
+	default:
+		return nil, nil, false
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	return delAddr, sdk.NewCoins(amount), true
+}
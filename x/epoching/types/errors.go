@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epoching module sentinel errors
+var (
+	ErrInvalidQueuedMessage = sdkerrors.Register(ModuleName, 2, "invalid queued message")
+	ErrUnknownValidator     = sdkerrors.Register(ModuleName, 3, "validator does not exist")
+	ErrValidatorExists      = sdkerrors.Register(ModuleName, 4, "validator already exists")
+	ErrNoHandler            = sdkerrors.Register(ModuleName, 5, "no message handler found")
+	ErrUnwrappedMessage     = sdkerrors.Register(ModuleName, 6, "message must be wrapped in an epoching message")
+)
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, epochNumber uint64, queuedMessages []QueuedMessage, nextQueuedMessageID uint64) *GenesisState {
+	return &GenesisState{
+		Params:              params,
+		EpochNumber:         epochNumber,
+		QueuedMessages:      queuedMessages,
+		NextQueuedMessageId: nextQueuedMessageID,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultEpochNumber, []QueuedMessage{}, DefaultNextQueuedMessageID)
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.EpochNumber == 0 {
+		return fmt.Errorf("epoch number must be positive")
+	}
+
+	if data.NextQueuedMessageId == 0 {
+		return fmt.Errorf("next queued message id must be positive")
+	}
+
+	seenIDs := make(map[uint64]bool, len(data.QueuedMessages))
+	for _, qm := range data.QueuedMessages {
+		if seenIDs[qm.Id] {
+			return fmt.Errorf("duplicate queued message id %d", qm.Id)
+		}
+		seenIDs[qm.Id] = true
+
+		if qm.Id >= data.NextQueuedMessageId {
+			return fmt.Errorf("queued message id %d must be lower than the next queued message id %d", qm.Id, data.NextQueuedMessageId)
+		}
+
+		if qm.EpochNumber == 0 || qm.EpochNumber > data.EpochNumber {
+			return fmt.Errorf("queued message %d has invalid epoch number %d", qm.Id, qm.EpochNumber)
+		}
+
+		if err := qm.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, qm := range data.QueuedMessages {
+		if err := qm.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ types.UnpackInterfacesMessage = QueuedMessage{}
+
+// NewQueuedMessage creates a new QueuedMessage wrapping the given msg.
+func NewQueuedMessage(id, epochNumber uint64, height int64, txHash []byte, msg sdk.Msg) (QueuedMessage, error) {
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return QueuedMessage{}, err
+	}
+
+	return QueuedMessage{
+		Id:          id,
+		EpochNumber: epochNumber,
+		BlockHeight: height,
+		TxHash:      txHash,
+		Msg:         any,
+	}, nil
+}
+
+// GetSdkMsg returns the cached sdk.Msg of the queued message.
+func (qm QueuedMessage) GetSdkMsg() (sdk.Msg, error) {
+	if qm.Msg == nil {
+		return nil, ErrInvalidQueuedMessage.Wrap("empty message")
+	}
+
+	msg, ok := qm.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, ErrInvalidQueuedMessage.Wrapf("expected sdk.Msg, got %T", qm.Msg.GetCachedValue())
+	}
+
+	return msg, nil
+}
+
+// Validate performs a stateless validation of the queued message.
+func (qm QueuedMessage) Validate() error {
+	msg, err := qm.GetSdkMsg()
+	if err != nil {
+		return err
+	}
+
+	if !IsQueueable(msg) {
+		return ErrInvalidQueuedMessage.Wrapf("message %s cannot be queued", sdk.MsgTypeURL(msg))
+	}
+
+	return msg.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (qm QueuedMessage) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(qm.Msg, &msg)
+}
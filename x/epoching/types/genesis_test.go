@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	_, _, delAddr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(delAddr)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))
+
+	newQueuedMessage := func(id uint64, msg sdk.Msg) types.QueuedMessage {
+		qm, err := types.NewQueuedMessage(id, types.DefaultEpochNumber, 1, nil, msg)
+		require.NoError(t, err)
+		return qm
+	}
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expErr   bool
+	}{
+		{
+			"default genesis",
+			types.DefaultGenesisState(),
+			false,
+		},
+		{
+			"valid queued messages",
+			types.NewGenesisState(types.DefaultParams(), 2, []types.QueuedMessage{
+				newQueuedMessage(1, stakingtypes.NewMsgDelegate(delAddr, valAddr, coin)),
+				newQueuedMessage(2, stakingtypes.NewMsgUndelegate(delAddr, valAddr, coin)),
+			}, 3),
+			false,
+		},
+		{
+			"zero epoch length",
+			types.NewGenesisState(types.NewParams(0, types.DefaultMaxMsgsPerBlock), types.DefaultEpochNumber, nil, types.DefaultNextQueuedMessageID),
+			true,
+		},
+		{
+			"zero max msgs per block",
+			types.NewGenesisState(types.NewParams(types.DefaultEpochLength, 0), types.DefaultEpochNumber, nil, types.DefaultNextQueuedMessageID),
+			true,
+		},
+		{
+			"zero epoch number",
+			types.NewGenesisState(types.DefaultParams(), 0, nil, types.DefaultNextQueuedMessageID),
+			true,
+		},
+		{
+			"zero next queued message id",
+			types.NewGenesisState(types.DefaultParams(), types.DefaultEpochNumber, nil, 0),
+			true,
+		},
+		{
+			"queued message id not lower than the next queued message id",
+			types.NewGenesisState(types.DefaultParams(), types.DefaultEpochNumber, []types.QueuedMessage{
+				newQueuedMessage(3, stakingtypes.NewMsgDelegate(delAddr, valAddr, coin)),
+			}, 3),
+			true,
+		},
+		{
+			"duplicate queued message id",
+			types.NewGenesisState(types.DefaultParams(), types.DefaultEpochNumber, []types.QueuedMessage{
+				newQueuedMessage(1, stakingtypes.NewMsgDelegate(delAddr, valAddr, coin)),
+				newQueuedMessage(1, stakingtypes.NewMsgDelegate(delAddr, valAddr, coin)),
+			}, 3),
+			true,
+		},
+		{
+			"queued message of a future epoch",
+			types.NewGenesisState(types.DefaultParams(), types.DefaultEpochNumber, []types.QueuedMessage{
+				func() types.QueuedMessage {
+					qm := newQueuedMessage(1, stakingtypes.NewMsgDelegate(delAddr, valAddr, coin))
+					qm.EpochNumber = types.DefaultEpochNumber + 1
+					return qm
+				}(),
+			}, 3),
+			true,
+		},
+		{
+			"message which cannot be queued",
+			types.NewGenesisState(types.DefaultParams(), types.DefaultEpochNumber, []types.QueuedMessage{
+				newQueuedMessage(1, bank.NewMsgSend(delAddr, delAddr, sdk.NewCoins(coin))),
+			}, 3),
+			true,
+		},
+		{
+			"invalid queued message",
+			types.NewGenesisState(types.DefaultParams(), types.DefaultEpochNumber, []types.QueuedMessage{
+				newQueuedMessage(1, stakingtypes.NewMsgDelegate(delAddr, valAddr, sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.ZeroInt()})),
+			}, 3),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateGenesis(*tc.genState)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
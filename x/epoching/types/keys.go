@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the epoching module
+	ModuleName = "epoching"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the epoching module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the epoching module
+	QuerierRoute = ModuleName
+)
+
+// Keys for epoching store
+// Items are stored with the following key: values
+//
+// - 0x10: Params
+//
+// - 0x11: nextQueuedMessageID_Bytes
+//
+// - 0x12: Epoch
+//
+// - 0x13<epochNumber_Bytes><queuedMessageID_Bytes>: QueuedMessage
+var (
+	ParamsKey              = []byte{0x10}
+	NextQueuedMessageIDKey = []byte{0x11}
+	EpochKey               = []byte{0x12}
+	QueuedMessageKeyPrefix = []byte{0x13}
+)
+
+// QueuedMessagesByEpochKey returns the prefix of the messages queued for the
+// given epoch.
+func QueuedMessagesByEpochKey(epochNumber uint64) []byte {
+	return append(QueuedMessageKeyPrefix, sdk.Uint64ToBigEndian(epochNumber)...)
+}
+
+// QueuedMessageKey returns the store key of a queued message.
+func QueuedMessageKey(epochNumber, id uint64) []byte {
+	return append(QueuedMessagesByEpochKey(epochNumber), sdk.Uint64ToBigEndian(id)...)
+}
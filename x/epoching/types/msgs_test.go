@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMsgWrappedDelegate(t *testing.T) {
+	_, _, delAddr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(delAddr)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))
+
+	msg := types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr, valAddr, coin))
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{delAddr}, msg.GetSigners())
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgWrappedDelegate, msg.Type())
+	require.Contains(t, string(msg.GetSignBytes()), `"type":"cosmos-sdk/MsgWrappedDelegate"`)
+
+	msg = types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr, valAddr, sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.ZeroInt()}))
+	require.Error(t, msg.ValidateBasic())
+
+	msg = &types.MsgWrappedDelegate{}
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidQueuedMessage)
+	require.Empty(t, msg.GetSigners())
+}
+
+func TestMsgWrappedUnjail(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	msg := types.NewMsgWrappedUnjail(slashingtypes.NewMsgUnjail(sdk.ValAddress(addr)))
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+
+	msg = types.NewMsgWrappedUnjail(&slashingtypes.MsgUnjail{ValidatorAddr: "invalid"})
+	require.Error(t, msg.ValidateBasic())
+}
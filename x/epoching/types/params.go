@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Default parameter values
+const (
+	DefaultEpochLength     uint64 = 10
+	DefaultMaxMsgsPerBlock uint64 = 500
+)
+
+// NewParams creates a new Params object
+func NewParams(epochLength, maxMsgsPerBlock uint64) Params {
+	return Params{
+		EpochLength:     epochLength,
+		MaxMsgsPerBlock: maxMsgsPerBlock,
+	}
+}
+
+// DefaultParams returns the default parameters for the epoching module
+func DefaultParams() Params {
+	return NewParams(DefaultEpochLength, DefaultMaxMsgsPerBlock)
+}
+
+// Validate performs a basic validation of the parameters
+func (p Params) Validate() error {
+	if err := validateEpochLength(p.EpochLength); err != nil {
+		return err
+	}
+
+	return validateMaxMsgsPerBlock(p.MaxMsgsPerBlock)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateEpochLength(v uint64) error {
+	if v == 0 {
+		return fmt.Errorf("epoch length must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxMsgsPerBlock(v uint64) error {
+	if v == 0 {
+		return fmt.Errorf("max msgs per block must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package feegrant
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                 = (*AllowedAddressAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*AllowedAddressAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *AllowedAddressAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewAllowedAddressAllowance creates new fee allowance for the messages
+// targeting the allowed addresses.
+func NewAllowedAddressAllowance(allowance FeeAllowanceI, allowedAddrs []string) (*AllowedAddressAllowance, error) {
+	any, err := packAllowance(allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AllowedAddressAllowance{
+		Allowance:        any,
+		AllowedAddresses: allowedAddrs,
+	}, nil
+}
+
+// GetAllowance returns the wrapped fee allowance.
+func (a *AllowedAddressAllowance) GetAllowance() (FeeAllowanceI, error) {
+	return unpackAllowance(a.Allowance)
+}
+
+// SetAllowance sets the wrapped fee allowance.
+func (a *AllowedAddressAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	any, err := packAllowance(allowance)
+	if err != nil {
+		return err
+	}
+
+	a.Allowance = any
+	return nil
+}
+
+// Accept method checks that all the messages target allowed addresses before
+// the wrapped allowance.
+func (a *AllowedAddressAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if err := a.allMsgTargetsAllowed(ctx, msgs); err != nil {
+		return false, err
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+func (a *AllowedAddressAllowance) allowedAddrsToMap(ctx sdk.Context) map[string]bool {
+	addrsMap := make(map[string]bool, len(a.AllowedAddresses))
+	for _, addr := range a.AllowedAddresses {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check address")
+		addrsMap[addr] = true
+	}
+
+	return addrsMap
+}
+
+func (a *AllowedAddressAllowance) allMsgTargetsAllowed(ctx sdk.Context, msgs []sdk.Msg) error {
+	addrsMap := a.allowedAddrsToMap(ctx)
+
+	for _, msg := range msgs {
+		targets, err := msgTargets(msg)
+		if err != nil {
+			return err
+		}
+		if len(targets) == 0 {
+			return sdkerrors.Wrapf(ErrMessageNotAllowed, "message %s does not target any address", sdk.MsgTypeURL(msg))
+		}
+
+		for _, target := range targets {
+			ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check address")
+			if !addrsMap[target] {
+				return sdkerrors.Wrapf(ErrMessageNotAllowed, "address %s does not exist in allowed addresses", target)
+			}
+		}
+	}
+
+	return nil
+}
+
+// msgTargets returns the account addresses of the top level fields of msg
+// which are not its signers, ordered by field name so that the gas consumed
+// while checking them is deterministic.
+func msgTargets(msg sdk.Msg) ([]string, error) {
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+
+	signers := make(map[string]bool)
+	for _, signer := range msg.GetSigners() {
+		signers[signer.String()] = true
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var targets []string
+	for _, name := range names {
+		s, ok := fields[name].(string)
+		if !ok || signers[s] {
+			continue
+		}
+		if _, err := sdk.AccAddressFromBech32(s); err == nil {
+			targets = append(targets, s)
+		}
+	}
+
+	return targets, nil
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *AllowedAddressAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.AllowedAddresses) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "allowed addresses shouldn't be empty")
+	}
+
+	addrsMap := make(map[string]bool, len(a.AllowedAddresses))
+	for _, addr := range a.AllowedAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowed address %s: %s", addr, err)
+		}
+		if addrsMap[addr] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "duplicate allowed address %s", addr)
+		}
+		addrsMap[addr] = true
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+func (a *AllowedAddressAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
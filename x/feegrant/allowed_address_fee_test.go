@@ -0,0 +1,172 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	ocproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	tokenfactorytypes "github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestAllowedAddressFeeValidAllow(t *testing.T) {
+	app := simapp.Setup(t, false)
+
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	contract := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+
+	cases := map[string]struct {
+		allowance *feegrant.BasicAllowance
+		addrs     []string
+		msgs      []sdk.Msg
+		fee       sdk.Coins
+		accept    bool
+		remains   sdk.Coins
+	}{
+		"address allowed": {
+			allowance: &feegrant.BasicAllowance{},
+			addrs:     []string{contract.String()},
+			msgs:      []sdk.Msg{banktypes.NewMsgSend(signer, contract, atom)},
+			accept:    true,
+		},
+		"address allowed with spend limit": {
+			allowance: &feegrant.BasicAllowance{
+				SpendLimit: atom,
+			},
+			addrs:   []string{other.String(), contract.String()},
+			msgs:    []sdk.Msg{banktypes.NewMsgSend(signer, contract, atom)},
+			fee:     smallAtom,
+			accept:  true,
+			remains: sdk.NewCoins(sdk.NewInt64Coin("atom", 512)),
+		},
+		"address not allowed": {
+			allowance: &feegrant.BasicAllowance{},
+			addrs:     []string{contract.String()},
+			msgs:      []sdk.Msg{banktypes.NewMsgSend(signer, other, atom)},
+			accept:    false,
+		},
+		"one of the messages not allowed": {
+			allowance: &feegrant.BasicAllowance{},
+			addrs:     []string{contract.String()},
+			msgs: []sdk.Msg{
+				banktypes.NewMsgSend(signer, contract, atom),
+				banktypes.NewMsgSend(signer, other, atom),
+			},
+			accept: false,
+		},
+		"signer is not a target": {
+			allowance: &feegrant.BasicAllowance{},
+			addrs:     []string{signer.String()},
+			msgs:      []sdk.Msg{banktypes.NewMsgSend(signer, contract, atom)},
+			accept:    false,
+		},
+		"message without target": {
+			allowance: &feegrant.BasicAllowance{},
+			addrs:     []string{contract.String()},
+			msgs:      []sdk.Msg{govv1.NewMsgVote(signer, 1, govv1.OptionYes, "")},
+			accept:    false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			ctx := app.BaseApp.NewContext(false, ocproto.Header{})
+
+			allowance, err := feegrant.NewAllowedAddressAllowance(tc.allowance, tc.addrs)
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			removed, err := allowance.Accept(ctx, tc.fee, tc.msgs)
+			if !tc.accept {
+				require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, removed)
+
+			feeAllowance, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, tc.remains, feeAllowance.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+}
+
+func TestAllowedAddressFeeDeterministicGas(t *testing.T) {
+	app := simapp.Setup(t, false)
+
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	allowed := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// the message targets one allowed and one disallowed address, so the gas
+	// consumed before rejecting it depends on the order they are checked in
+	msg := tokenfactorytypes.NewMsgForceTransfer(signer, sdk.NewInt64Coin("atom", 10), allowed, other)
+
+	var gasUsed sdk.Gas
+	for i := 0; i < 100; i++ {
+		ctx := app.BaseApp.NewContext(false, ocproto.Header{}).WithGasMeter(sdk.NewInfiniteGasMeter())
+
+		allowance, err := feegrant.NewAllowedAddressAllowance(&feegrant.BasicAllowance{}, []string{allowed.String()})
+		require.NoError(t, err)
+
+		_, err = allowance.Accept(ctx, nil, []sdk.Msg{msg})
+		require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+
+		if i == 0 {
+			gasUsed = ctx.GasMeter().GasConsumed()
+			continue
+		}
+		require.Equal(t, gasUsed, ctx.GasMeter().GasConsumed())
+	}
+}
+
+func TestAllowedAddressFeeValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	cases := map[string]struct {
+		addrs []string
+		valid bool
+	}{
+		"valid addresses": {
+			addrs: []string{addr},
+			valid: true,
+		},
+		"empty addresses": {
+			addrs: nil,
+			valid: false,
+		},
+		"invalid address": {
+			addrs: []string{"invalid"},
+			valid: false,
+		},
+		"duplicate address": {
+			addrs: []string{addr, addr},
+			valid: false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewAllowedAddressAllowance(&feegrant.BasicAllowance{}, tc.addrs)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
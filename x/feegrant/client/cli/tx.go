@@ -17,11 +17,14 @@ import (
 
 // flag for feegrant module
 const (
-	FlagExpiration  = "expiration"
-	FlagPeriod      = "period"
-	FlagPeriodLimit = "period-limit"
-	FlagSpendLimit  = "spend-limit"
-	FlagAllowedMsgs = "allowed-messages"
+	FlagExpiration   = "expiration"
+	FlagPeriod       = "period"
+	FlagPeriodLimit  = "period-limit"
+	FlagSpendLimit   = "spend-limit"
+	FlagAllowedMsgs  = "allowed-messages"
+	FlagAllowedAddrs = "allowed-addresses"
+	FlagBlockLimit   = "block-limit"
+	FlagMaxGasPrice  = "max-gas-price"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -57,8 +60,11 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --allowed-addresses cosmos1ghek... --block-limit 10stake
+	--max-gas-price 0.025stake
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -160,6 +166,52 @@ Examples:
 				}
 			}
 
+			allowedAddrs, err := cmd.Flags().GetStringSlice(FlagAllowedAddrs)
+			if err != nil {
+				return err
+			}
+
+			if len(allowedAddrs) > 0 {
+				grant, err = feegrant.NewAllowedAddressAllowance(grant, allowedAddrs)
+				if err != nil {
+					return err
+				}
+			}
+
+			blockLimitVal, err := cmd.Flags().GetString(FlagBlockLimit)
+			if err != nil {
+				return err
+			}
+
+			if blockLimitVal != "" {
+				blockLimit, err := sdk.ParseCoinsNormalized(blockLimitVal)
+				if err != nil {
+					return err
+				}
+
+				grant, err = feegrant.NewPerBlockAllowance(grant, blockLimit)
+				if err != nil {
+					return err
+				}
+			}
+
+			maxGasPriceVal, err := cmd.Flags().GetString(FlagMaxGasPrice)
+			if err != nil {
+				return err
+			}
+
+			if maxGasPriceVal != "" {
+				maxGasPrice, err := sdk.ParseDecCoins(maxGasPriceVal)
+				if err != nil {
+					return err
+				}
+
+				grant, err = feegrant.NewMaxGasPriceAllowance(grant, maxGasPrice)
+				if err != nil {
+					return err
+				}
+			}
+
 			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
 			if err != nil {
 				return err
@@ -171,6 +223,9 @@ Examples:
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
+	cmd.Flags().StringSlice(FlagAllowedAddrs, []string{}, "Set of addresses the messages paid by the fee allowance can target")
+	cmd.Flags().String(FlagBlockLimit, "", "block limit specifies the maximum number of coins that can be spent in a block")
+	cmd.Flags().String(FlagMaxGasPrice, "", "max gas price specifies the maximum gas price of the transactions paid by the fee allowance (ex: 0.025stake)")
 	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
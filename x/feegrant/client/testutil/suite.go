@@ -18,6 +18,7 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/client/cli"
 	govtestutil "github.com/cosmos/cosmos-sdk/x/gov/client/testutil"
@@ -983,6 +984,126 @@ func (s *IntegrationTestSuite) TestFilteredFeeAllowance() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestAllowedAddressFeeAllowance() {
+	val := s.network.Validators[0]
+
+	granter := val.Address
+	k, _, err := val.ClientCtx.Keyring.NewMnemonic("grantee2", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	pub, err := k.GetPubKey()
+	s.Require().NoError(err)
+	grantee := sdk.AccAddress(pub.Address())
+
+	clientCtx := val.ClientCtx
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))).String()),
+	}
+	allowedAddr := "cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl"
+	amount := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)))
+
+	_, err = banktestutil.MsgSendExec(clientCtx, granter, grantee, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))), commonFlags...)
+	s.Require().NoError(err)
+
+	args := append(
+		[]string{
+			granter.String(),
+			grantee.String(),
+			fmt.Sprintf("--%s=%s", cli.FlagAllowedAddrs, allowedAddr),
+			fmt.Sprintf("--%s=%s", cli.FlagBlockLimit, "1000stake"),
+			fmt.Sprintf("--%s=%s", cli.FlagMaxGasPrice, "0.001stake"),
+			fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "1000stake"),
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+		},
+		commonFlags...,
+	)
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewCmdFeeGrant(), args)
+	s.Require().NoError(err)
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	// get the fee allowance and check it wraps the allowances in order
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryFeeGrant(), []string{
+		granter.String(),
+		grantee.String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	resp := &feegrant.Grant{}
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), resp), out.String())
+
+	grant, err := resp.GetGrant()
+	s.Require().NoError(err)
+	maxGasPriceGrant, ok := grant.(*feegrant.MaxGasPriceAllowance)
+	s.Require().True(ok)
+	grant, err = maxGasPriceGrant.GetAllowance()
+	s.Require().NoError(err)
+	perBlockGrant, ok := grant.(*feegrant.PerBlockAllowance)
+	s.Require().True(ok)
+	grant, err = perBlockGrant.GetAllowance()
+	s.Require().NoError(err)
+	allowedAddrGrant, ok := grant.(*feegrant.AllowedAddressAllowance)
+	s.Require().True(ok)
+	s.Require().Equal([]string{allowedAddr}, allowedAddrGrant.AllowedAddresses)
+
+	// exec the fee allowance
+	cases := []struct {
+		name         string
+		to           string
+		fees         sdk.Coins
+		expectedCode uint32
+	}{
+		{
+			"valid send to allowed address",
+			allowedAddr,
+			sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))),
+			0,
+		},
+		{
+			"should fail with gas price above max gas price",
+			allowedAddr,
+			sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(300))),
+			2,
+		},
+		{
+			"should fail with address not allowed",
+			"cosmos14cm33pvnrv2497tyt8sp9yavhmw83nwej3m0e8",
+			sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))),
+			7,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			to, err := sdk.AccAddressFromBech32(tc.to)
+			s.Require().NoError(err)
+
+			out, err := banktestutil.MsgSendExec(clientCtx, grantee, to, amount,
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, tc.fees.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagFeeGranter, granter.String()),
+			)
+			s.Require().NoError(err)
+
+			var txResp sdk.TxResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+			s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+		})
+	}
+
+	// revoke the fee allowance not to change the grants of the granter
+	args = append([]string{granter.String(), grantee.String(), fmt.Sprintf("--%s=%s", flags.FlagFrom, granter)}, commonFlags...)
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewCmdRevokeFeegrant(), args)
+	s.Require().NoError(err)
+}
+
 func getFormattedExpiration(duration int64) string {
 	return time.Now().Add(time.Duration(duration) * time.Second).Format(time.RFC3339)
 }
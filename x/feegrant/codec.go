@@ -20,6 +20,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance", nil)
 	cdc.RegisterConcrete(&PeriodicAllowance{}, "cosmos-sdk/PeriodicAllowance", nil)
 	cdc.RegisterConcrete(&AllowedMsgAllowance{}, "cosmos-sdk/AllowedMsgAllowance", nil)
+	cdc.RegisterConcrete(&MaxGasPriceAllowance{}, "cosmos-sdk/MaxGasPriceAllowance", nil)
+	cdc.RegisterConcrete(&AllowedAddressAllowance{}, "cosmos-sdk/AllowedAddressAllowance", nil)
+	cdc.RegisterConcrete(&PerBlockAllowance{}, "cosmos-sdk/PerBlockAllowance", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -35,6 +38,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&BasicAllowance{},
 		&PeriodicAllowance{},
 		&AllowedMsgAllowance{},
+		&MaxGasPriceAllowance{},
+		&AllowedAddressAllowance{},
+		&PerBlockAllowance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
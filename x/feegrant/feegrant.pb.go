@@ -5,7 +5,6 @@ package feegrant
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-proto"
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -218,6 +217,141 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// MaxGasPriceAllowance creates allowance only for transactions paying at most
+// a gas price.
+type MaxGasPriceAllowance struct {
+	// allowance can be any of basic and periodic fee allowance.
+	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// max_gas_price is the maximum gas price of the transactions, their fees
+	// must be paid in its denoms.
+	MaxGasPrice github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=max_gas_price,json=maxGasPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"max_gas_price"`
+}
+
+func (m *MaxGasPriceAllowance) Reset()         { *m = MaxGasPriceAllowance{} }
+func (m *MaxGasPriceAllowance) String() string { return proto.CompactTextString(m) }
+func (*MaxGasPriceAllowance) ProtoMessage()    {}
+func (*MaxGasPriceAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{3}
+}
+func (m *MaxGasPriceAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxGasPriceAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxGasPriceAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaxGasPriceAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxGasPriceAllowance.Merge(m, src)
+}
+func (m *MaxGasPriceAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxGasPriceAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxGasPriceAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxGasPriceAllowance proto.InternalMessageInfo
+
+// AllowedAddressAllowance creates allowance only for transactions whose
+// messages all target allowed addresses, such as contracts. A message targets
+// the addresses of its top level fields which are not its signers, e.g. the
+// recipient of a send.
+type AllowedAddressAllowance struct {
+	// allowance can be any of basic and periodic fee allowance.
+	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// allowed_addresses are the addresses the messages can target.
+	AllowedAddresses []string `protobuf:"bytes,2,rep,name=allowed_addresses,json=allowedAddresses,proto3" json:"allowed_addresses,omitempty"`
+}
+
+func (m *AllowedAddressAllowance) Reset()         { *m = AllowedAddressAllowance{} }
+func (m *AllowedAddressAllowance) String() string { return proto.CompactTextString(m) }
+func (*AllowedAddressAllowance) ProtoMessage()    {}
+func (*AllowedAddressAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *AllowedAddressAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedAddressAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedAddressAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedAddressAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedAddressAllowance.Merge(m, src)
+}
+func (m *AllowedAddressAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedAddressAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedAddressAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedAddressAllowance proto.InternalMessageInfo
+
+// PerBlockAllowance creates allowance with a limit of the fees spent in every
+// block.
+type PerBlockAllowance struct {
+	// allowance can be any of basic and periodic fee allowance.
+	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// block_spend_limit specifies the maximum amount of coins that can be spent
+	// in a block
+	BlockSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=block_spend_limit,json=blockSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_spend_limit"`
+	// block_can_spend is the number of coins left to be spent in the block at
+	// block_height
+	BlockCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=block_can_spend,json=blockCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_can_spend"`
+	// block_height is the height of the block of the last transaction
+	BlockHeight int64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *PerBlockAllowance) Reset()         { *m = PerBlockAllowance{} }
+func (m *PerBlockAllowance) String() string { return proto.CompactTextString(m) }
+func (*PerBlockAllowance) ProtoMessage()    {}
+func (*PerBlockAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{5}
+}
+func (m *PerBlockAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerBlockAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerBlockAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerBlockAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerBlockAllowance.Merge(m, src)
+}
+func (m *PerBlockAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerBlockAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerBlockAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerBlockAllowance proto.InternalMessageInfo
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -232,7 +366,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{6}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,6 +420,9 @@ func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*MaxGasPriceAllowance)(nil), "cosmos.feegrant.v1beta1.MaxGasPriceAllowance")
+	proto.RegisterType((*AllowedAddressAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedAddressAllowance")
+	proto.RegisterType((*PerBlockAllowance)(nil), "cosmos.feegrant.v1beta1.PerBlockAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
 }
 
@@ -294,44 +431,50 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x9b, 0xa6, 0x90, 0x0b, 0x94, 0xd6, 0x04, 0xe1, 0x64, 0x70, 0xa2, 0x0e, 0x34, 0x0c,
-	0xb1, 0x69, 0xd8, 0xca, 0x42, 0x1c, 0xa0, 0x42, 0xa2, 0x12, 0x72, 0x99, 0x58, 0xa2, 0xb3, 0xfd,
-	0x6a, 0x4e, 0xc4, 0x3e, 0xcb, 0x77, 0x81, 0xe6, 0x1b, 0x30, 0x76, 0x64, 0x42, 0xcc, 0xcc, 0x15,
-	0x9f, 0xa1, 0x62, 0xaa, 0x60, 0x61, 0xa2, 0x28, 0xf9, 0x22, 0xc8, 0x77, 0xe7, 0x24, 0x24, 0xfc,
-	0x13, 0xea, 0x14, 0xdf, 0xbb, 0xf7, 0xfb, 0xf7, 0xde, 0x29, 0xe8, 0x96, 0x4f, 0x59, 0x44, 0x99,
-	0x7d, 0x08, 0x10, 0xa6, 0x38, 0xe6, 0xf6, 0xab, 0x1d, 0x0f, 0x38, 0xde, 0x99, 0x16, 0xac, 0x24,
-	0xa5, 0x9c, 0xea, 0x37, 0x65, 0x9f, 0x35, 0x2d, 0xab, 0xbe, 0x7a, 0x35, 0xa4, 0x21, 0x15, 0x3d,
-	0x76, 0xf6, 0x25, 0xdb, 0xeb, 0xb5, 0x90, 0xd2, 0x70, 0x00, 0xb6, 0x38, 0x79, 0xc3, 0x43, 0x1b,
-	0xc7, 0xa3, 0xfc, 0x4a, 0x32, 0xf5, 0x25, 0x46, 0xd1, 0xca, 0x2b, 0x53, 0x99, 0xf1, 0x30, 0x83,
-	0xa9, 0x11, 0x9f, 0x92, 0x58, 0xdd, 0x37, 0x16, 0x59, 0x39, 0x89, 0x80, 0x71, 0x1c, 0x25, 0x39,
-	0xc1, 0x62, 0x43, 0x30, 0x4c, 0x31, 0x27, 0x54, 0x11, 0x6c, 0x7d, 0xd1, 0xd0, 0xba, 0x83, 0x19,
-	0xf1, 0xbb, 0x83, 0x01, 0x7d, 0x8d, 0x63, 0x1f, 0xf4, 0x01, 0xaa, 0xb0, 0x04, 0xe2, 0xa0, 0x3f,
-	0x20, 0x11, 0xe1, 0x86, 0xd6, 0x2c, 0xb6, 0x2a, 0x9d, 0x9a, 0xa5, 0x7c, 0x65, 0x4e, 0xf2, 0xa8,
-	0x56, 0x8f, 0x92, 0xd8, 0xb9, 0x73, 0xfa, 0xad, 0x51, 0xf8, 0x70, 0xde, 0x68, 0x85, 0x84, 0xbf,
-	0x18, 0x7a, 0x96, 0x4f, 0x23, 0x15, 0x42, 0xfd, 0xb4, 0x59, 0xf0, 0xd2, 0xe6, 0xa3, 0x04, 0x98,
-	0x00, 0x30, 0x17, 0x09, 0xfe, 0x27, 0x19, 0xbd, 0x7e, 0x1f, 0x21, 0x38, 0x4a, 0x88, 0x34, 0x65,
-	0xac, 0x34, 0xb5, 0x56, 0xa5, 0x53, 0xb7, 0xa4, 0x6b, 0x2b, 0x77, 0x6d, 0x3d, 0xcb, 0x63, 0x39,
-	0xab, 0xc7, 0xe7, 0x0d, 0xcd, 0x9d, 0xc3, 0xec, 0x6e, 0x7e, 0x3a, 0x69, 0x5f, 0x7d, 0x04, 0x30,
-	0x4d, 0xf0, 0x78, 0x6b, 0x52, 0x44, 0x9b, 0x4f, 0x21, 0x25, 0x34, 0x98, 0x0f, 0xd6, 0x43, 0x25,
-	0x2f, 0x8b, 0x6a, 0x68, 0x42, 0x65, 0xdb, 0xfa, 0xcd, 0x06, 0xad, 0x9f, 0x07, 0xe2, 0xac, 0x66,
-	0x01, 0x5d, 0x89, 0xd5, 0xef, 0xa1, 0xb5, 0x44, 0x30, 0x2b, 0xaf, 0xb5, 0x25, 0xaf, 0x0f, 0xd4,
-	0x84, 0x9d, 0xcb, 0x19, 0xee, 0x6d, 0x66, 0x57, 0x41, 0xf4, 0x11, 0xd2, 0xe5, 0x57, 0x7f, 0x7e,
-	0xc2, 0xc5, 0x8b, 0x9f, 0xf0, 0x86, 0x94, 0x39, 0x98, 0xcd, 0x79, 0x88, 0x54, 0xad, 0xef, 0xe3,
-	0x58, 0xca, 0x1b, 0xab, 0x17, 0x2f, 0xbc, 0x2e, 0x45, 0x7a, 0x38, 0x16, 0xda, 0xfa, 0x1e, 0xba,
-	0xa2, 0x64, 0x53, 0x60, 0xc0, 0x8d, 0xd2, 0x5f, 0x17, 0x2c, 0xa6, 0x26, 0x96, 0x5c, 0x91, 0x48,
-	0x37, 0x03, 0xfe, 0x6a, 0xcb, 0xef, 0x34, 0x74, 0x5d, 0x1c, 0x21, 0xd8, 0x67, 0xe1, 0x6c, 0xcf,
-	0x0f, 0x51, 0x19, 0xe7, 0x07, 0xb5, 0xeb, 0xea, 0x92, 0x60, 0x37, 0x1e, 0x39, 0xcb, 0x9c, 0xee,
-	0x0c, 0xa9, 0xdf, 0x46, 0x1b, 0x58, 0xb2, 0xf7, 0x23, 0x60, 0x0c, 0x87, 0xc0, 0x8c, 0x95, 0x66,
-	0xb1, 0x55, 0x76, 0xaf, 0xa9, 0xfa, 0xbe, 0x2a, 0xef, 0xde, 0x78, 0xf3, 0xbe, 0x51, 0x58, 0x36,
-	0xf8, 0x51, 0x43, 0xa5, 0xbd, 0xec, 0x65, 0xe9, 0x1d, 0x74, 0x49, 0x3c, 0x31, 0x48, 0x85, 0xa1,
-	0xb2, 0x63, 0x7c, 0x3e, 0x69, 0x57, 0xd5, 0xdc, 0xbb, 0x41, 0x90, 0x02, 0x63, 0x07, 0x3c, 0x25,
-	0x71, 0xe8, 0xe6, 0x8d, 0x33, 0x0c, 0x88, 0xa7, 0xf6, 0x0f, 0x98, 0x85, 0xe8, 0xc5, 0xff, 0x8d,
-	0xee, 0x74, 0x4f, 0xc7, 0xa6, 0x76, 0x36, 0x36, 0xb5, 0xef, 0x63, 0x53, 0x3b, 0x9e, 0x98, 0x85,
-	0xb3, 0x89, 0x59, 0xf8, 0x3a, 0x31, 0x0b, 0xcf, 0xb7, 0xff, 0xf8, 0x12, 0x8e, 0xa6, 0x7f, 0x92,
-	0xde, 0x9a, 0x90, 0xbb, 0xfb, 0x23, 0x00, 0x00, 0xff, 0xff, 0x41, 0xd8, 0x15, 0xc6, 0x4f, 0x05,
-	0x00, 0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbf, 0x4f, 0xdb, 0x40,
+	0x14, 0x8e, 0x93, 0x40, 0xcb, 0x33, 0x3f, 0x5d, 0x24, 0x0c, 0xaa, 0x9c, 0x34, 0x43, 0x49, 0x85,
+	0x6a, 0x97, 0xb0, 0xb5, 0x4b, 0x63, 0x90, 0xe8, 0x50, 0x24, 0xe4, 0x76, 0xea, 0x12, 0x9d, 0xed,
+	0xc3, 0x9c, 0x88, 0x7d, 0x96, 0xcf, 0x29, 0x49, 0xa7, 0x8e, 0x1d, 0x19, 0x3b, 0x76, 0xee, 0x5f,
+	0xd1, 0xad, 0x8c, 0x8c, 0x9d, 0xa0, 0x82, 0x7f, 0xa4, 0xf2, 0xdd, 0xd9, 0x31, 0xd0, 0x1f, 0x48,
+	0xd0, 0x29, 0xbe, 0x77, 0xef, 0x7d, 0xdf, 0x7b, 0xdf, 0x97, 0x67, 0xc3, 0x63, 0x8f, 0xb2, 0x90,
+	0x32, 0x6b, 0x0f, 0xe3, 0x20, 0x41, 0x51, 0x6a, 0xbd, 0x5f, 0x77, 0x71, 0x8a, 0xd6, 0x8b, 0x80,
+	0x19, 0x27, 0x34, 0xa5, 0xda, 0x92, 0xc8, 0x33, 0x8b, 0xb0, 0xcc, 0x5b, 0x59, 0x0c, 0x68, 0x40,
+	0x79, 0x8e, 0x95, 0x3d, 0x89, 0xf4, 0x95, 0xe5, 0x80, 0xd2, 0xa0, 0x8f, 0x2d, 0x7e, 0x72, 0x07,
+	0x7b, 0x16, 0x8a, 0x46, 0xf2, 0xca, 0x90, 0x8c, 0x2e, 0x62, 0xb8, 0x60, 0xf3, 0x28, 0x89, 0xe4,
+	0x7d, 0xe3, 0x6a, 0x69, 0x4a, 0x42, 0xcc, 0x52, 0x14, 0xc6, 0x39, 0xc0, 0xd5, 0x04, 0x7f, 0x90,
+	0xa0, 0x94, 0x50, 0x09, 0xd0, 0xfa, 0xa6, 0xc0, 0xac, 0x8d, 0x18, 0xf1, 0xba, 0xfd, 0x3e, 0x3d,
+	0x44, 0x91, 0x87, 0xb5, 0x3e, 0xa8, 0x2c, 0xc6, 0x91, 0xdf, 0xeb, 0x93, 0x90, 0xa4, 0xba, 0xd2,
+	0xac, 0xb5, 0xd5, 0xce, 0xb2, 0x29, 0x67, 0xca, 0x3a, 0xc9, 0xe7, 0x31, 0x37, 0x29, 0x89, 0xec,
+	0x67, 0xc7, 0xa7, 0x8d, 0xca, 0xd7, 0xb3, 0x46, 0x3b, 0x20, 0xe9, 0xfe, 0xc0, 0x35, 0x3d, 0x1a,
+	0x5a, 0xb2, 0x6d, 0xf1, 0xf3, 0x94, 0xf9, 0x07, 0x56, 0x3a, 0x8a, 0x31, 0xe3, 0x05, 0xcc, 0x01,
+	0x8e, 0xff, 0x3a, 0x83, 0xd7, 0x5e, 0x02, 0xe0, 0x61, 0x4c, 0x44, 0x53, 0x7a, 0xb5, 0xa9, 0xb4,
+	0xd5, 0xce, 0x8a, 0x29, 0xba, 0x36, 0xf3, 0xae, 0xcd, 0xb7, 0xf9, 0x58, 0x76, 0xfd, 0xe8, 0xac,
+	0xa1, 0x38, 0xa5, 0x9a, 0xd6, 0x49, 0x0d, 0x16, 0x76, 0x71, 0x42, 0xa8, 0x5f, 0x9e, 0x62, 0x13,
+	0x26, 0xdc, 0x6c, 0x2e, 0x5d, 0xe1, 0x90, 0xab, 0xe6, 0x1f, 0x3c, 0x31, 0x2f, 0x4f, 0x6f, 0xd7,
+	0xb3, 0x69, 0x1c, 0x51, 0xab, 0xbd, 0x80, 0xc9, 0x98, 0x23, 0xcb, 0xc6, 0x96, 0xaf, 0x35, 0xb6,
+	0x25, 0xe5, 0xb4, 0xef, 0x67, 0x75, 0x9f, 0xb3, 0xde, 0x64, 0x89, 0x36, 0x02, 0x4d, 0x3c, 0xf5,
+	0xca, 0x72, 0xd6, 0xee, 0x5e, 0xce, 0x79, 0x41, 0xf3, 0x66, 0x2c, 0xea, 0x00, 0x64, 0xac, 0xe7,
+	0xa1, 0x48, 0xd0, 0xeb, 0xf5, 0xbb, 0x27, 0x9e, 0x15, 0x24, 0x9b, 0x28, 0xe2, 0xdc, 0xda, 0x36,
+	0x4c, 0x4b, 0xda, 0x04, 0x33, 0x9c, 0xea, 0x13, 0xff, 0x74, 0x93, 0xab, 0xc6, 0x1d, 0x55, 0x45,
+	0xa5, 0x93, 0x15, 0xb6, 0x3e, 0xc0, 0x03, 0xee, 0x08, 0xf6, 0x77, 0x58, 0x30, 0xf6, 0xb4, 0x03,
+	0x53, 0x28, 0x3f, 0x48, 0x5f, 0x17, 0xaf, 0x81, 0x77, 0xa3, 0x91, 0x33, 0x4e, 0xd3, 0x9e, 0xc0,
+	0x3c, 0x12, 0x50, 0xbd, 0x10, 0x33, 0x86, 0x02, 0xcc, 0xf4, 0x6a, 0xb3, 0xd6, 0x9e, 0x72, 0xe6,
+	0x64, 0x7c, 0x47, 0x86, 0x9f, 0xd7, 0x3f, 0x7d, 0x69, 0x54, 0x5a, 0xdf, 0x15, 0x58, 0xdc, 0x41,
+	0xc3, 0x6d, 0xc4, 0x76, 0x13, 0xe2, 0xe1, 0xdb, 0xb1, 0x0f, 0x60, 0x26, 0x44, 0xc3, 0x5e, 0x80,
+	0x58, 0x2f, 0xce, 0xd0, 0x38, 0xb5, 0xda, 0x79, 0xf8, 0x5b, 0x17, 0xb6, 0xb0, 0xc7, 0x8d, 0xd8,
+	0x90, 0x46, 0xac, 0xdd, 0xc0, 0x08, 0x59, 0xc3, 0x1c, 0x35, 0x1c, 0xf7, 0x2c, 0x27, 0xf9, 0xa8,
+	0xc0, 0x92, 0x94, 0xb1, 0xeb, 0xfb, 0x09, 0x66, 0xec, 0x76, 0xc3, 0xac, 0xc1, 0x42, 0x2e, 0x25,
+	0x12, 0x78, 0x85, 0x96, 0xf3, 0xe8, 0x12, 0x4f, 0x21, 0xe6, 0x69, 0x95, 0xef, 0xa6, 0xdd, 0xa7,
+	0xde, 0xc1, 0xed, 0xc8, 0x0f, 0x61, 0xc1, 0xcd, 0x50, 0x2e, 0x2d, 0x53, 0xf5, 0xee, 0xff, 0xd3,
+	0x73, 0x9c, 0xa5, 0xb4, 0x4b, 0x0c, 0x44, 0xa8, 0xb4, 0x4a, 0xff, 0x61, 0x87, 0x67, 0x38, 0x47,
+	0xb1, 0x49, 0x8f, 0x60, 0x5a, 0x90, 0xee, 0x63, 0x12, 0xec, 0xa7, 0x7a, 0xbd, 0xa9, 0xb4, 0x6b,
+	0x8e, 0xca, 0x63, 0xaf, 0x78, 0x48, 0x0a, 0x4c, 0x61, 0x62, 0x3b, 0x7b, 0x9d, 0x69, 0x3a, 0xdc,
+	0xe3, 0xef, 0x35, 0x9c, 0x70, 0x45, 0xa7, 0x9c, 0xfc, 0x38, 0xbe, 0xc1, 0x7a, 0xb5, 0x7c, 0x73,
+	0xc5, 0x87, 0xda, 0x8d, 0x7c, 0xb0, 0xbb, 0xc7, 0xe7, 0x86, 0x72, 0x72, 0x6e, 0x28, 0x3f, 0xcf,
+	0x0d, 0xe5, 0xe8, 0xc2, 0xa8, 0x9c, 0x5c, 0x18, 0x95, 0x1f, 0x17, 0x46, 0xe5, 0xdd, 0xea, 0x5f,
+	0x87, 0x1d, 0x16, 0x1f, 0x49, 0x77, 0x92, 0x63, 0x6f, 0xfc, 0x1a, 0x00, 0xe0, 0x35, 0x9e, 0xb0,
+	0x4f, 0x07, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -502,6 +645,167 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaxGasPriceAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxGasPriceAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxGasPriceAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxGasPrice) > 0 {
+		for iNdEx := len(m.MaxGasPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxGasPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedAddressAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedAddressAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedAddressAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedAddresses) > 0 {
+		for iNdEx := len(m.AllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowedAddresses[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PerBlockAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerBlockAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerBlockAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BlockCanSpend) > 0 {
+		for iNdEx := len(m.BlockCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BlockSpendLimit) > 0 {
+		for iNdEx := len(m.BlockSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -627,36 +931,102 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
-func (m *Grant) Size() (n int) {
+func (m *MaxGasPriceAllowance) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
 		n += 1 + l + sovFeegrant(uint64(l))
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.MaxGasPrice) > 0 {
+		for _, e := range m.MaxGasPrice {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowedAddressAllowance) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	if m.Allowance != nil {
 		l = m.Allowance.Size()
 		n += 1 + l + sovFeegrant(uint64(l))
 	}
+	if len(m.AllowedAddresses) > 0 {
+		for _, s := range m.AllowedAddresses {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovFeegrant(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFeegrant(x uint64) (n int) {
-	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *PerBlockAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.BlockSpendLimit) > 0 {
+		for _, e := range m.BlockSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.BlockCanSpend) > 0 {
+		for _, e := range m.BlockCanSpend {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovFeegrant(uint64(m.BlockHeight))
+	}
+	return n
 }
-func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
+func sovFeegrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeegrant(x uint64) (n int) {
+	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -1109,6 +1479,417 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MaxGasPriceAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxGasPriceAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxGasPriceAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxGasPrice = append(m.MaxGasPrice, types.DecCoin{})
+			if err := m.MaxGasPrice[len(m.MaxGasPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedAddressAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedAddressAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedAddressAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAddresses = append(m.AllowedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerBlockAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerBlockAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerBlockAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockSpendLimit = append(m.BlockSpendLimit, types.Coin{})
+			if err := m.BlockSpendLimit[len(m.BlockSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockCanSpend = append(m.BlockCanSpend, types.Coin{})
+			if err := m.BlockCanSpend[len(m.BlockCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
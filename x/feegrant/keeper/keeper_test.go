@@ -450,3 +450,42 @@ func (suite *KeeperTestSuite) TestPruneGrants() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestPruneWrappedGrants() {
+	now := suite.sdkCtx.BlockTime()
+	basic := &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &now,
+	}
+
+	maxGasPrice, err := feegrant.NewMaxGasPriceAllowance(basic, sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 2))))
+	suite.Require().NoError(err)
+	allowedAddress, err := feegrant.NewAllowedAddressAllowance(basic, []string{suite.addrs[3].String()})
+	suite.Require().NoError(err)
+	perBlock, err := feegrant.NewPerBlockAllowance(basic, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	suite.Require().NoError(err)
+
+	allowances := []feegrant.FeeAllowanceI{maxGasPrice, allowedAddress, perBlock}
+	for i, allowance := range allowances {
+		err := suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[i+1], allowance)
+		suite.Require().NoError(err)
+	}
+
+	resp, err := suite.keeper.AllowancesByGranter(suite.ctx, &feegrant.QueryAllowancesByGranterRequest{
+		Granter: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Allowances, len(allowances))
+	for i, grant := range resp.Allowances {
+		allowance, err := grant.GetGrant()
+		suite.Require().NoError(err)
+		suite.Require().Equal(allowances[i], allowance)
+	}
+
+	suite.app.FeeGrantKeeper.RemoveExpiredAllowances(suite.sdkCtx.WithBlockTime(now.AddDate(0, 0, 1)))
+	for i := range allowances {
+		_, err := suite.keeper.GetAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[i+1])
+		suite.Require().Error(err)
+		suite.Require().Contains(err.Error(), "not found")
+	}
+}
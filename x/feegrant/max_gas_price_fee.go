@@ -0,0 +1,104 @@
+package feegrant
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                 = (*MaxGasPriceAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*MaxGasPriceAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *MaxGasPriceAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewMaxGasPriceAllowance creates new fee allowance limiting the gas price of
+// the transactions.
+func NewMaxGasPriceAllowance(allowance FeeAllowanceI, maxGasPrice sdk.DecCoins) (*MaxGasPriceAllowance, error) {
+	any, err := packAllowance(allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MaxGasPriceAllowance{
+		Allowance:   any,
+		MaxGasPrice: maxGasPrice,
+	}, nil
+}
+
+// GetAllowance returns the wrapped fee allowance.
+func (a *MaxGasPriceAllowance) GetAllowance() (FeeAllowanceI, error) {
+	return unpackAllowance(a.Allowance)
+}
+
+// SetAllowance sets the wrapped fee allowance.
+func (a *MaxGasPriceAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	any, err := packAllowance(allowance)
+	if err != nil {
+		return err
+	}
+
+	a.Allowance = any
+	return nil
+}
+
+// Accept method checks the gas price of the transaction, which is its fee
+// divided by its gas limit, before the wrapped allowance.
+func (a *MaxGasPriceAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	// the gas meter of the transaction is limited by its gas limit
+	gasLimit := sdk.NewDecFromInt(sdk.NewIntFromUint64(ctx.GasMeter().Limit()))
+	for _, coin := range fee {
+		maxFee := a.MaxGasPrice.AmountOf(coin.Denom).Mul(gasLimit)
+		if sdk.NewDecFromInt(coin.Amount).GT(maxFee) {
+			return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "gas price of %s exceeds max gas price %s", coin, a.MaxGasPrice)
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *MaxGasPriceAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if a.MaxGasPrice.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "max gas price shouldn't be empty")
+	}
+	if !a.MaxGasPrice.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "max gas price: %s", a.MaxGasPrice)
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+func (a *MaxGasPriceAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
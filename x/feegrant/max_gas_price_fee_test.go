@@ -0,0 +1,118 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	ocproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestMaxGasPriceFeeValidAllow(t *testing.T) {
+	app := simapp.Setup(t, false)
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	maxGasPrice := sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 2)))
+
+	// msg we will call in the all cases
+	call := banktypes.MsgSend{}
+	cases := map[string]struct {
+		allowance   *feegrant.BasicAllowance
+		maxGasPrice sdk.DecCoins
+		gasLimit    uint64
+		fee         sdk.Coins
+		accept      bool
+		remove      bool
+		remains     sdk.Coins
+	}{
+		"gas price below max": {
+			allowance:   &feegrant.BasicAllowance{},
+			maxGasPrice: maxGasPrice,
+			gasLimit:    1000,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("atom", 5)),
+			accept:      true,
+		},
+		"gas price equal to max": {
+			allowance:   &feegrant.BasicAllowance{},
+			maxGasPrice: maxGasPrice,
+			gasLimit:    1000,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("atom", 10)),
+			accept:      true,
+		},
+		"gas price above max": {
+			allowance:   &feegrant.BasicAllowance{},
+			maxGasPrice: maxGasPrice,
+			gasLimit:    1000,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("atom", 11)),
+			accept:      false,
+		},
+		"fee denom without max gas price": {
+			allowance:   &feegrant.BasicAllowance{},
+			maxGasPrice: maxGasPrice,
+			gasLimit:    1000,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("eth", 1)),
+			accept:      false,
+		},
+		"small fee with spend limit": {
+			allowance: &feegrant.BasicAllowance{
+				SpendLimit: atom,
+			},
+			maxGasPrice: maxGasPrice,
+			gasLimit:    1000,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("atom", 5)),
+			accept:      true,
+			remains:     sdk.NewCoins(sdk.NewInt64Coin("atom", 550)),
+		},
+		"all fee with spend limit": {
+			allowance: &feegrant.BasicAllowance{
+				SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 5)),
+			},
+			maxGasPrice: maxGasPrice,
+			gasLimit:    1000,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("atom", 5)),
+			accept:      true,
+			remove:      true,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			ctx := app.BaseApp.NewContext(false, ocproto.Header{}).WithGasMeter(sdk.NewGasMeter(tc.gasLimit))
+
+			allowance, err := feegrant.NewMaxGasPriceAllowance(tc.allowance, tc.maxGasPrice)
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			removed, err := allowance.Accept(ctx, tc.fee, []sdk.Msg{&call})
+			if !tc.accept {
+				require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remove, removed)
+			if !removed {
+				feeAllowance, err := allowance.GetAllowance()
+				require.NoError(t, err)
+				require.Equal(t, tc.remains, feeAllowance.(*feegrant.BasicAllowance).SpendLimit)
+			}
+		})
+	}
+}
+
+func TestMaxGasPriceFeeValidateBasic(t *testing.T) {
+	invalidGasPrice := sdk.DecCoins{sdk.DecCoin{Denom: "atom", Amount: sdk.NewDec(-1)}}
+
+	allowance, err := feegrant.NewMaxGasPriceAllowance(&feegrant.BasicAllowance{}, nil)
+	require.NoError(t, err)
+	require.Error(t, allowance.ValidateBasic())
+
+	allowance, err = feegrant.NewMaxGasPriceAllowance(&feegrant.BasicAllowance{}, invalidGasPrice)
+	require.NoError(t, err)
+	require.Error(t, allowance.ValidateBasic())
+}
@@ -0,0 +1,112 @@
+package feegrant
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                 = (*PerBlockAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*PerBlockAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *PerBlockAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewPerBlockAllowance creates new fee allowance limiting the fees spent in
+// every block.
+func NewPerBlockAllowance(allowance FeeAllowanceI, blockSpendLimit sdk.Coins) (*PerBlockAllowance, error) {
+	any, err := packAllowance(allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PerBlockAllowance{
+		Allowance:       any,
+		BlockSpendLimit: blockSpendLimit,
+	}, nil
+}
+
+// GetAllowance returns the wrapped fee allowance.
+func (a *PerBlockAllowance) GetAllowance() (FeeAllowanceI, error) {
+	return unpackAllowance(a.Allowance)
+}
+
+// SetAllowance sets the wrapped fee allowance.
+func (a *PerBlockAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	any, err := packAllowance(allowance)
+	if err != nil {
+		return err
+	}
+
+	a.Allowance = any
+	return nil
+}
+
+// Accept method checks the fees spent in the current block before the wrapped
+// allowance. The block limit is reset at the first transaction of every block.
+func (a *PerBlockAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if a.BlockHeight != ctx.BlockHeight() {
+		a.BlockCanSpend = a.BlockSpendLimit
+		a.BlockHeight = ctx.BlockHeight()
+	}
+
+	blockLeft, isNeg := a.BlockCanSpend.SafeSub(fee...)
+	if isNeg {
+		return false, sdkerrors.Wrap(ErrFeeLimitExceeded, "block limit")
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		a.BlockCanSpend = blockLeft
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *PerBlockAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if !a.BlockSpendLimit.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "block spend limit: %s", a.BlockSpendLimit)
+	}
+	if !a.BlockSpendLimit.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "block spend limit must be positive")
+	}
+	if !a.BlockCanSpend.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "block can spend: %s", a.BlockCanSpend)
+	}
+	if a.BlockHeight < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "negative block height")
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+func (a *PerBlockAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
@@ -0,0 +1,97 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	ocproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestPerBlockFeeValidAllow(t *testing.T) {
+	app := simapp.Setup(t, false)
+
+	blockLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 4))
+
+	// msg we will call in the all cases
+	call := banktypes.MsgSend{}
+	allowance, err := feegrant.NewPerBlockAllowance(&feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 20)),
+	}, blockLimit)
+	require.NoError(t, err)
+	require.NoError(t, allowance.ValidateBasic())
+
+	steps := []struct {
+		height    int64
+		fee       sdk.Coins
+		accept    bool
+		remove    bool
+		blockLeft sdk.Coins
+	}{
+		{height: 1, fee: smallAtom, accept: true, blockLeft: sdk.NewCoins(sdk.NewInt64Coin("atom", 6))},
+		{height: 1, fee: smallAtom, accept: true, blockLeft: sdk.NewCoins(sdk.NewInt64Coin("atom", 2))},
+		{height: 1, fee: smallAtom, accept: false},
+		{height: 1, fee: sdk.NewCoins(sdk.NewInt64Coin("eth", 1)), accept: false},
+		// the block limit is reset at the next block
+		{height: 2, fee: blockLimit, accept: true, blockLeft: sdk.Coins{}},
+		{height: 3, fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 11)), accept: false},
+		// the spend limit is exhausted
+		{height: 3, fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 2)), accept: true, remove: true},
+	}
+
+	for i, step := range steps {
+		ctx := app.BaseApp.NewContext(false, ocproto.Header{Height: step.height})
+
+		removed, err := allowance.Accept(ctx, step.fee, []sdk.Msg{&call})
+		if !step.accept {
+			require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded, "step %d", i)
+			continue
+		}
+		require.NoError(t, err, "step %d", i)
+		require.Equal(t, step.remove, removed, "step %d", i)
+		if !removed {
+			require.Equal(t, step.height, allowance.BlockHeight, "step %d", i)
+			require.True(t, step.blockLeft.IsEqual(allowance.BlockCanSpend), "step %d", i)
+		}
+	}
+}
+
+func TestPerBlockFeeValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		blockLimit sdk.Coins
+		valid      bool
+	}{
+		"valid block limit": {
+			blockLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 10)),
+			valid:      true,
+		},
+		"empty block limit": {
+			blockLimit: sdk.Coins{},
+			valid:      false,
+		},
+		"zero block limit": {
+			blockLimit: sdk.Coins{sdk.NewInt64Coin("atom", 0)},
+			valid:      false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewPerBlockAllowance(&feegrant.BasicAllowance{}, tc.blockLimit)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
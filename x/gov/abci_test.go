@@ -38,6 +38,7 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
 	)
 	require.NoError(t, err)
 
@@ -91,6 +92,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
 	)
 	require.NoError(t, err)
 
@@ -115,6 +117,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
 	)
 	require.NoError(t, err)
 
@@ -173,6 +176,7 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
 	)
 	require.NoError(t, err)
 
@@ -225,7 +229,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	activeQueue.Close()
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 5))}
-	newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), "")
+	newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), "", false)
 	require.NoError(t, err)
 
 	wrapCtx := sdk.WrapSDKContext(ctx)
@@ -293,7 +297,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", addrs[0], false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -341,7 +345,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
 	msg := banktypes.NewMsgSend(authtypes.NewModuleAddress(types.ModuleName), addrs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000))))
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", addrs[0], false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -367,6 +371,66 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.Equal(t, v1.StatusFailed, proposal.Status)
 }
 
+func TestEndBlockerExpeditedProposalConversion(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens.MulRaw(2))
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", addrs[0], true)
+	require.NoError(t, err)
+	require.True(t, proposal.Expedited)
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], depositParams.MinDeposit)
+	require.NoError(t, err)
+	require.False(t, votingStarted, "the minimum deposit of regular proposals must not activate expedited ones")
+
+	votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], depositParams.ExpeditedMinDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	err = app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), "")
+	require.NoError(t, err)
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, proposal.VotingStartBlock+votingParams.ExpeditedVotingPeriod, proposal.VotingEndBlock)
+
+	// the failed expedited proposal is converted into a regular one
+	ctx = ctx.WithBlockHeight(int64(proposal.VotingEndBlock))
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	require.False(t, proposal.Expedited)
+	require.Equal(t, proposal.VotingStartBlock+votingParams.VotingPeriod, proposal.VotingEndBlock)
+
+	_, ok = app.GovKeeper.GetVote(ctx, proposal.Id, addrs[0])
+	require.True(t, ok, "the votes of a converted proposal must be kept")
+
+	// the regular proposal is rejected at the end of its voting period
+	ctx = ctx.WithBlockHeight(int64(proposal.VotingEndBlock))
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusRejected, proposal.Status)
+
+	_, ok = app.GovKeeper.GetVote(ctx, proposal.Id, addrs[0])
+	require.False(t, ok)
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
@@ -38,6 +38,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryVotingPowers(),
 	)
 
 	return govQueryCmd
@@ -508,21 +509,23 @@ $ %s query gov tally 1
 	return cmd
 }
 
-// GetCmdQueryParams implements the query params command.
-func GetCmdQueryParams() *cobra.Command {
+// GetCmdQueryVotingPowers implements the query voting powers command.
+func GetCmdQueryVotingPowers() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "params",
-		Short: "Query the parameters of the governance process",
+		Use:   "voting-powers [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the voting power of the voters of a proposal",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query the all the parameters for the governance process.
+			fmt.Sprintf(`Query the voting power of the voters of a proposal per voting
+power source, as counted by its tally. You can find the proposal-id by running
+"%s query gov proposals".
 
 Example:
-$ %s query gov params
+$ %s query gov voting-powers 1
 `,
-				version.AppName,
+				version.AppName, version.AppName,
 			),
 		),
-		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -530,39 +533,60 @@ $ %s query gov params
 			}
 			queryClient := v1.NewQueryClient(clientCtx)
 
-			// Query store for all 3 params
-			ctx := cmd.Context()
-			votingRes, err := queryClient.Params(
-				ctx,
-				&v1.QueryParamsRequest{ParamsType: "voting"},
-			)
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
-				return err
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
 			}
 
-			tallyRes, err := queryClient.Params(
-				ctx,
-				&v1.QueryParamsRequest{ParamsType: "tallying"},
+			res, err := queryClient.VotingPowers(
+				cmd.Context(),
+				&v1.QueryVotingPowersRequest{ProposalId: proposalID},
 			)
 			if err != nil {
 				return err
 			}
 
-			depositRes, err := queryClient.Params(
-				ctx,
-				&v1.QueryParamsRequest{ParamsType: "deposit"},
-			)
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the parameters of the governance process",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the all the parameters for the governance process.
+
+Example:
+$ %s query gov params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
+			queryClient := v1.NewQueryClient(clientCtx)
 
-			params := v1.NewParams(
-				*votingRes.GetVotingParams(),
-				*tallyRes.GetTallyParams(),
-				*depositRes.GetDepositParams(),
+			res, err := queryClient.Params(
+				cmd.Context(),
+				&v1.QueryParamsRequest{ParamsType: v1.ParamAll},
 			)
+			if err != nil {
+				return err
+			}
 
-			return clientCtx.PrintObjectLegacy(params)
+			return clientCtx.PrintProto(res.Params)
 		},
 	}
 
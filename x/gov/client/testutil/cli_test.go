@@ -15,8 +15,11 @@ func TestIntegrationTestSuite(t *testing.T) {
 	cfg.NumValidators = 1
 	suite.Run(t, NewIntegrationTestSuite(cfg))
 
-	dp := v1.NewDepositParams(sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, v1.DefaultMinDepositTokens)), 3)
-	vp := v1.NewVotingParams(1)
+	dp := v1.NewDepositParams(
+		sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, v1.DefaultMinDepositTokens)), 3,
+		sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, v1.DefaultMinExpeditedDepositTokens)), v1.DefaultProposalCancelRatio,
+	)
+	vp := v1.NewVotingParams(2, 1)
 	genesisState := v1.DefaultGenesisState()
 	genesisState.DepositParams = &dp
 	genesisState.VotingParams = &vp
@@ -131,7 +131,8 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	minDeposit := proposal.GetMinDepositFromParams(keeper.GetDepositParams(ctx))
+	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(minDeposit) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -178,3 +179,41 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 		return false
 	})
 }
+
+// ChargeDeposits burns the given ratio of all the deposits on a specific
+// proposal, rounded down, refunds the rest to the depositors and deletes the
+// deposits.
+func (keeper Keeper) ChargeDeposits(ctx sdk.Context, proposalID uint64, burnRatio sdk.Dec) error {
+	store := ctx.KVStore(keeper.storeKey)
+
+	var err error
+	burned := sdk.NewCoins()
+	keeper.IterateDeposits(ctx, proposalID, func(deposit v1.Deposit) bool {
+		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+
+		refund := sdk.NewCoins()
+		for _, coin := range deposit.Amount {
+			burnAmount := sdk.NewDecFromInt(coin.Amount).Mul(burnRatio).TruncateInt()
+			burned = burned.Add(sdk.NewCoin(coin.Denom, burnAmount))
+			refund = refund.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(burnAmount)))
+		}
+
+		if !refund.IsZero() {
+			err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refund)
+			if err != nil {
+				return true
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	if !burned.IsZero() {
+		return keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, burned)
+	}
+	return nil
+}
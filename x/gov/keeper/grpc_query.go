@@ -172,6 +172,10 @@ func (q Keeper) Params(c context.Context, req *v1.QueryParamsRequest) (*v1.Query
 		tallyParams := q.GetTallyParams(ctx)
 		return &v1.QueryParamsResponse{TallyParams: &tallyParams}, nil
 
+	case v1.ParamAll:
+		params := q.GetParams(ctx)
+		return &v1.QueryParamsResponse{Params: &params}, nil
+
 	default:
 		return nil, status.Errorf(codes.InvalidArgument,
 			"%s is not a valid parameter type", req.ParamsType)
@@ -273,6 +277,30 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// VotingPowers queries the voting power of the voters of a proposal
+func (q Keeper) VotingPowers(c context.Context, req *v1.QueryVotingPowersRequest) (*v1.QueryVotingPowersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, ok := q.GetProposal(ctx, req.ProposalId); !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	votingPowers, totalVotingPower := q.GetVotingPowers(ctx, req.ProposalId)
+
+	return &v1.QueryVotingPowersResponse{
+		VotingPowers:     votingPowers,
+		TotalVotingPower: totalVotingPower.String(),
+	}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct {
@@ -17,7 +17,8 @@ import (
 
 // Keeper defines the governance module Keeper
 type Keeper struct {
-	// The reference to the Paramstore to get and set gov specific params
+	// The reference to the legacy Paramstore, only used to migrate the gov
+	// params to the gov store
 	paramSpace types.ParamSubspace
 
 	authKeeper types.AccountKeeper
@@ -29,6 +30,9 @@ type Keeper struct {
 	// GovHooks
 	hooks types.GovHooks
 
+	// The sources of voting power counted by the tally of proposals
+	tallyPowerSources []TallyPowerSource
+
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey storetypes.StoreKey
 
@@ -42,6 +46,10 @@ type Keeper struct {
 	router *baseapp.MsgServiceRouter
 
 	config types.Config
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -55,7 +63,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace types.ParamSubspace,
 	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper,
 	legacyRouter v1beta1.Router, router *baseapp.MsgServiceRouter,
-	config types.Config,
+	config types.Config, authority string,
 ) Keeper {
 	// ensure governance module account is set
 	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -73,18 +81,25 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeKey:     key,
-		paramSpace:   paramSpace,
-		authKeeper:   authKeeper,
-		bankKeeper:   bankKeeper,
-		sk:           sk,
-		cdc:          cdc,
-		legacyRouter: legacyRouter,
-		router:       router,
-		config:       config,
+		storeKey:          key,
+		paramSpace:        paramSpace,
+		authKeeper:        authKeeper,
+		bankKeeper:        bankKeeper,
+		sk:                sk,
+		tallyPowerSources: []TallyPowerSource{NewStakingPowerSource(sk)},
+		cdc:               cdc,
+		legacyRouter:      legacyRouter,
+		router:            router,
+		config:            config,
+		authority:         authority,
 	}
 }
 
+// GetAuthority returns the x/gov module's authority.
+func (keeper Keeper) GetAuthority() string {
+	return keeper.authority
+}
+
 // SetHooks sets the hooks for governance
 func (keeper *Keeper) SetHooks(gh types.GovHooks) *Keeper {
 	if keeper.hooks != nil {
@@ -96,6 +111,26 @@ func (keeper *Keeper) SetHooks(gh types.GovHooks) *Keeper {
 	return keeper
 }
 
+// SetTallyPowerSources sets the sources of voting power counted by the tally
+// of proposals, replacing the default StakingPowerSource.
+func (keeper *Keeper) SetTallyPowerSources(sources ...TallyPowerSource) *Keeper {
+	if len(sources) == 0 {
+		panic("cannot set empty governance tally power sources")
+	}
+
+	names := make(map[string]bool, len(sources))
+	for _, source := range sources {
+		if names[source.Name()] {
+			panic(fmt.Sprintf("duplicate governance tally power source %s", source.Name()))
+		}
+		names[source.Name()] = true
+	}
+
+	keeper.tallyPowerSources = sources
+
+	return keeper
+}
+
 // Logger returns a module-specific logger.
 func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -36,6 +36,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					strings.Repeat("1", 300),
+					false,
 				)
 			},
 			expErr:    true,
@@ -48,6 +49,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr:    true,
@@ -60,6 +62,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr:    true,
@@ -72,6 +75,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr:    true,
@@ -84,6 +88,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr: false,
@@ -95,6 +100,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr: false,
@@ -135,6 +141,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -158,6 +165,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -199,6 +207,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -247,6 +256,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -271,6 +281,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -312,6 +323,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -360,6 +372,7 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 		coins,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -410,6 +423,119 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCancelProposalReq() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      coins,
+	}
+
+	// the proposals submitted with the min deposit enter their voting period
+	depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
+	depositParams.MinDeposit = coins
+	suite.app.GovKeeper.SetDepositParams(suite.ctx, depositParams)
+
+	submitProposal := func() uint64 {
+		msg, err := v1.NewMsgSubmitProposal(
+			[]sdk.Msg{bankMsg},
+			coins,
+			proposer.String(),
+			"",
+			false,
+		)
+		suite.Require().NoError(err)
+
+		res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+		suite.Require().NoError(err)
+		suite.Require().NotNil(res.ProposalId)
+		return res.ProposalId
+	}
+
+	cases := map[string]struct {
+		preRun    func() uint64
+		proposer  sdk.AccAddress
+		expErr    bool
+		expErrMsg string
+	}{
+		"wrong proposal id": {
+			preRun: func() uint64 {
+				return 0
+			},
+			proposer:  proposer,
+			expErr:    true,
+			expErrMsg: "unknown proposal",
+		},
+		"not the proposer": {
+			preRun:    submitProposal,
+			proposer:  addrs[1],
+			expErr:    true,
+			expErrMsg: "is not the proposer",
+		},
+		"proposal not active": {
+			preRun: func() uint64 {
+				proposalID := submitProposal()
+				proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposalID)
+				suite.Require().True(ok)
+				proposal.Status = v1.StatusPassed
+				suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
+				return proposalID
+			},
+			proposer:  proposer,
+			expErr:    true,
+			expErrMsg: "inactive proposal",
+		},
+		"proposal in deposit period": {
+			preRun: func() uint64 {
+				deposit := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10)))
+				msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{bankMsg}, deposit, proposer.String(), "", false)
+				suite.Require().NoError(err)
+				res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+				suite.Require().NoError(err)
+				return res.ProposalId
+			},
+			proposer:  proposer,
+			expErr:    true,
+			expErrMsg: "inactive proposal",
+		},
+		"all good": {
+			preRun:   submitProposal,
+			proposer: proposer,
+			expErr:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			proposalID := tc.preRun()
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, tc.proposer, sdk.DefaultBondDenom)
+
+			res, err := suite.msgSrvr.CancelProposal(suite.ctx, v1.NewMsgCancelProposal(proposalID, tc.proposer.String()))
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(proposalID, res.ProposalId)
+			suite.Require().Equal(uint64(suite.ctx.BlockHeight()), res.CanceledHeight)
+
+			_, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposalID)
+			suite.Require().False(ok)
+			suite.Require().Empty(suite.app.GovKeeper.GetDeposits(suite.ctx, proposalID))
+
+			// half of the deposit is burnt, the rest is refunded
+			refund := sdk.NewCoin(sdk.DefaultBondDenom, coins.AmountOf(sdk.DefaultBondDenom).QuoRaw(2))
+			suite.Require().Equal(balance.Add(refund), suite.app.BankKeeper.GetBalance(suite.ctx, tc.proposer, sdk.DefaultBondDenom))
+		})
+	}
+}
+
 // legacy msg server tests
 func (suite *KeeperTestSuite) TestLegacyMsgSubmitProposal() {
 	addrs := suite.addrs
@@ -478,6 +604,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -501,6 +628,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -532,6 +660,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -580,6 +709,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -604,6 +734,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -635,6 +766,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -683,6 +815,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgDeposit() {
 		coins,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -732,3 +865,94 @@ func (suite *KeeperTestSuite) TestLegacyMsgDeposit() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgUpdateParams() {
+	authority := suite.app.GovKeeper.GetAuthority()
+	params := v1.DefaultParams()
+
+	invalidParams := v1.DefaultParams()
+	invalidParams.TallyParams.Quorum = "2.0"
+
+	slowExpeditedParams := v1.DefaultParams()
+	slowExpeditedParams.VotingParams.ExpeditedVotingPeriod = slowExpeditedParams.VotingParams.VotingPeriod
+
+	lowExpeditedThresholdParams := v1.DefaultParams()
+	lowExpeditedThresholdParams.TallyParams.ExpeditedThreshold = lowExpeditedThresholdParams.TallyParams.Threshold
+
+	lowExpeditedDepositParams := v1.DefaultParams()
+	lowExpeditedDepositParams.DepositParams.ExpeditedMinDeposit = lowExpeditedDepositParams.DepositParams.MinDeposit
+
+	testCases := []struct {
+		name      string
+		req       *v1.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			req: &v1.MsgUpdateParams{
+				Authority: suite.addrs[0].String(),
+				Params:    params,
+			},
+			expErr:    true,
+			expErrMsg: "expected " + authority,
+		},
+		{
+			name: "invalid params",
+			req: &v1.MsgUpdateParams{
+				Authority: authority,
+				Params:    invalidParams,
+			},
+			expErr:    true,
+			expErrMsg: "quorom too large",
+		},
+		{
+			name: "expedited voting period not shorter",
+			req: &v1.MsgUpdateParams{
+				Authority: authority,
+				Params:    slowExpeditedParams,
+			},
+			expErr:    true,
+			expErrMsg: "must be strictly less than the voting period",
+		},
+		{
+			name: "expedited threshold not greater",
+			req: &v1.MsgUpdateParams{
+				Authority: authority,
+				Params:    lowExpeditedThresholdParams,
+			},
+			expErr:    true,
+			expErrMsg: "must be greater than the vote threshold",
+		},
+		{
+			name: "expedited minimum deposit not greater",
+			req: &v1.MsgUpdateParams{
+				Authority: authority,
+				Params:    lowExpeditedDepositParams,
+			},
+			expErr:    true,
+			expErrMsg: "must be greater than the minimum deposit",
+		},
+		{
+			name: "all good",
+			req: &v1.MsgUpdateParams{
+				Authority: authority,
+				Params:    params,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.UpdateParams(suite.ctx, tc.req)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.req.Params, suite.app.GovKeeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
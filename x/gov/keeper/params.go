@@ -2,41 +2,70 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
-// GetDepositParams returns the current DepositParams from the global param store
+// GetParams returns the current gov params from the gov store.
+func (keeper Keeper) GetParams(ctx sdk.Context) (params v1.Params) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the gov params to the gov store. It returns an error if the
+// params are invalid.
+func (keeper Keeper) SetParams(ctx sdk.Context, params v1.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	keeper.setParams(ctx, params)
+	return nil
+}
+
+func (keeper Keeper) setParams(ctx sdk.Context, params v1.Params) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.ParamsKey, keeper.cdc.MustMarshal(&params))
+}
+
+// GetDepositParams returns the current DepositParams from the gov store
 func (keeper Keeper) GetDepositParams(ctx sdk.Context) v1.DepositParams {
-	var depositParams v1.DepositParams
-	keeper.paramSpace.Get(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
-	return depositParams
+	return keeper.GetParams(ctx).DepositParams
 }
 
-// GetVotingParams returns the current VotingParams from the global param store
+// GetVotingParams returns the current VotingParams from the gov store
 func (keeper Keeper) GetVotingParams(ctx sdk.Context) v1.VotingParams {
-	var votingParams v1.VotingParams
-	keeper.paramSpace.Get(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
-	return votingParams
+	return keeper.GetParams(ctx).VotingParams
 }
 
-// GetTallyParams returns the current TallyParam from the global param store
+// GetTallyParams returns the current TallyParam from the gov store
 func (keeper Keeper) GetTallyParams(ctx sdk.Context) v1.TallyParams {
-	var tallyParams v1.TallyParams
-	keeper.paramSpace.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
-	return tallyParams
+	return keeper.GetParams(ctx).TallyParams
 }
 
-// SetDepositParams sets DepositParams to the global param store
+// SetDepositParams sets DepositParams to the gov store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams v1.DepositParams) {
-	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+	params := keeper.GetParams(ctx)
+	params.DepositParams = depositParams
+	keeper.setParams(ctx, params)
 }
 
-// SetVotingParams sets VotingParams to the global param store
+// SetVotingParams sets VotingParams to the gov store
 func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams v1.VotingParams) {
-	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+	params := keeper.GetParams(ctx)
+	params.VotingParams = votingParams
+	keeper.setParams(ctx, params)
 }
 
-// SetTallyParams sets TallyParams to the global param store
+// SetTallyParams sets TallyParams to the gov store
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams v1.TallyParams) {
-	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+	params := keeper.GetParams(ctx)
+	params.TallyParams = tallyParams
+	keeper.setParams(ctx, params)
 }
@@ -10,8 +10,10 @@ import (
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
-// SubmitProposal creates a new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string) (v1.Proposal, error) {
+// SubmitProposal creates a new proposal given an array of messages, an
+// expedited proposal having a shorter voting period and higher deposit and
+// threshold requirements.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -68,7 +70,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	submitBlock := uint64(ctx.BlockHeader().Height)
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal, err := v1.NewProposal(messages, proposalID, metadata, submitBlock, submitBlock+depositPeriod)
+	proposal, err := v1.NewProposal(messages, proposalID, metadata, submitBlock, submitBlock+depositPeriod, proposer, expedited)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
@@ -91,6 +93,47 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	return proposal, nil
 }
 
+// CancelProposal cancels a proposal by its proposer during its voting period.
+// The proposal and its votes are deleted, and the deposits are charged with the
+// proposal cancel ratio.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer string) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Proposer != proposer {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not the proposer of proposal %d", proposer, proposalID)
+	}
+
+	if proposal.Status != v1.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	cancelRatio, err := sdk.NewDecFromStr(keeper.GetDepositParams(ctx).ProposalCancelRatio)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.ChargeDeposits(ctx, proposalID, cancelRatio); err != nil {
+		return err
+	}
+
+	keeper.DeleteVotes(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposalProposer, proposer),
+			sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalCanceled),
+		),
+	)
+
+	return nil
+}
+
 // GetProposal gets a proposal from store by ProposalID.
 // Panics if can't unmarshal the proposal.
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (v1.Proposal, bool) {
@@ -237,7 +280,7 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal) {
 	startBlock := ctx.BlockHeader().Height
 	proposal.VotingStartBlock = uint64(startBlock)
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := proposal.GetVotingPeriodFromParams(keeper.GetVotingParams(ctx))
 	endBlock := proposal.VotingStartBlock + votingPeriod
 	proposal.VotingEndBlock = endBlock
 	proposal.Status = v1.StatusVotingPeriod
@@ -3,13 +3,11 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// TODO: Break into several smaller functions for clarity
-
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
+// voters, summed over the tally power sources of the keeper. The votes are left in the store, see
+// DeleteVotes.
 func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnDeposits bool, tallyResults v1.TallyResult) {
 	results := make(map[v1.VoteOption]sdk.Dec)
 	results[v1.OptionYes] = sdk.ZeroDec()
@@ -17,88 +15,39 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	results[v1.OptionNo] = sdk.ZeroDec()
 	results[v1.OptionNoWithVeto] = sdk.ZeroDec()
 
-	totalVotingPower := sdk.ZeroDec()
-	currValidators := make(map[string]v1.ValidatorGovInfo)
-
-	// fetch all the bonded validators, insert them into currValidators
-	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
-		currValidators[validator.GetOperator().String()] = v1.NewValidatorGovInfo(
-			validator.GetOperator(),
-			validator.GetBondedTokens(),
-			validator.GetDelegatorShares(),
-			sdk.ZeroDec(),
-			v1.WeightedVoteOptions{},
-		)
-
-		return false
-	})
-
-	keeper.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) bool {
-		// if validator, just record it in the map
-		voter := sdk.MustAccAddressFromBech32(vote.Voter)
-
-		valAddrStr := sdk.ValAddress(voter.Bytes()).String()
-		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Options
-			currValidators[valAddrStr] = val
-		}
+	votes := keeper.GetVotes(ctx, proposal.Id)
+	options := make(map[string]v1.WeightedVoteOptions, len(votes))
+	for _, vote := range votes {
+		options[vote.Voter] = vote.Options
+	}
 
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr().String()
-
-			if val, ok := currValidators[valAddrStr]; ok {
-				// There is no need to handle the special case that validator address equal to voter address.
-				// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				currValidators[valAddrStr] = val
-
-				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-				for _, option := range vote.Options {
-					weight, _ := sdk.NewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
-				totalVotingPower = totalVotingPower.Add(votingPower)
+	totalVotingPower := sdk.ZeroDec()
+	totalPower := sdk.ZeroDec()
+
+	for _, source := range keeper.tallyPowerSources {
+		for _, power := range source.VotingPowers(ctx, votes) {
+			for _, option := range options[power.Voter.String()] {
+				weight, _ := sdk.NewDecFromStr(option.Weight)
+				subPower := power.Power.Mul(weight)
+				results[option.Option] = results[option.Option].Add(subPower)
 			}
-
-			return false
-		})
-
-		keeper.deleteVote(ctx, vote.ProposalId, voter)
-		return false
-	})
-
-	// iterate over the validators again to tally their voting power
-	for _, val := range currValidators {
-		if len(val.Vote) == 0 {
-			continue
+			totalVotingPower = totalVotingPower.Add(power.Power)
 		}
 
-		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
-		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-		for _, option := range val.Vote {
-			weight, _ := sdk.NewDecFromStr(option.Weight)
-			subPower := votingPower.Mul(weight)
-			results[option.Option] = results[option.Option].Add(subPower)
-		}
-		totalVotingPower = totalVotingPower.Add(votingPower)
+		totalPower = totalPower.Add(source.TotalVotingPower(ctx))
 	}
 
 	tallyParams := keeper.GetTallyParams(ctx)
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
-	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
+	// If there is no voting power, e.g. no staked coins, the proposal fails
+	if !totalPower.IsPositive() {
 		return false, false, tallyResults
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
+	percentVoting := totalVotingPower.Quo(totalPower)
 	quorum, _ := sdk.NewDecFromStr(tallyParams.Quorum)
 	if percentVoting.LT(quorum) {
 		return false, false, tallyResults
@@ -116,7 +65,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	threshold, _ := sdk.NewDecFromStr(tallyParams.Threshold)
+	threshold, _ := sdk.NewDecFromStr(proposal.GetThresholdFromParams(tallyParams))
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
@@ -124,3 +73,38 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
 	return false, false, tallyResults
 }
+
+// GetVotingPowers returns the voting power of the voters of a proposal per tally
+// power source, along with the total voting power of the sources. The parts of
+// the voting power of a voter from a source are summed.
+func (keeper Keeper) GetVotingPowers(ctx sdk.Context, proposalID uint64) ([]*v1.VotingPower, sdk.Dec) {
+	votes := keeper.GetVotes(ctx, proposalID)
+
+	var votingPowers []*v1.VotingPower
+	totalPower := sdk.ZeroDec()
+
+	for _, source := range keeper.tallyPowerSources {
+		var voters []string
+		powers := make(map[string]sdk.Dec)
+		for _, power := range source.VotingPowers(ctx, votes) {
+			voter := power.Voter.String()
+			if _, ok := powers[voter]; !ok {
+				voters = append(voters, voter)
+				powers[voter] = sdk.ZeroDec()
+			}
+			powers[voter] = powers[voter].Add(power.Power)
+		}
+
+		for _, voter := range voters {
+			votingPowers = append(votingPowers, &v1.VotingPower{
+				Voter:  voter,
+				Source: source.Name(),
+				Power:  powers[voter].String(),
+			})
+		}
+
+		totalPower = totalPower.Add(source.TotalVotingPower(ctx))
+	}
+
+	return votingPowers, totalPower
+}
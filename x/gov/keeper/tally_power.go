@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingPowerSourceName is the name of the StakingPowerSource.
+const StakingPowerSourceName = "staking"
+
+// VoterPower is a part of the voting power of a voter.
+type VoterPower struct {
+	Voter sdk.AccAddress
+	Power sdk.Dec
+}
+
+// TallyPowerSource is a source of voting power for the tally of proposals.
+// The tally of a proposal sums the voting power of the voters from all the
+// sources of the keeper, see SetTallyPowerSources, and computes its quorum
+// against their total voting power.
+type TallyPowerSource interface {
+	// Name returns the name of the source, identifying it in the voting power
+	// breakdowns of proposals.
+	Name() string
+
+	// VotingPowers returns the voting power of the voters of a proposal given
+	// their votes. A voter may be returned several times, its voting power
+	// being the sum of its parts, and voters without voting power may be
+	// omitted.
+	VotingPowers(ctx sdk.Context, votes v1.Votes) []VoterPower
+
+	// TotalVotingPower returns the voting power of all the potential voters.
+	TotalVotingPower(ctx sdk.Context) sdk.Dec
+}
+
+var _ TallyPowerSource = StakingPowerSource{}
+
+// StakingPowerSource is the default TallyPowerSource, granting voting power
+// to the stake delegated to the bonded validators. A validator votes with the
+// stake of its delegators who do not vote themselves.
+//
+// The stake of the excluded delegators, e.g. liquid staking module accounts,
+// has no voting power: neither they nor their validators vote with it.
+type StakingPowerSource struct {
+	sk       types.StakingKeeper
+	excluded []sdk.AccAddress
+}
+
+// NewStakingPowerSource returns a StakingPowerSource excluding the stake of
+// the given delegators.
+func NewStakingPowerSource(sk types.StakingKeeper, excludedDelegators ...sdk.AccAddress) StakingPowerSource {
+	return StakingPowerSource{sk: sk, excluded: excludedDelegators}
+}
+
+// Name implements the TallyPowerSource interface.
+func (s StakingPowerSource) Name() string {
+	return StakingPowerSourceName
+}
+
+// VotingPowers implements the TallyPowerSource interface.
+func (s StakingPowerSource) VotingPowers(ctx sdk.Context, votes v1.Votes) []VoterPower {
+	validators, currValidators := s.bondedValidators(ctx)
+
+	// the stake of the excluded delegators is deducted from their validators
+	excluded := make(map[string]bool, len(s.excluded))
+	for _, delegator := range s.excluded {
+		excluded[delegator.String()] = true
+		s.sk.IterateDelegations(ctx, delegator, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr().String()
+			if val, ok := currValidators[valAddrStr]; ok {
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				currValidators[valAddrStr] = val
+			}
+
+			return false
+		})
+	}
+
+	var powers []VoterPower
+	for _, vote := range votes {
+		if excluded[vote.Voter] {
+			continue
+		}
+
+		// if validator, just record it in the map
+		voter := sdk.MustAccAddressFromBech32(vote.Voter)
+
+		valAddrStr := sdk.ValAddress(voter.Bytes()).String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			currValidators[valAddrStr] = val
+		}
+
+		// iterate over all delegations from voter, deduct from any delegated-to validators
+		s.sk.IterateDelegations(ctx, voter, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr().String()
+
+			if val, ok := currValidators[valAddrStr]; ok {
+				// There is no need to handle the special case that validator address equal to voter address.
+				// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				currValidators[valAddrStr] = val
+
+				// delegation shares * bonded / total shares
+				powers = append(powers, VoterPower{
+					Voter: voter,
+					Power: delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares),
+				})
+			}
+
+			return false
+		})
+	}
+
+	// iterate over the validators again to tally their voting power
+	for _, valAddrStr := range validators {
+		val := currValidators[valAddrStr]
+		if len(val.Vote) == 0 {
+			continue
+		}
+
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		powers = append(powers, VoterPower{
+			Voter: sdk.AccAddress(val.Address),
+			Power: sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares),
+		})
+	}
+
+	return powers
+}
+
+// TotalVotingPower implements the TallyPowerSource interface. It returns the
+// total bonded tokens, minus the stake of the excluded delegators.
+func (s StakingPowerSource) TotalVotingPower(ctx sdk.Context) sdk.Dec {
+	total := sdk.NewDecFromInt(s.sk.TotalBondedTokens(ctx))
+	if len(s.excluded) == 0 {
+		return total
+	}
+
+	_, currValidators := s.bondedValidators(ctx)
+	for _, delegator := range s.excluded {
+		s.sk.IterateDelegations(ctx, delegator, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+			if val, ok := currValidators[delegation.GetValidatorAddr().String()]; ok {
+				total = total.Sub(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+			}
+
+			return false
+		})
+	}
+
+	return total
+}
+
+// bondedValidators returns the bonded validators by power, along with their
+// governance info.
+func (s StakingPowerSource) bondedValidators(ctx sdk.Context) ([]string, map[string]v1.ValidatorGovInfo) {
+	var validators []string
+	currValidators := make(map[string]v1.ValidatorGovInfo)
+
+	s.sk.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+		valAddrStr := validator.GetOperator().String()
+		validators = append(validators, valAddrStr)
+		currValidators[valAddrStr] = v1.NewValidatorGovInfo(
+			validator.GetOperator(),
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			sdk.ZeroDec(),
+			v1.WeightedVoteOptions{},
+		)
+
+		return false
+	})
+
+	return validators, currValidators
+}
@@ -0,0 +1,56 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+//
+// - Moving the deposit, voting and tally params from the x/params subspace to
+// a single Params in the x/gov store.
+// - Setting the expedited proposal and proposal cancellation params, which
+// did not exist in v0.46, to their default values, adjusted to the existing
+// params when the defaults would be weaker than the regular requirements.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace types.ParamSubspace, cdc codec.BinaryCodec) error {
+	var (
+		depositParams govv1.DepositParams
+		votingParams  govv1.VotingParams
+		tallyParams   govv1.TallyParams
+	)
+	legacySubspace.Get(ctx, govv1.ParamStoreKeyDepositParams, &depositParams)
+	legacySubspace.Get(ctx, govv1.ParamStoreKeyVotingParams, &votingParams)
+	legacySubspace.Get(ctx, govv1.ParamStoreKeyTallyParams, &tallyParams)
+
+	depositParams.ExpeditedMinDeposit = make(sdk.Coins, len(depositParams.MinDeposit))
+	for i, coin := range depositParams.MinDeposit {
+		depositParams.ExpeditedMinDeposit[i] = sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(govv1.DefaultMinExpeditedDepositTokensRatio))
+	}
+	depositParams.ProposalCancelRatio = govv1.DefaultProposalCancelRatio.String()
+
+	votingParams.ExpeditedVotingPeriod = govv1.DefaultExpeditedPeriod
+	if votingParams.ExpeditedVotingPeriod >= votingParams.VotingPeriod {
+		votingParams.ExpeditedVotingPeriod = votingParams.VotingPeriod / 2
+	}
+
+	expeditedThreshold := govv1.DefaultExpeditedThreshold
+	if threshold, err := sdk.NewDecFromStr(tallyParams.Threshold); err == nil && expeditedThreshold.LTE(threshold) {
+		// halfway between the threshold and unanimity
+		expeditedThreshold = threshold.Add(sdk.OneDec()).QuoInt64(2)
+	}
+	tallyParams.ExpeditedThreshold = expeditedThreshold.String()
+
+	params := govv1.NewParams(votingParams, tallyParams, depositParams)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
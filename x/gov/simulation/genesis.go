@@ -15,12 +15,16 @@ import (
 
 // Simulation parameter constants
 const (
-	DepositParamsMinDeposit    = "deposit_params_min_deposit"
-	DepositParamsDepositPeriod = "deposit_params_deposit_period"
-	VotingParamsVotingPeriod   = "voting_params_voting_period"
-	TallyParamsQuorum          = "tally_params_quorum"
-	TallyParamsThreshold       = "tally_params_threshold"
-	TallyParamsVeto            = "tally_params_veto"
+	DepositParamsMinDeposit          = "deposit_params_min_deposit"
+	DepositParamsDepositPeriod       = "deposit_params_deposit_period"
+	DepositParamsExpeditedMinDeposit = "deposit_params_expedited_min_deposit"
+	DepositParamsCancelRatio         = "deposit_params_cancel_ratio"
+	VotingParamsVotingPeriod         = "voting_params_voting_period"
+	VotingParamsExpeditedPeriod      = "voting_params_expedited_period"
+	TallyParamsQuorum                = "tally_params_quorum"
+	TallyParamsThreshold             = "tally_params_threshold"
+	TallyParamsExpeditedThreshold    = "tally_params_expedited_threshold"
+	TallyParamsVeto                  = "tally_params_veto"
 )
 
 // GenDepositParamsDepositPeriod randomized DepositParamsDepositPeriod
@@ -33,9 +37,27 @@ func GenDepositParamsMinDeposit(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
 }
 
+// GenDepositParamsExpeditedMinDeposit randomized DepositParamsExpeditedMinDeposit,
+// greater than minDeposit
+func GenDepositParamsExpeditedMinDeposit(r *rand.Rand, minDeposit sdk.Coins) sdk.Coins {
+	amount := minDeposit.AmountOf(sdk.DefaultBondDenom).AddRaw(int64(simulation.RandIntBetween(r, 1, 1e3)))
+	return sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+}
+
+// GenDepositParamsCancelRatio randomized DepositParamsCancelRatio
+func GenDepositParamsCancelRatio(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 100)), 2)
+}
+
 // GenVotingParamsVotingPeriod randomized VotingParamsVotingPeriod
 func GenVotingParamsVotingPeriod(r *rand.Rand) uint64 {
-	return uint64(simulation.RandIntBetween(r, 1, 60*60*24*2/5))
+	return uint64(simulation.RandIntBetween(r, 2, 60*60*24*2/5))
+}
+
+// GenVotingParamsExpeditedPeriod randomized VotingParamsExpeditedPeriod,
+// shorter than votingPeriod
+func GenVotingParamsExpeditedPeriod(r *rand.Rand, votingPeriod uint64) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, int(votingPeriod)))
 }
 
 // GenTallyParamsQuorum randomized TallyParamsQuorum
@@ -48,6 +70,12 @@ func GenTallyParamsThreshold(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 450, 550)), 3)
 }
 
+// GenTallyParamsExpeditedThreshold randomized TallyParamsExpeditedThreshold,
+// greater than threshold
+func GenTallyParamsExpeditedThreshold(r *rand.Rand, threshold sdk.Dec) sdk.Dec {
+	return threshold.Add(sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 200)), 3))
+}
+
 // GenTallyParamsVeto randomized TallyParamsVeto
 func GenTallyParamsVeto(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
@@ -69,12 +97,30 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { depositPeriod = GenDepositParamsDepositPeriod(r) },
 	)
 
+	var expeditedMinDeposit sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsExpeditedMinDeposit, &expeditedMinDeposit, simState.Rand,
+		func(r *rand.Rand) { expeditedMinDeposit = GenDepositParamsExpeditedMinDeposit(r, minDeposit) },
+	)
+
+	var cancelRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsCancelRatio, &cancelRatio, simState.Rand,
+		func(r *rand.Rand) { cancelRatio = GenDepositParamsCancelRatio(r) },
+	)
+
 	var votingPeriod uint64
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, VotingParamsVotingPeriod, &votingPeriod, simState.Rand,
 		func(r *rand.Rand) { votingPeriod = GenVotingParamsVotingPeriod(r) },
 	)
 
+	var expeditedVotingPeriod uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsExpeditedPeriod, &expeditedVotingPeriod, simState.Rand,
+		func(r *rand.Rand) { expeditedVotingPeriod = GenVotingParamsExpeditedPeriod(r, votingPeriod) },
+	)
+
 	var quorum sdk.Dec
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, TallyParamsQuorum, &quorum, simState.Rand,
@@ -87,6 +133,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { threshold = GenTallyParamsThreshold(r) },
 	)
 
+	var expeditedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsExpeditedThreshold, &expeditedThreshold, simState.Rand,
+		func(r *rand.Rand) { expeditedThreshold = GenTallyParamsExpeditedThreshold(r, threshold) },
+	)
+
 	var veto sdk.Dec
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, TallyParamsVeto, &veto, simState.Rand,
@@ -95,9 +147,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	govGenesis := v1.NewGenesisState(
 		startingProposalID,
-		v1.NewDepositParams(minDeposit, depositPeriod),
-		v1.NewVotingParams(votingPeriod),
-		v1.NewTallyParams(quorum, threshold, veto),
+		v1.NewDepositParams(minDeposit, depositPeriod, expeditedMinDeposit, cancelRatio),
+		v1.NewVotingParams(votingPeriod, expeditedVotingPeriod),
+		v1.NewTallyParams(quorum, threshold, veto, expeditedThreshold),
 	)
 
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
@@ -82,6 +82,7 @@ func TestWeightedOperations(t *testing.T) {
 		{simappparams.DefaultWeightMsgDeposit, types.ModuleName, simulation.TypeMsgDeposit},
 		{simappparams.DefaultWeightMsgVote, types.ModuleName, simulation.TypeMsgVote},
 		{simappparams.DefaultWeightMsgVoteWeighted, types.ModuleName, simulation.TypeMsgVoteWeighted},
+		{simappparams.DefaultWeightMsgCancelProposal, types.ModuleName, simulation.TypeMsgCancelProposal},
 	}
 
 	for i, w := range weightesOps {
@@ -147,7 +148,7 @@ func TestSimulateMsgDeposit(t *testing.T) {
 	submitHeight := uint64(ctx.BlockHeader().Height)
 	depositPeriod := app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal, err := v1.NewProposal([]sdk.Msg{contentMsg}, 1, "", submitHeight, submitHeight+depositPeriod)
+	proposal, err := v1.NewProposal([]sdk.Msg{contentMsg}, 1, "", submitHeight, submitHeight+depositPeriod, accounts[0].Address, false)
 	require.NoError(t, err)
 
 	app.GovKeeper.SetProposal(ctx, proposal)
@@ -193,7 +194,7 @@ func TestSimulateMsgVote(t *testing.T) {
 	submitHeight := uint64(ctx.BlockHeader().Height)
 	depositPeriod := app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal, err := v1.NewProposal([]sdk.Msg{contentMsg}, 1, "", submitHeight, submitHeight+depositPeriod)
+	proposal, err := v1.NewProposal([]sdk.Msg{contentMsg}, 1, "", submitHeight, submitHeight+depositPeriod, accounts[0].Address, false)
 	require.NoError(t, err)
 
 	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
@@ -236,7 +237,7 @@ func TestSimulateMsgVoteWeighted(t *testing.T) {
 	submitTime := uint64(ctx.BlockHeader().Height)
 	depositPeriod := app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal, err := v1.NewProposal([]sdk.Msg{contentMsg}, 1, "", submitTime, submitTime+depositPeriod)
+	proposal, err := v1.NewProposal([]sdk.Msg{contentMsg}, 1, "", submitTime, submitTime+depositPeriod, accounts[0].Address, false)
 	require.NoError(t, err)
 
 	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
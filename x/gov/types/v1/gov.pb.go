@@ -5,7 +5,6 @@ package v1
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-proto"
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -248,6 +247,10 @@ type Proposal struct {
 	VotingEndBlock   uint64       `protobuf:"varint,9,opt,name=voting_end_block,json=votingEndBlock,proto3" json:"voting_end_block,omitempty" yaml:"voting_end_block"`
 	// metadata is any arbitrary metadata attached to the proposal.
 	Metadata string `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// proposer is the address of the proposal submitter.
+	Proposer string `protobuf:"bytes,11,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// expedited defines if the proposal is expedited, see the expedited params.
+	Expedited bool `protobuf:"varint,12,opt,name=expedited,proto3" json:"expedited,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -353,6 +356,20 @@ func (m *Proposal) GetMetadata() string {
 	return ""
 }
 
+func (m *Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *Proposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	YesCount        string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
@@ -422,6 +439,70 @@ func (m *TallyResult) GetNoWithVetoCount() string {
 	return ""
 }
 
+// VotingPower defines the voting power of a voter on a proposal from a voting
+// power source.
+type VotingPower struct {
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// source is the name of the voting power source.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// power is the voting power, in the unit of the bonded tokens.
+	Power string `protobuf:"bytes,3,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *VotingPower) Reset()         { *m = VotingPower{} }
+func (m *VotingPower) String() string { return proto.CompactTextString(m) }
+func (*VotingPower) ProtoMessage()    {}
+func (*VotingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{4}
+}
+func (m *VotingPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingPower.Merge(m, src)
+}
+func (m *VotingPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingPower proto.InternalMessageInfo
+
+func (m *VotingPower) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *VotingPower) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *VotingPower) GetPower() string {
+	if m != nil {
+		return m.Power
+	}
+	return ""
+}
+
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
@@ -436,7 +517,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{4}
+	return fileDescriptor_e05cb1c0d030febb, []int{5}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,13 +580,18 @@ type DepositParams struct {
 	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
 	//  Maximum period for Atom holders to deposit on a proposal in blocks.
 	MaxDepositPeriod uint64 `protobuf:"varint,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3" json:"max_deposit_period,omitempty" yaml:"max_deposit_period"`
+	//  Minimum deposit for an expedited proposal to enter voting period.
+	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,3,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit,omitempty" yaml:"expedited_min_deposit"`
+	//  Portion of the deposits burned when a proposal is canceled, the rest
+	//  being refunded to the depositors.
+	ProposalCancelRatio string `protobuf:"bytes,4,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty" yaml:"proposal_cancel_ratio"`
 }
 
 func (m *DepositParams) Reset()         { *m = DepositParams{} }
 func (m *DepositParams) String() string { return proto.CompactTextString(m) }
 func (*DepositParams) ProtoMessage()    {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{5}
+	return fileDescriptor_e05cb1c0d030febb, []int{6}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,17 +634,33 @@ func (m *DepositParams) GetMaxDepositPeriod() uint64 {
 	return 0
 }
 
+func (m *DepositParams) GetExpeditedMinDeposit() []types.Coin {
+	if m != nil {
+		return m.ExpeditedMinDeposit
+	}
+	return nil
+}
+
+func (m *DepositParams) GetProposalCancelRatio() string {
+	if m != nil {
+		return m.ProposalCancelRatio
+	}
+	return ""
+}
+
 // VotingParams defines the params for voting on governance proposals.
 type VotingParams struct {
 	//  Length of the voting period in blocks.
 	VotingPeriod uint64 `protobuf:"varint,1,opt,name=voting_period,json=votingPeriod,proto3" json:"voting_period,omitempty" yaml:"voting_period"`
+	//  Length of the voting period of expedited proposals in blocks.
+	ExpeditedVotingPeriod uint64 `protobuf:"varint,2,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3" json:"expedited_voting_period,omitempty" yaml:"expedited_voting_period"`
 }
 
 func (m *VotingParams) Reset()         { *m = VotingParams{} }
 func (m *VotingParams) String() string { return proto.CompactTextString(m) }
 func (*VotingParams) ProtoMessage()    {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{6}
+	return fileDescriptor_e05cb1c0d030febb, []int{7}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -594,6 +696,13 @@ func (m *VotingParams) GetVotingPeriod() uint64 {
 	return 0
 }
 
+func (m *VotingParams) GetExpeditedVotingPeriod() uint64 {
+	if m != nil {
+		return m.ExpeditedVotingPeriod
+	}
+	return 0
+}
+
 // TallyParams defines the params for tallying votes on governance proposals.
 type TallyParams struct {
 	//  Minimum percentage of total stake needed to vote for a result to be
@@ -604,13 +713,16 @@ type TallyParams struct {
 	//  Minimum value of Veto votes to Total votes ratio for proposal to be
 	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	//  Minimum proportion of Yes votes for an expedited proposal to pass.
+	//  Default value: 0.667.
+	ExpeditedThreshold string `protobuf:"bytes,4,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
 }
 
 func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{7}
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,6 +772,77 @@ func (m *TallyParams) GetVetoThreshold() string {
 	return ""
 }
 
+func (m *TallyParams) GetExpeditedThreshold() string {
+	if m != nil {
+		return m.ExpeditedThreshold
+	}
+	return ""
+}
+
+// Params defines the parameters of the x/gov module.
+type Params struct {
+	// deposit_params defines the parameters related to deposit.
+	DepositParams DepositParams `protobuf:"bytes,1,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params"`
+	// voting_params defines the parameters related to voting.
+	VotingParams VotingParams `protobuf:"bytes,2,opt,name=voting_params,json=votingParams,proto3" json:"voting_params"`
+	// tally_params defines the parameters related to tally.
+	TallyParams TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDepositParams() DepositParams {
+	if m != nil {
+		return m.DepositParams
+	}
+	return DepositParams{}
+}
+
+func (m *Params) GetVotingParams() VotingParams {
+	if m != nil {
+		return m.VotingParams
+	}
+	return VotingParams{}
+}
+
+func (m *Params) GetTallyParams() TallyParams {
+	if m != nil {
+		return m.TallyParams
+	}
+	return TallyParams{}
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -667,88 +850,103 @@ func init() {
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
+	proto.RegisterType((*VotingPower)(nil), "cosmos.gov.v1.VotingPower")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
+	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1146 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0x65, 0x5a, 0xb6, 0x47, 0x96, 0xc2, 0xae, 0xdd, 0x8a, 0x76, 0x62, 0xd1, 0x21, 0xd0,
-	0xc2, 0x4d, 0x1a, 0xa9, 0x4e, 0xd0, 0x16, 0x48, 0xd0, 0x83, 0x64, 0x31, 0x8d, 0xd2, 0xc0, 0x52,
-	0x49, 0x46, 0x69, 0x7a, 0x21, 0x28, 0x91, 0x91, 0x88, 0x88, 0x5c, 0x95, 0xbb, 0x52, 0xa2, 0x47,
-	0xe8, 0xad, 0xf7, 0xbe, 0x46, 0xd0, 0x67, 0x48, 0x2f, 0x45, 0x90, 0x4b, 0x7b, 0x12, 0xda, 0xe4,
-	0xa6, 0xa3, 0x9f, 0xa0, 0x20, 0x77, 0xa9, 0x5f, 0x07, 0xee, 0x89, 0xdc, 0x99, 0x6f, 0xbe, 0x99,
-	0xd9, 0xf9, 0xc8, 0x5d, 0xc8, 0xb7, 0x31, 0xf1, 0x31, 0x29, 0x75, 0xf0, 0xb0, 0x34, 0x3c, 0x89,
-	0x1e, 0xc5, 0x7e, 0x88, 0x29, 0x46, 0x59, 0xe6, 0x28, 0x46, 0x96, 0xe1, 0xc9, 0x41, 0x81, 0xe3,
-	0x5a, 0x36, 0x71, 0x4b, 0xc3, 0x93, 0x96, 0x4b, 0xed, 0x93, 0x52, 0x1b, 0x7b, 0x01, 0x83, 0x1f,
-	0xec, 0x75, 0x70, 0x07, 0xc7, 0xaf, 0xa5, 0xe8, 0x8d, 0x5b, 0x95, 0x0e, 0xc6, 0x9d, 0x9e, 0x5b,
-	0x8a, 0x57, 0xad, 0xc1, 0xb3, 0x12, 0xf5, 0x7c, 0x97, 0x50, 0xdb, 0xef, 0x73, 0xc0, 0xfe, 0x32,
-	0xc0, 0x0e, 0x46, 0xdc, 0x55, 0x58, 0x76, 0x39, 0x83, 0xd0, 0xa6, 0x1e, 0x4e, 0x32, 0xee, 0xb3,
-	0x8a, 0x2c, 0x96, 0x94, 0x57, 0x1b, 0x2f, 0x54, 0x0c, 0xe8, 0x89, 0xeb, 0x75, 0xba, 0xd4, 0x75,
-	0x9a, 0x98, 0xba, 0xf5, 0x7e, 0x14, 0x86, 0x4e, 0x20, 0x8d, 0xe3, 0x37, 0x59, 0x38, 0x12, 0x8e,
-	0x73, 0xb7, 0xf7, 0x8b, 0x0b, 0x2d, 0x16, 0x67, 0x50, 0x9d, 0x03, 0xd1, 0x67, 0x90, 0x7e, 0x11,
-	0x13, 0xc9, 0xa9, 0x23, 0xe1, 0x78, 0xbb, 0x92, 0x7b, 0xfb, 0xea, 0x16, 0xf0, 0xa8, 0xaa, 0xdb,
-	0xd6, 0xb9, 0x57, 0xfd, 0x4d, 0x80, 0xcd, 0xaa, 0xdb, 0xc7, 0xc4, 0xa3, 0x48, 0x81, 0x4c, 0x3f,
-	0xc4, 0x7d, 0x4c, 0xec, 0x9e, 0xe5, 0x39, 0x71, 0x2e, 0x51, 0x87, 0xc4, 0x54, 0x73, 0xd0, 0xd7,
-	0xb0, 0xed, 0x30, 0x2c, 0x0e, 0x39, 0xaf, 0xfc, 0xf6, 0xd5, 0xad, 0x3d, 0xce, 0x5b, 0x76, 0x9c,
-	0xd0, 0x25, 0xc4, 0xa0, 0xa1, 0x17, 0x74, 0xf4, 0x19, 0x14, 0x7d, 0x03, 0x69, 0xdb, 0xc7, 0x83,
-	0x80, 0xca, 0xeb, 0x47, 0xeb, 0xc7, 0x99, 0x59, 0xfd, 0xd1, 0x4c, 0x8a, 0x7c, 0x26, 0xc5, 0x53,
-	0xec, 0x05, 0x15, 0xf1, 0xf5, 0x58, 0x59, 0xd3, 0x39, 0x5c, 0xfd, 0x43, 0x84, 0xad, 0x06, 0xcf,
-	0x8f, 0x72, 0x90, 0x9a, 0x56, 0x95, 0xf2, 0x1c, 0xf4, 0x25, 0x6c, 0xf9, 0x2e, 0x21, 0x76, 0xc7,
-	0x25, 0x72, 0x2a, 0xe6, 0xdd, 0x2b, 0xb2, 0x9d, 0x2f, 0x26, 0x3b, 0x5f, 0x2c, 0x07, 0x23, 0x7d,
-	0x8a, 0x42, 0x5f, 0x41, 0x9a, 0x50, 0x9b, 0x0e, 0x88, 0xbc, 0x1e, 0xef, 0xe3, 0xe1, 0xd2, 0x3e,
-	0x26, 0xa9, 0x8c, 0x18, 0xa4, 0x73, 0x30, 0x7a, 0x00, 0xe8, 0x99, 0x17, 0xd8, 0x3d, 0x8b, 0xda,
-	0xbd, 0xde, 0xc8, 0x0a, 0x5d, 0x32, 0xe8, 0x51, 0x59, 0x3c, 0x12, 0x8e, 0x33, 0xb7, 0x0f, 0x96,
-	0x28, 0xcc, 0x08, 0xa2, 0xc7, 0x08, 0x5d, 0x8a, 0xa3, 0xe6, 0x2c, 0xe8, 0x2e, 0xec, 0x90, 0x41,
-	0xcb, 0xf7, 0xa8, 0xd5, 0xea, 0xe1, 0xf6, 0x73, 0x79, 0x23, 0x6a, 0xa6, 0x92, 0x3f, 0x1f, 0x2b,
-	0xbb, 0x23, 0xdb, 0xef, 0xdd, 0x55, 0xe7, 0xbd, 0xaa, 0x9e, 0x61, 0xcb, 0x4a, 0xb4, 0x42, 0x0f,
-	0xe0, 0x23, 0xbe, 0xa3, 0x96, 0x1b, 0x38, 0x9c, 0x20, 0x1d, 0x13, 0x5c, 0x3b, 0x1f, 0x2b, 0x32,
-	0x23, 0x58, 0x81, 0xa8, 0xfa, 0x15, 0x6e, 0xd3, 0x02, 0x87, 0x31, 0x55, 0x21, 0x4b, 0x31, 0xb5,
-	0x7b, 0x16, 0x77, 0xc8, 0x9b, 0xff, 0x6f, 0x2a, 0x3b, 0x71, 0x54, 0xa2, 0x96, 0xef, 0x01, 0x0d,
-	0x31, 0xf5, 0x82, 0x8e, 0x45, 0xa8, 0x1d, 0x26, 0x1d, 0x6d, 0xc5, 0x05, 0x1d, 0x9e, 0x8f, 0x95,
-	0x7d, 0x56, 0xd0, 0x2a, 0x46, 0xd5, 0x25, 0x66, 0x34, 0x22, 0x1b, 0x2b, 0x49, 0x03, 0x6e, 0x9b,
-	0xeb, 0x6d, 0x3b, 0xa6, 0xba, 0x7a, 0x3e, 0x56, 0xf2, 0x0b, 0x54, 0x73, 0xad, 0xe5, 0x98, 0x69,
-	0xda, 0xd9, 0x41, 0x24, 0x09, 0x6a, 0x3b, 0x36, 0xb5, 0x65, 0x88, 0xf4, 0xa9, 0x4f, 0xd7, 0xea,
-	0x5f, 0x02, 0x64, 0xe6, 0x67, 0x71, 0x13, 0xb6, 0x47, 0x2e, 0xb1, 0xda, 0xb1, 0x2e, 0x85, 0x95,
-	0x8f, 0xa4, 0x16, 0x50, 0x7d, 0x6b, 0xe4, 0x92, 0xd3, 0xc8, 0x8f, 0xee, 0x40, 0xd6, 0x6e, 0x11,
-	0x6a, 0x7b, 0x01, 0x0f, 0x48, 0x5d, 0x18, 0xb0, 0xc3, 0x41, 0x2c, 0xe8, 0x73, 0xd8, 0x0a, 0x30,
-	0xc7, 0xaf, 0x5f, 0x88, 0xdf, 0x0c, 0x30, 0x83, 0xde, 0x03, 0x14, 0x60, 0xeb, 0x85, 0x47, 0xbb,
-	0xd6, 0xd0, 0xa5, 0x49, 0x90, 0x78, 0x61, 0xd0, 0x95, 0x00, 0x3f, 0xf1, 0x68, 0xb7, 0xe9, 0x52,
-	0x16, 0xac, 0xfe, 0x2e, 0x80, 0x18, 0xfd, 0x02, 0x2e, 0xff, 0x80, 0x8b, 0xb0, 0x31, 0xc4, 0xd4,
-	0xbd, 0xfc, 0xe3, 0x65, 0x30, 0x74, 0x0f, 0x36, 0xd9, 0xff, 0x84, 0xc8, 0x62, 0xac, 0x91, 0xeb,
-	0x4b, 0x72, 0x5f, 0xfd, 0x59, 0xe9, 0x49, 0xc4, 0xc2, 0x30, 0x36, 0x16, 0x87, 0xf1, 0x50, 0xdc,
-	0x5a, 0x97, 0xc4, 0x68, 0x24, 0x59, 0x2e, 0xa7, 0x86, 0x1d, 0xda, 0x3e, 0x41, 0x4f, 0x21, 0xe3,
-	0x7b, 0xc1, 0x54, 0x98, 0xc2, 0x65, 0xc2, 0x3c, 0x8c, 0x84, 0x39, 0x19, 0x2b, 0x1f, 0xcf, 0x45,
-	0x7d, 0x81, 0x7d, 0x8f, 0xba, 0x7e, 0x9f, 0x8e, 0x74, 0xf0, 0xbd, 0x20, 0xd1, 0xeb, 0x73, 0x40,
-	0xbe, 0xfd, 0x32, 0x01, 0x59, 0x7d, 0x37, 0xf4, 0xb0, 0x13, 0x6f, 0x84, 0x58, 0xf9, 0x76, 0x32,
-	0x56, 0xae, 0xad, 0x7a, 0x67, 0x4c, 0x33, 0x3d, 0xaf, 0xa2, 0x54, 0x5d, 0xf2, 0xed, 0x97, 0x49,
-	0x1f, 0xcc, 0xd4, 0x85, 0x9d, 0x66, 0x2c, 0x4d, 0xde, 0xd7, 0x8f, 0x90, 0xe5, 0xea, 0xe5, 0x79,
-	0xe3, 0xd9, 0x54, 0xee, 0x4c, 0xc6, 0x4a, 0x7e, 0xc1, 0xb1, 0x90, 0x72, 0x6f, 0x41, 0xf7, 0x49,
-	0xb6, 0x1d, 0xb6, 0xe6, 0x99, 0xfe, 0x4d, 0x64, 0xcd, 0x33, 0xdd, 0x85, 0xf4, 0xcf, 0x03, 0x1c,
-	0x0e, 0x7c, 0xae, 0x69, 0x75, 0x32, 0x56, 0x24, 0x66, 0x99, 0x71, 0x2f, 0x1f, 0x06, 0xcc, 0x8f,
-	0x4e, 0x61, 0x9b, 0x76, 0x43, 0x97, 0x74, 0x71, 0xcf, 0xe1, 0x12, 0xf9, 0x74, 0x32, 0x56, 0x76,
-	0xa7, 0xc6, 0x0f, 0x32, 0xcc, 0xe2, 0xd0, 0x0f, 0x90, 0x8b, 0x25, 0x3c, 0x63, 0x62, 0xda, 0xbf,
-	0x31, 0x19, 0x2b, 0xf2, 0xa2, 0xe7, 0x83, 0x74, 0xd9, 0x08, 0x67, 0x26, 0xb0, 0x1b, 0xbf, 0x08,
-	0x00, 0x73, 0xc7, 0xe1, 0x55, 0xc8, 0x37, 0xeb, 0xa6, 0x66, 0xd5, 0x1b, 0x66, 0xad, 0x7e, 0x66,
-	0x3d, 0x3e, 0x33, 0x1a, 0xda, 0x69, 0xed, 0x7e, 0x4d, 0xab, 0x4a, 0x6b, 0x68, 0x17, 0xae, 0xcc,
-	0x3b, 0x9f, 0x6a, 0x86, 0x24, 0xa0, 0x3c, 0xec, 0xce, 0x1b, 0xcb, 0x15, 0xc3, 0x2c, 0xd7, 0xce,
-	0xa4, 0x14, 0x42, 0x90, 0x9b, 0x77, 0x9c, 0xd5, 0xa5, 0x75, 0x74, 0x0d, 0xe4, 0x45, 0x9b, 0xf5,
-	0xa4, 0x66, 0x3e, 0xb0, 0x9a, 0x9a, 0x59, 0x97, 0xc4, 0x1b, 0x7f, 0x0a, 0x90, 0x5b, 0x3c, 0x27,
-	0x90, 0x02, 0x57, 0x1b, 0x7a, 0xbd, 0x51, 0x37, 0xca, 0x8f, 0x2c, 0xc3, 0x2c, 0x9b, 0x8f, 0x8d,
-	0xa5, 0x9a, 0x54, 0x28, 0x2c, 0x03, 0xaa, 0x5a, 0xa3, 0x6e, 0xd4, 0x4c, 0xab, 0xa1, 0xe9, 0xb5,
-	0x7a, 0x55, 0x12, 0xd0, 0x75, 0x38, 0x5c, 0xc6, 0x34, 0xeb, 0x66, 0xed, 0xec, 0xbb, 0x04, 0x92,
-	0x42, 0x07, 0xf0, 0xc9, 0x32, 0xa4, 0x51, 0x36, 0x0c, 0xad, 0xca, 0x8a, 0x5e, 0xf6, 0xe9, 0xda,
-	0x43, 0xed, 0xd4, 0xd4, 0xaa, 0x92, 0x78, 0x51, 0xe4, 0xfd, 0x72, 0xed, 0x91, 0x56, 0x95, 0x36,
-	0x2a, 0xda, 0xeb, 0x77, 0x05, 0xe1, 0xcd, 0xbb, 0x82, 0xf0, 0xcf, 0xbb, 0x82, 0xf0, 0xeb, 0xfb,
-	0xc2, 0xda, 0x9b, 0xf7, 0x85, 0xb5, 0xbf, 0xdf, 0x17, 0xd6, 0x7e, 0xba, 0xd9, 0xf1, 0x68, 0x77,
-	0xd0, 0x2a, 0xb6, 0xb1, 0xcf, 0x6f, 0x29, 0xfc, 0x71, 0x8b, 0x38, 0xcf, 0x4b, 0x2f, 0xe3, 0x9b,
-	0x17, 0x1d, 0xf5, 0x5d, 0x12, 0x5d, 0xab, 0xd2, 0xf1, 0x99, 0x7b, 0xe7, 0xbf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xab, 0xb8, 0x40, 0x81, 0x97, 0x09, 0x00, 0x00,
+	// 1348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0x4d, 0x6f, 0xdb, 0xc6,
+	0x16, 0x35, 0x25, 0x59, 0xb6, 0xaf, 0x6c, 0x47, 0x6f, 0xec, 0x44, 0xf4, 0x97, 0x68, 0xf3, 0x6d,
+	0x8c, 0x24, 0x4f, 0x7a, 0x4e, 0x10, 0x14, 0x48, 0xd1, 0x85, 0x25, 0x31, 0x89, 0xd2, 0xd4, 0x52,
+	0x29, 0xc5, 0x69, 0xba, 0x21, 0x68, 0x69, 0x22, 0x13, 0x11, 0x39, 0x2a, 0x39, 0x92, 0xad, 0x55,
+	0x51, 0x74, 0x55, 0x74, 0xd3, 0x55, 0xbb, 0xe9, 0x0f, 0xca, 0xaa, 0xc8, 0xb2, 0x2b, 0x21, 0x48,
+	0x56, 0xd1, 0xd2, 0xbf, 0xa0, 0xe0, 0xcc, 0xf0, 0x4b, 0x56, 0x90, 0xae, 0xac, 0x39, 0xf7, 0xcc,
+	0xb9, 0x77, 0xee, 0x9c, 0x19, 0x8e, 0xa1, 0xd0, 0x21, 0x9e, 0x4d, 0xbc, 0x72, 0x8f, 0x8c, 0xca,
+	0xa3, 0x23, 0xff, 0x4f, 0x69, 0xe0, 0x12, 0x4a, 0xd0, 0x1a, 0x0f, 0x94, 0x7c, 0x64, 0x74, 0xb4,
+	0x5d, 0x14, 0xbc, 0x33, 0xd3, 0xc3, 0xe5, 0xd1, 0xd1, 0x19, 0xa6, 0xe6, 0x51, 0xb9, 0x43, 0x2c,
+	0x87, 0xd3, 0xb7, 0x37, 0x7b, 0xa4, 0x47, 0xd8, 0xcf, 0xb2, 0xff, 0x4b, 0xa0, 0x4a, 0x8f, 0x90,
+	0x5e, 0x1f, 0x97, 0xd9, 0xe8, 0x6c, 0xf8, 0xaa, 0x4c, 0x2d, 0x1b, 0x7b, 0xd4, 0xb4, 0x07, 0x82,
+	0xb0, 0x35, 0x4b, 0x30, 0x9d, 0xb1, 0x08, 0x15, 0x67, 0x43, 0xdd, 0xa1, 0x6b, 0x52, 0x8b, 0x88,
+	0x8c, 0xaa, 0x01, 0xe8, 0x05, 0xb6, 0x7a, 0xe7, 0x14, 0x77, 0x4f, 0x09, 0xc5, 0x8d, 0x81, 0x1f,
+	0x43, 0x47, 0x90, 0x25, 0xec, 0x97, 0x2c, 0xed, 0x4b, 0x87, 0xeb, 0xf7, 0xb6, 0x4a, 0x89, 0x75,
+	0x94, 0x22, 0xaa, 0x2e, 0x88, 0xe8, 0x16, 0x64, 0x2f, 0x98, 0x90, 0x9c, 0xda, 0x97, 0x0e, 0x57,
+	0x74, 0x31, 0x52, 0x7f, 0x92, 0x60, 0xa9, 0x86, 0x07, 0xc4, 0xb3, 0x28, 0x52, 0x20, 0x37, 0x70,
+	0xc9, 0x80, 0x78, 0x66, 0xdf, 0xb0, 0xba, 0x4c, 0x3b, 0xa3, 0x43, 0x00, 0xd5, 0xbb, 0x68, 0x17,
+	0x56, 0xba, 0x9c, 0x4b, 0x5c, 0xa1, 0x13, 0x01, 0xe8, 0x0b, 0xc8, 0x9a, 0x36, 0x19, 0x3a, 0x54,
+	0x4e, 0xef, 0xa7, 0x0f, 0x73, 0x51, 0x55, 0x7e, 0x3b, 0x4b, 0xa2, 0x9d, 0xa5, 0x2a, 0xb1, 0x9c,
+	0x4a, 0xe6, 0xcd, 0x44, 0x59, 0xd0, 0x05, 0x5d, 0xfd, 0x79, 0x11, 0x96, 0x9b, 0x22, 0x0b, 0x5a,
+	0x87, 0x54, 0x98, 0x3b, 0x65, 0x75, 0xd1, 0xff, 0x61, 0xd9, 0xc6, 0x9e, 0x67, 0xf6, 0xb0, 0x27,
+	0xa7, 0x98, 0xee, 0x66, 0x89, 0x37, 0xad, 0x14, 0x34, 0xad, 0x74, 0xec, 0x8c, 0xf5, 0x90, 0x85,
+	0x1e, 0x40, 0xd6, 0xa3, 0x26, 0x1d, 0x7a, 0x72, 0x9a, 0x75, 0x67, 0x6f, 0xa6, 0x3b, 0x41, 0xaa,
+	0x16, 0x23, 0xe9, 0x82, 0x8c, 0x9e, 0x00, 0x7a, 0x65, 0x39, 0x66, 0xdf, 0xa0, 0x66, 0xbf, 0x3f,
+	0x36, 0x5c, 0xec, 0x0d, 0xfb, 0x54, 0xce, 0xec, 0x4b, 0x87, 0xb9, 0x7b, 0xdb, 0x33, 0x12, 0x6d,
+	0x9f, 0xa2, 0x33, 0x86, 0x9e, 0x67, 0xb3, 0x62, 0x08, 0x7a, 0x08, 0xab, 0xde, 0xf0, 0xcc, 0xb6,
+	0xa8, 0x71, 0xd6, 0x27, 0x9d, 0xd7, 0xf2, 0xa2, 0xbf, 0x98, 0x4a, 0xe1, 0x6a, 0xa2, 0x6c, 0x8c,
+	0x4d, 0xbb, 0xff, 0x50, 0x8d, 0x47, 0x55, 0x3d, 0xc7, 0x87, 0x15, 0x7f, 0x84, 0x9e, 0xc0, 0x7f,
+	0x44, 0x47, 0x0d, 0xec, 0x74, 0x85, 0x40, 0x96, 0x09, 0xec, 0x5e, 0x4d, 0x14, 0x99, 0x0b, 0x5c,
+	0xa3, 0xa8, 0xfa, 0x0d, 0x81, 0x69, 0x4e, 0x97, 0x2b, 0xd5, 0x60, 0x8d, 0x12, 0x6a, 0xf6, 0x0d,
+	0x11, 0x90, 0x97, 0xfe, 0xdd, 0xae, 0xac, 0xb2, 0x59, 0x81, 0x27, 0xbe, 0x06, 0x34, 0x22, 0xd4,
+	0x72, 0x7a, 0x86, 0x47, 0x4d, 0x37, 0x58, 0xd1, 0x32, 0x2b, 0x68, 0xef, 0x6a, 0xa2, 0x6c, 0xf1,
+	0x82, 0xae, 0x73, 0x54, 0x3d, 0xcf, 0xc1, 0x96, 0x8f, 0xf1, 0x92, 0x34, 0x10, 0x58, 0x6c, 0x6d,
+	0x2b, 0x4c, 0x6a, 0xe7, 0x6a, 0xa2, 0x14, 0x12, 0x52, 0xb1, 0xa5, 0xad, 0x73, 0x28, 0x5c, 0xd9,
+	0xb6, 0x6f, 0x09, 0x6a, 0x76, 0x4d, 0x6a, 0xca, 0xc0, 0x5c, 0x18, 0x8e, 0xfd, 0x18, 0x37, 0x2c,
+	0x76, 0xe5, 0x1c, 0x8f, 0x05, 0x63, 0xdf, 0xbe, 0xf8, 0x72, 0x80, 0xbb, 0x16, 0xc5, 0x5d, 0x79,
+	0x75, 0x5f, 0x3a, 0x5c, 0xd6, 0x23, 0x40, 0xfd, 0x43, 0x82, 0x5c, 0x7c, 0x17, 0x77, 0x60, 0x65,
+	0x8c, 0x3d, 0xa3, 0xc3, 0x1c, 0x2d, 0x71, 0xa9, 0x31, 0xf6, 0xaa, 0xfe, 0x18, 0xfd, 0x17, 0xd6,
+	0xcc, 0x33, 0x8f, 0x9a, 0x96, 0x23, 0x08, 0xfc, 0x34, 0xac, 0x0a, 0x90, 0x93, 0xb6, 0x60, 0xd9,
+	0x21, 0x22, 0x9e, 0x66, 0xf1, 0x25, 0x87, 0xf0, 0xd0, 0x1d, 0x40, 0x0e, 0x31, 0x2e, 0x2c, 0x7a,
+	0x6e, 0x8c, 0x30, 0x0d, 0x48, 0x19, 0x46, 0xba, 0xe1, 0x90, 0x17, 0x16, 0x3d, 0x3f, 0xc5, 0x94,
+	0x93, 0xd5, 0x6f, 0x21, 0x77, 0xca, 0x3a, 0xd0, 0x24, 0x17, 0xd8, 0x45, 0x9b, 0xb0, 0x38, 0x22,
+	0x14, 0xbb, 0xa2, 0x28, 0x3e, 0xf0, 0x0f, 0xb8, 0x47, 0x86, 0x6e, 0x07, 0x07, 0x07, 0x9c, 0x8f,
+	0x7c, 0xf6, 0xc0, 0x9f, 0x26, 0x2a, 0xe0, 0x03, 0xf5, 0x4f, 0x09, 0x32, 0xfe, 0x2d, 0xf1, 0xf9,
+	0x33, 0x1f, 0x66, 0x4b, 0xc5, 0xb3, 0x7d, 0x09, 0x4b, 0xfc, 0x62, 0xf1, 0xe4, 0x0c, 0xb3, 0xd5,
+	0xc1, 0xcc, 0x09, 0xb9, 0x7e, 0x6b, 0xe9, 0xc1, 0x8c, 0xc4, 0xfe, 0x2d, 0x26, 0xf7, 0xef, 0x69,
+	0x66, 0x39, 0x9d, 0xcf, 0xa8, 0x1f, 0xd3, 0xb0, 0x26, 0x1c, 0xd8, 0x34, 0x5d, 0xd3, 0xf6, 0xd0,
+	0x4b, 0xc8, 0xd9, 0x96, 0x13, 0x7a, 0x59, 0xfa, 0x9c, 0x97, 0xf7, 0x7c, 0x2f, 0x4f, 0x27, 0xca,
+	0xcd, 0xd8, 0xac, 0xbb, 0xc4, 0xb6, 0x28, 0xb6, 0x07, 0x74, 0xac, 0x83, 0x6d, 0x39, 0x81, 0xc5,
+	0x5f, 0x03, 0xb2, 0xcd, 0xcb, 0x80, 0x64, 0x0c, 0xb0, 0x6b, 0x91, 0x2e, 0x5b, 0x6e, 0xa6, 0xf2,
+	0xd5, 0x74, 0xa2, 0xec, 0x5e, 0x8f, 0x46, 0x4a, 0xd1, 0x11, 0xb8, 0xce, 0x52, 0xf5, 0xbc, 0x6d,
+	0x5e, 0x06, 0xeb, 0x60, 0x10, 0xfa, 0x5d, 0x82, 0x9b, 0xa1, 0xe7, 0x8c, 0xf8, 0x92, 0x3e, 0x7b,
+	0x69, 0x3e, 0x16, 0x4b, 0x52, 0xe6, 0xce, 0x4f, 0x94, 0xb4, 0xcb, 0x4b, 0x9a, 0x4b, 0x54, 0xf5,
+	0x8d, 0x10, 0xff, 0x26, 0xea, 0xc2, 0x05, 0xdc, 0x0c, 0x8d, 0xd0, 0x31, 0x9d, 0x0e, 0xee, 0x1b,
+	0xec, 0x4b, 0xc4, 0x4d, 0x59, 0xa9, 0xfa, 0x89, 0xe7, 0x12, 0xe6, 0x25, 0x9e, 0x4b, 0x54, 0xf5,
+	0x8d, 0x00, 0xaf, 0x32, 0x58, 0x67, 0xe8, 0x47, 0x09, 0x56, 0x85, 0xbd, 0xf9, 0x56, 0x7f, 0x07,
+	0x6b, 0xe2, 0x0e, 0x10, 0x5b, 0xc1, 0x4c, 0x59, 0xb9, 0x3f, 0x9d, 0x28, 0x85, 0x44, 0x20, 0x91,
+	0x79, 0x33, 0x71, 0x7b, 0x04, 0x1b, 0xb0, 0xca, 0xc7, 0xa2, 0xf9, 0x3f, 0x42, 0x21, 0x6a, 0x49,
+	0x32, 0x07, 0xdf, 0xee, 0xc7, 0xd3, 0x89, 0x72, 0xf0, 0x09, 0x4a, 0x22, 0x5b, 0x71, 0xb6, 0xc1,
+	0x33, 0x79, 0xa3, 0x3d, 0x3e, 0x8d, 0x15, 0xa0, 0xfe, 0x9a, 0x12, 0x77, 0x8c, 0x58, 0xea, 0x5d,
+	0xc8, 0xfe, 0x30, 0x24, 0xee, 0xd0, 0xe6, 0x67, 0xb9, 0xb2, 0x39, 0x9d, 0x28, 0x79, 0x8e, 0xc4,
+	0xcc, 0x2a, 0x38, 0xe8, 0x01, 0xac, 0xd0, 0x73, 0x17, 0x7b, 0xe7, 0xa4, 0xcf, 0x0b, 0x5e, 0xa9,
+	0x14, 0xa6, 0x13, 0x65, 0x23, 0x04, 0x63, 0x73, 0x22, 0x26, 0xaa, 0xc2, 0x3a, 0xbb, 0x63, 0xa2,
+	0xb9, 0xec, 0x2a, 0xa8, 0xec, 0x4e, 0x27, 0x8a, 0x9c, 0x8c, 0xc4, 0x04, 0xd6, 0xfc, 0x48, 0x3b,
+	0x14, 0xd1, 0x21, 0x72, 0x4d, 0x4c, 0x89, 0x9b, 0xe3, 0x60, 0x3a, 0x51, 0xf6, 0xe6, 0x84, 0x63,
+	0x72, 0x28, 0x0c, 0x87, 0x9a, 0xea, 0x3b, 0x09, 0xb2, 0xa2, 0x11, 0x75, 0x58, 0x0f, 0xcf, 0x0e,
+	0x43, 0x58, 0x43, 0x72, 0xf7, 0x76, 0x67, 0xae, 0x95, 0xc4, 0xa5, 0x20, 0x3e, 0x58, 0x6b, 0xdd,
+	0x38, 0x88, 0x1e, 0x45, 0xf6, 0xe1, 0x4a, 0x29, 0xa6, 0xb4, 0x73, 0xfd, 0x8d, 0x14, 0x5a, 0x2e,
+	0xf8, 0xf2, 0x8d, 0xe2, 0x36, 0xac, 0xc2, 0x2a, 0x7f, 0x09, 0x08, 0x99, 0xf4, 0xa7, 0x5f, 0x02,
+	0x09, 0x95, 0x1c, 0x8d, 0xa0, 0xdb, 0xbf, 0x48, 0x00, 0xb1, 0x87, 0xdb, 0x0e, 0x14, 0x4e, 0x1b,
+	0x6d, 0xcd, 0x68, 0x34, 0xdb, 0xf5, 0xc6, 0x89, 0xf1, 0xfc, 0xa4, 0xd5, 0xd4, 0xaa, 0xf5, 0x47,
+	0x75, 0xad, 0x96, 0x5f, 0x40, 0x1b, 0x70, 0x23, 0x1e, 0x7c, 0xa9, 0xb5, 0xf2, 0x12, 0x2a, 0xc0,
+	0x46, 0x1c, 0x3c, 0xae, 0xb4, 0xda, 0xc7, 0xf5, 0x93, 0x7c, 0x0a, 0x21, 0x58, 0x8f, 0x07, 0x4e,
+	0x1a, 0xf9, 0x34, 0xda, 0x05, 0x39, 0x89, 0x19, 0x2f, 0xea, 0xed, 0x27, 0xc6, 0xa9, 0xd6, 0x6e,
+	0xe4, 0x33, 0xb7, 0xff, 0x92, 0x60, 0x3d, 0xf9, 0xf6, 0x41, 0x0a, 0xec, 0x34, 0xf5, 0x46, 0xb3,
+	0xd1, 0x3a, 0x7e, 0x66, 0xb4, 0xda, 0xc7, 0xed, 0xe7, 0xad, 0x99, 0x9a, 0x54, 0x28, 0xce, 0x12,
+	0x6a, 0x5a, 0xb3, 0xd1, 0xaa, 0xb7, 0x8d, 0xa6, 0xa6, 0xd7, 0x1b, 0xb5, 0xbc, 0x84, 0x0e, 0x60,
+	0x6f, 0x96, 0x73, 0xda, 0x68, 0xd7, 0x4f, 0x1e, 0x07, 0x94, 0x14, 0xda, 0x86, 0x5b, 0xb3, 0x94,
+	0xe6, 0x71, 0xab, 0xa5, 0xd5, 0x78, 0xd1, 0xb3, 0x31, 0x5d, 0x7b, 0xaa, 0x55, 0xdb, 0x5a, 0x2d,
+	0x9f, 0x99, 0x37, 0xf3, 0xd1, 0x71, 0xfd, 0x99, 0x56, 0xcb, 0x2f, 0x56, 0xb4, 0x37, 0xef, 0x8b,
+	0xd2, 0xdb, 0xf7, 0x45, 0xe9, 0xdd, 0xfb, 0xa2, 0xf4, 0xdb, 0x87, 0xe2, 0xc2, 0xdb, 0x0f, 0xc5,
+	0x85, 0xbf, 0x3f, 0x14, 0x17, 0xbe, 0xbf, 0xd3, 0xb3, 0xe8, 0xf9, 0xf0, 0xac, 0xd4, 0x21, 0x76,
+	0x59, 0xbc, 0xe9, 0xf9, 0x9f, 0xff, 0x79, 0xdd, 0xd7, 0xe5, 0x4b, 0xf6, 0x8f, 0x00, 0x1d, 0x0f,
+	0xb0, 0xe7, 0xbf, 0xf2, 0xb3, 0xec, 0x1d, 0x79, 0xff, 0x9f, 0x01, 0x00, 0xb7, 0xb0, 0xd6, 0xd4,
+	0x26, 0x0c, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -855,6 +1053,23 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -986,6 +1201,50 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VotingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Power) > 0 {
+		i -= len(m.Power)
+		copy(dAtA[i:], m.Power)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Power)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1062,6 +1321,27 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposalCancelRatio) > 0 {
+		i -= len(m.ProposalCancelRatio)
+		copy(dAtA[i:], m.ProposalCancelRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.MaxDepositPeriod != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.MaxDepositPeriod))
 		i--
@@ -1104,6 +1384,11 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpeditedVotingPeriod != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ExpeditedVotingPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.VotingPeriod != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.VotingPeriod))
 		i--
@@ -1132,6 +1417,13 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExpeditedThreshold) > 0 {
+		i -= len(m.ExpeditedThreshold)
+		copy(dAtA[i:], m.ExpeditedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.VetoThreshold) > 0 {
 		i -= len(m.VetoThreshold)
 		copy(dAtA[i:], m.VetoThreshold)
@@ -1156,6 +1448,59 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.VotingParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.DepositParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1249,6 +1594,13 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Expedited {
+		n += 2
+	}
 	return n
 }
 
@@ -1277,6 +1629,27 @@ func (m *TallyResult) Size() (n int) {
 	return n
 }
 
+func (m *VotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Power)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1318,6 +1691,16 @@ func (m *DepositParams) Size() (n int) {
 	if m.MaxDepositPeriod != 0 {
 		n += 1 + sovGov(uint64(m.MaxDepositPeriod))
 	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.ProposalCancelRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1330,6 +1713,9 @@ func (m *VotingParams) Size() (n int) {
 	if m.VotingPeriod != 0 {
 		n += 1 + sovGov(uint64(m.VotingPeriod))
 	}
+	if m.ExpeditedVotingPeriod != 0 {
+		n += 1 + sovGov(uint64(m.ExpeditedVotingPeriod))
+	}
 	return n
 }
 
@@ -1351,6 +1737,25 @@ func (m *TallyParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	l = len(m.ExpeditedThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.VotingParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.TallyParams.Size()
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1875,26 +2280,78 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -2074,6 +2531,152 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VotingPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Power = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2323,6 +2926,72 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2392,6 +3061,25 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			m.ExpeditedVotingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpeditedVotingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2538,6 +3226,187 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			}
 			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TallyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
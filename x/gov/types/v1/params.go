@@ -11,15 +11,23 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod uint64 = uint64(time.Hour * 24 * 2 / (5 * time.Second)) // 2 days in blocks
+	DefaultPeriod          uint64 = uint64(time.Hour * 24 * 2 / (5 * time.Second)) // 2 days in blocks
+	DefaultExpeditedPeriod uint64 = uint64(time.Hour * 24 / (5 * time.Second))     // 1 day in blocks
+
+	// DefaultMinExpeditedDepositTokensRatio is the ratio of the expedited
+	// minimum deposit to the minimum deposit.
+	DefaultMinExpeditedDepositTokensRatio = 5
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.NewInt(10000000)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens          = sdk.NewInt(10000000)
+	DefaultMinExpeditedDepositTokens = DefaultMinDepositTokens.MulRaw(DefaultMinExpeditedDepositTokensRatio)
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
+	DefaultVetoThreshold             = sdk.NewDecWithPrec(334, 3)
+	DefaultProposalCancelRatio       = sdk.NewDecWithPrec(5, 1)
 )
 
 // Parameter store key
@@ -29,20 +37,26 @@ var (
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
 )
 
-// ParamKeyTable - Key declaration for parameters
+// ParamKeyTable - Key declaration for parameters. The legacy params may omit
+// the expedited and proposal cancel params, which are set when migrating them
+// to the gov store.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable(
-		paramtypes.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
-		paramtypes.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
-		paramtypes.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateLegacyDepositParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateLegacyVotingParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateLegacyTallyParams),
 	)
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriodInBlocks uint64) DepositParams {
+func NewDepositParams(
+	minDeposit sdk.Coins, maxDepositPeriodInBlocks uint64, expeditedMinDeposit sdk.Coins, proposalCancelRatio sdk.Dec,
+) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriodInBlocks,
+		MinDeposit:          minDeposit,
+		MaxDepositPeriod:    maxDepositPeriodInBlocks,
+		ExpeditedMinDeposit: expeditedMinDeposit,
+		ProposalCancelRatio: proposalCancelRatio.String(),
 	}
 }
 
@@ -51,12 +65,15 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
+		DefaultProposalCancelRatio,
 	)
 }
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return sdk.Coins(dp.MinDeposit).IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return sdk.Coins(dp.MinDeposit).IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		sdk.Coins(dp.ExpeditedMinDeposit).IsEqual(dp2.ExpeditedMinDeposit) && dp.ProposalCancelRatio == dp2.ProposalCancelRatio
 }
 
 func validateDepositParams(i interface{}) error {
@@ -71,27 +88,56 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod == 0 {
 		return errors.New("maximum deposit period must be above zero")
 	}
+	if !sdk.Coins(v.ExpeditedMinDeposit).IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", v.ExpeditedMinDeposit)
+	}
+
+	proposalCancelRatio, err := sdk.NewDecFromStr(v.ProposalCancelRatio)
+	if err != nil {
+		return fmt.Errorf("invalid proposal cancel ratio string: %w", err)
+	}
+	if proposalCancelRatio.IsNegative() {
+		return fmt.Errorf("proposal cancel ratio cannot be negative: %s", proposalCancelRatio)
+	}
+	if proposalCancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("proposal cancel ratio too large: %s", proposalCancelRatio)
+	}
 
 	return nil
 }
 
+func validateLegacyDepositParams(i interface{}) error {
+	v, ok := i.(DepositParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.ProposalCancelRatio == "" {
+		v.ProposalCancelRatio = DefaultProposalCancelRatio.String()
+	}
+
+	return validateDepositParams(v)
+}
+
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, vetoThreshold sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, vetoThreshold, expeditedThreshold sdk.Dec) TallyParams {
 	return TallyParams{
-		Quorum:        quorum.String(),
-		Threshold:     threshold.String(),
-		VetoThreshold: vetoThreshold.String(),
+		Quorum:             quorum.String(),
+		Threshold:          threshold.String(),
+		VetoThreshold:      vetoThreshold.String(),
+		ExpeditedThreshold: expeditedThreshold.String(),
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedThreshold)
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum == other.Quorum && tp.Threshold == other.Threshold && tp.VetoThreshold == other.VetoThreshold
+	return tp.Quorum == other.Quorum && tp.Threshold == other.Threshold && tp.VetoThreshold == other.VetoThreshold &&
+		tp.ExpeditedThreshold == other.ExpeditedThreshold
 }
 
 func validateTallyParams(i interface{}) error {
@@ -133,24 +179,49 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
 
+	expeditedThreshold, err := sdk.NewDecFromStr(v.ExpeditedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid expedited threshold string: %w", err)
+	}
+	if !expeditedThreshold.IsPositive() {
+		return fmt.Errorf("expedited vote threshold must be positive: %s", expeditedThreshold)
+	}
+	if expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", v)
+	}
+
 	return nil
 }
 
+func validateLegacyTallyParams(i interface{}) error {
+	v, ok := i.(TallyParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.ExpeditedThreshold == "" {
+		v.ExpeditedThreshold = DefaultExpeditedThreshold.String()
+	}
+
+	return validateTallyParams(v)
+}
+
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriodInBlocks uint64) VotingParams {
+func NewVotingParams(votingPeriodInBlocks, expeditedVotingPeriodInBlocks uint64) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriodInBlocks,
+		VotingPeriod:          votingPeriodInBlocks,
+		ExpeditedVotingPeriod: expeditedVotingPeriodInBlocks,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod)
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	return vp.VotingPeriod == other.VotingPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod
 }
 
 func validateVotingParams(i interface{}) error {
@@ -162,20 +233,24 @@ func validateVotingParams(i interface{}) error {
 	if v.VotingPeriod <= 0 {
 		return errors.New("voting period must be above zero")
 	}
+	if v.ExpeditedVotingPeriod <= 0 {
+		return errors.New("expedited voting period must be above zero")
+	}
 
 	return nil
 }
 
-// Params returns all of the governance params
-type Params struct {
-	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
-	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_params"`
-}
+func validateLegacyVotingParams(i interface{}) error {
+	v, ok := i.(VotingParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
 
-func (gp Params) String() string {
-	return gp.VotingParams.String() + "\n" +
-		gp.TallyParams.String() + "\n" + gp.DepositParams.String()
+	if v.ExpeditedVotingPeriod == 0 {
+		v.ExpeditedVotingPeriod = DefaultExpeditedPeriod
+	}
+
+	return validateVotingParams(v)
 }
 
 // NewParams creates a new gov Params instance
@@ -191,3 +266,42 @@ func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
 func DefaultParams() Params {
 	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
 }
+
+// Validate checks that the governance params have valid values.
+func (p Params) Validate() error {
+	if err := validateDepositParams(p.DepositParams); err != nil {
+		return err
+	}
+
+	if err := validateVotingParams(p.VotingParams); err != nil {
+		return err
+	}
+
+	if err := validateTallyParams(p.TallyParams); err != nil {
+		return err
+	}
+
+	// expedited proposals must be faster to pass, but harder to submit and to
+	// pass, than regular ones
+	minDeposit := sdk.Coins(p.DepositParams.MinDeposit)
+	if !minDeposit.Empty() && !sdk.Coins(p.DepositParams.ExpeditedMinDeposit).IsAllGT(minDeposit) {
+		return fmt.Errorf(
+			"expedited minimum deposit %s must be greater than the minimum deposit %s",
+			sdk.Coins(p.DepositParams.ExpeditedMinDeposit), sdk.Coins(p.DepositParams.MinDeposit),
+		)
+	}
+	if p.VotingParams.ExpeditedVotingPeriod >= p.VotingParams.VotingPeriod {
+		return fmt.Errorf(
+			"expedited voting period %d must be strictly less than the voting period %d",
+			p.VotingParams.ExpeditedVotingPeriod, p.VotingParams.VotingPeriod,
+		)
+	}
+	if sdk.MustNewDecFromStr(p.TallyParams.ExpeditedThreshold).LTE(sdk.MustNewDecFromStr(p.TallyParams.Threshold)) {
+		return fmt.Errorf(
+			"expedited vote threshold %s must be greater than the vote threshold %s",
+			p.TallyParams.ExpeditedThreshold, p.TallyParams.Threshold,
+		)
+	}
+
+	return nil
+}
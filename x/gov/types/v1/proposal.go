@@ -21,7 +21,7 @@ const (
 )
 
 // NewProposal creates a new Proposal instance
-func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitBlock, depositEndBlock uint64) (Proposal, error) {
+func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitBlock, depositEndBlock uint64, proposer sdk.AccAddress, expedited bool) (Proposal, error) {
 	msgs, err := sdktx.SetMsgs(messages)
 	if err != nil {
 		return Proposal{}, err
@@ -37,11 +37,40 @@ func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitBlock, de
 		FinalTallyResult: &tally,
 		SubmitBlock:      submitBlock,
 		DepositEndBlock:  depositEndBlock,
+		Proposer:         proposer.String(),
+		Expedited:        expedited,
 	}
 
 	return p, nil
 }
 
+// GetMinDepositFromParams returns the minimum deposit for the proposal to
+// enter its voting period, which depends on whether it is expedited.
+func (p Proposal) GetMinDepositFromParams(params DepositParams) sdk.Coins {
+	if p.Expedited {
+		return params.ExpeditedMinDeposit
+	}
+	return params.MinDeposit
+}
+
+// GetVotingPeriodFromParams returns the length of the voting period of the
+// proposal in blocks, which depends on whether it is expedited.
+func (p Proposal) GetVotingPeriodFromParams(params VotingParams) uint64 {
+	if p.Expedited {
+		return params.ExpeditedVotingPeriod
+	}
+	return params.VotingPeriod
+}
+
+// GetThresholdFromParams returns the minimum proportion of Yes votes for the
+// proposal to pass, which depends on whether it is expedited.
+func (p Proposal) GetThresholdFromParams(params TallyParams) string {
+	if p.Expedited {
+		return params.ExpeditedThreshold
+	}
+	return params.Threshold
+}
+
 // GetMessages returns the proposal messages
 func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
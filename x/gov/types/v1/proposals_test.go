@@ -35,7 +35,7 @@ func TestNestedAnys(t *testing.T) {
 	testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 	msgContent, err := v1.NewLegacyContent(testProposal, "cosmos1govacct")
 	require.NoError(t, err)
-	proposal, err := v1.NewProposal([]sdk.Msg{msgContent}, 1, "", uint64(42), uint64(42))
+	proposal, err := v1.NewProposal([]sdk.Msg{msgContent}, 1, "", uint64(42), uint64(42), sdk.AccAddress("cosmos1proposer"), false)
 	require.NoError(t, err)
 
 	require.Equal(t, "TODO Fix panic here", proposal.String())
@@ -20,6 +20,7 @@ const (
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
+	ParamAll      = "params"
 )
 
 // QueryProposalParams Params for queries:
@@ -6,7 +6,6 @@ package v1
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -463,7 +462,7 @@ func (m *QueryVotesResponse) GetPagination() *query.PageResponse {
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 	// params_type defines which parameters to query for, can be one of "voting",
-	// "tallying" or "deposit".
+	// "tallying", "deposit" or "params".
 	ParamsType string `protobuf:"bytes,1,opt,name=params_type,json=paramsType,proto3" json:"params_type,omitempty"`
 }
 
@@ -515,6 +514,9 @@ type QueryParamsResponse struct {
 	DepositParams *DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params,omitempty"`
 	// tally_params defines the parameters related to tally.
 	TallyParams *TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"`
+	// params defines all the parameters of the x/gov module, it is only set
+	// when the params_type of the request is "params".
+	Params *Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -571,6 +573,13 @@ func (m *QueryParamsResponse) GetTallyParams() *TallyParams {
 	return nil
 }
 
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 // QueryDepositRequest is the request type for the Query/Deposit RPC method.
 type QueryDepositRequest struct {
 	// proposal_id defines the unique id of the proposal.
@@ -873,6 +882,110 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QueryVotingPowersRequest is the request type for the Query/VotingPowers RPC
+// method.
+type QueryVotingPowersRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryVotingPowersRequest) Reset()         { *m = QueryVotingPowersRequest{} }
+func (m *QueryVotingPowersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowersRequest) ProtoMessage()    {}
+func (*QueryVotingPowersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{16}
+}
+func (m *QueryVotingPowersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowersRequest.Merge(m, src)
+}
+func (m *QueryVotingPowersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowersRequest proto.InternalMessageInfo
+
+func (m *QueryVotingPowersRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryVotingPowersResponse is the response type for the Query/VotingPowers
+// RPC method.
+type QueryVotingPowersResponse struct {
+	// voting_powers defines the voting power of each voter per source.
+	VotingPowers []*VotingPower `protobuf:"bytes,1,rep,name=voting_powers,json=votingPowers,proto3" json:"voting_powers,omitempty"`
+	// total_voting_power defines the total voting power of the sources, against
+	// which the quorum is computed.
+	TotalVotingPower string `protobuf:"bytes,2,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+}
+
+func (m *QueryVotingPowersResponse) Reset()         { *m = QueryVotingPowersResponse{} }
+func (m *QueryVotingPowersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowersResponse) ProtoMessage()    {}
+func (*QueryVotingPowersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{17}
+}
+func (m *QueryVotingPowersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowersResponse.Merge(m, src)
+}
+func (m *QueryVotingPowersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowersResponse proto.InternalMessageInfo
+
+func (m *QueryVotingPowersResponse) GetVotingPowers() []*VotingPower {
+	if m != nil {
+		return m.VotingPowers
+	}
+	return nil
+}
+
+func (m *QueryVotingPowersResponse) GetTotalVotingPower() string {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1.QueryProposalResponse")
@@ -890,72 +1003,77 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryVotingPowersRequest)(nil), "cosmos.gov.v1.QueryVotingPowersRequest")
+	proto.RegisterType((*QueryVotingPowersResponse)(nil), "cosmos.gov.v1.QueryVotingPowersResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 945 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5d, 0x6f, 0xdc, 0x44,
-	0x14, 0xcd, 0x6c, 0x92, 0x76, 0xf7, 0xa6, 0x09, 0x70, 0x5b, 0x9a, 0xc5, 0x94, 0x6d, 0x70, 0x68,
-	0x12, 0x28, 0xf5, 0xb0, 0xe9, 0x97, 0x04, 0xad, 0x04, 0x2d, 0x04, 0x90, 0x78, 0x08, 0xdb, 0x8a,
-	0x07, 0x5e, 0x22, 0x27, 0x6b, 0x19, 0x8b, 0x8d, 0xc7, 0xdd, 0x99, 0x5d, 0x11, 0xd2, 0x08, 0xa9,
-	0x12, 0x82, 0x27, 0x40, 0xa2, 0x12, 0xfc, 0x10, 0x7e, 0x04, 0x8f, 0x15, 0xbc, 0x20, 0x78, 0x41,
-	0x09, 0x3f, 0x04, 0x79, 0xe6, 0xda, 0x6b, 0x3b, 0xde, 0xcd, 0xa6, 0x8a, 0xfa, 0x14, 0x8d, 0x7d,
-	0xee, 0xb9, 0xe7, 0x7e, 0xf8, 0x4c, 0x16, 0x5e, 0xda, 0x12, 0x72, 0x5b, 0x48, 0xee, 0x8b, 0x3e,
-	0xef, 0x37, 0xf9, 0x83, 0x9e, 0xd7, 0xdd, 0x71, 0xa2, 0xae, 0x50, 0x02, 0x67, 0xcd, 0x2b, 0xc7,
-	0x17, 0x7d, 0xa7, 0xdf, 0xb4, 0xde, 0x20, 0xe4, 0xa6, 0x2b, 0x3d, 0x83, 0xe3, 0xfd, 0xe6, 0xa6,
-	0xa7, 0xdc, 0x26, 0x8f, 0x5c, 0x3f, 0x08, 0x5d, 0x15, 0x88, 0xd0, 0x84, 0x5a, 0x17, 0x7c, 0x21,
-	0xfc, 0x8e, 0xc7, 0xdd, 0x28, 0xe0, 0x6e, 0x18, 0x0a, 0xa5, 0x5f, 0x4a, 0x7a, 0x3b, 0x9f, 0xcf,
-	0x19, 0xf3, 0x9b, 0x17, 0x24, 0x66, 0x43, 0x9f, 0x38, 0xa5, 0xd7, 0x07, 0xfb, 0x26, 0x9c, 0xfb,
-	0x34, 0xce, 0xb9, 0xde, 0x15, 0x91, 0x90, 0x6e, 0xa7, 0xe5, 0x3d, 0xe8, 0x79, 0x52, 0xe1, 0x45,
-	0x98, 0x89, 0xe8, 0xd1, 0x46, 0xd0, 0xae, 0xb3, 0x05, 0xb6, 0x32, 0xd5, 0x82, 0xe4, 0xd1, 0xc7,
-	0x6d, 0xfb, 0x13, 0x78, 0xb1, 0x10, 0x28, 0x23, 0x11, 0x4a, 0x0f, 0xaf, 0x42, 0x35, 0x81, 0xe9,
-	0xb0, 0x99, 0xd5, 0x79, 0x27, 0x57, 0xb1, 0x93, 0x86, 0xa4, 0x40, 0xfb, 0xc7, 0x4a, 0x81, 0x4e,
-	0x26, 0x42, 0xd6, 0xe0, 0xb9, 0x54, 0x88, 0x54, 0xae, 0xea, 0x49, 0xcd, 0x3a, 0xb7, 0xfa, 0xca,
-	0x10, 0xd6, 0x7b, 0x1a, 0xd4, 0x9a, 0x8b, 0x72, 0x67, 0x74, 0x60, 0xba, 0x2f, 0x94, 0xd7, 0xad,
-	0x57, 0x16, 0xd8, 0x4a, 0xed, 0x4e, 0xfd, 0x8f, 0xdf, 0xae, 0x9c, 0x23, 0x82, 0xf7, 0xda, 0xed,
-	0xae, 0x27, 0xe5, 0x3d, 0xd5, 0x0d, 0x42, 0xbf, 0x65, 0x60, 0x78, 0x03, 0x6a, 0x6d, 0x2f, 0x12,
-	0x32, 0x50, 0xa2, 0x5b, 0x9f, 0x3c, 0x22, 0x66, 0x00, 0xc5, 0x35, 0x80, 0xc1, 0xd8, 0xea, 0x53,
-	0xba, 0x01, 0x4b, 0x89, 0xd4, 0x78, 0xc6, 0x8e, 0xd9, 0x05, 0x9a, 0xb1, 0xb3, 0xee, 0xfa, 0x1e,
-	0xd5, 0xda, 0xca, 0x44, 0xda, 0xbf, 0x32, 0x38, 0x5f, 0xec, 0x08, 0x75, 0xf8, 0x3a, 0xd4, 0x92,
-	0xe2, 0xe2, 0x66, 0x4c, 0x8e, 0x6a, 0xf1, 0x00, 0x89, 0x1f, 0xe6, 0x94, 0x55, 0xb4, 0xb2, 0xe5,
-	0x23, 0x95, 0x99, 0x9c, 0x39, 0x69, 0x5b, 0xf0, 0xbc, 0x56, 0xf6, 0x99, 0x50, 0xde, 0xb8, 0xfb,
-	0x72, 0xdc, 0xfe, 0xdb, 0xb7, 0xe0, 0x85, 0x4c, 0x12, 0xaa, 0x7c, 0x19, 0xa6, 0xe2, 0xb7, 0xb4,
-	0x57, 0x67, 0x0b, 0x45, 0x6b, 0xa8, 0x06, 0xd8, 0x0f, 0x33, 0xd1, 0x72, 0x6c, 0x8d, 0x6b, 0x25,
-	0x1d, 0x7a, 0x9a, 0xd9, 0x7d, 0xcf, 0x00, 0xb3, 0xe9, 0x49, 0xfd, 0xeb, 0xa6, 0x05, 0xc9, 0xcc,
-	0x4a, 0xe5, 0x1b, 0xc4, 0xc9, 0xcd, 0xea, 0x3a, 0x29, 0x59, 0x77, 0xbb, 0xee, 0x76, 0xae, 0x13,
-	0xfa, 0xc1, 0x86, 0xda, 0x89, 0x4c, 0x3b, 0x6b, 0x71, 0x58, 0xfc, 0xe8, 0xfe, 0x4e, 0xe4, 0xd9,
-	0xff, 0x30, 0x38, 0x9b, 0x8b, 0xa3, 0x12, 0xde, 0x85, 0xd9, 0xbe, 0x50, 0x41, 0xe8, 0x6f, 0x18,
-	0x30, 0x4d, 0xe2, 0xe5, 0xc3, 0xa5, 0x04, 0xa1, 0x4f, 0xb1, 0x67, 0xfa, 0x99, 0x13, 0xde, 0x85,
-	0x39, 0xfa, 0x58, 0x12, 0x0a, 0x53, 0xdd, 0x85, 0x02, 0xc5, 0xfb, 0x06, 0x44, 0x1c, 0xb3, 0xed,
-	0xec, 0x11, 0x6f, 0xc3, 0x19, 0xe5, 0x76, 0x3a, 0x3b, 0x09, 0xc5, 0xa4, 0xa6, 0xb0, 0x0a, 0x14,
-	0xf7, 0x63, 0x08, 0x11, 0xcc, 0xa8, 0xc1, 0xc1, 0x0e, 0xa9, 0x38, 0xca, 0x31, 0xf6, 0x7e, 0xe4,
-	0x3c, 0xa1, 0x32, 0xb6, 0x27, 0xd8, 0x1f, 0x91, 0xc9, 0xa6, 0xf9, 0xa8, 0x9b, 0x6f, 0xc1, 0x69,
-	0x02, 0x51, 0x1f, 0xcf, 0x97, 0x37, 0xa1, 0x95, 0xc0, 0xec, 0x6f, 0xf2, 0x4c, 0xcf, 0x7e, 0xb5,
-	0x1f, 0x33, 0x32, 0xea, 0x81, 0x02, 0x2a, 0x66, 0x15, 0xaa, 0xa4, 0x32, 0x59, 0xf0, 0x61, 0xd5,
-	0xa4, 0xb8, 0x93, 0x5b, 0xf3, 0xb7, 0x61, 0x5e, 0xab, 0xd2, 0x23, 0x6f, 0x79, 0xb2, 0xd7, 0x51,
-	0xc7, 0xb8, 0xc9, 0xea, 0x87, 0x63, 0xd3, 0x09, 0x4d, 0xeb, 0xc5, 0xa1, 0xf9, 0x94, 0x6e, 0x18,
-	0x85, 0x18, 0xe0, 0xea, 0xdf, 0x55, 0x98, 0xd6, 0x74, 0xf8, 0x2d, 0x83, 0x6a, 0xe2, 0xc3, 0xb8,
-	0x58, 0x88, 0x2c, 0xbb, 0x74, 0xad, 0xd7, 0x46, 0x83, 0x8c, 0x26, 0xdb, 0x79, 0xf4, 0xe7, 0x7f,
-	0x3f, 0x57, 0x56, 0x70, 0x89, 0xe7, 0xef, 0xfb, 0xd4, 0xe9, 0xf9, 0x6e, 0xa6, 0xe0, 0x3d, 0xfc,
-	0x1a, 0x6a, 0xe9, 0x1d, 0x82, 0x23, 0x53, 0x24, 0xeb, 0x64, 0x5d, 0x3a, 0x02, 0x45, 0x4a, 0x16,
-	0xb4, 0x12, 0x0b, 0xeb, 0xc3, 0x94, 0xe0, 0x77, 0x0c, 0xa6, 0x62, 0x5f, 0xc3, 0x8b, 0x65, 0x8c,
-	0x99, 0x0b, 0xc4, 0x5a, 0x18, 0x0e, 0xa0, 0x6c, 0xb7, 0x74, 0xb6, 0x1b, 0x78, 0x6d, 0xbc, 0xba,
-	0xb9, 0x76, 0x52, 0xbe, 0xab, 0xaf, 0x93, 0x3d, 0x7c, 0xc4, 0x60, 0x5a, 0xdb, 0x31, 0x0e, 0xcd,
-	0x94, 0x96, 0xff, 0xea, 0x08, 0x04, 0x89, 0xb9, 0xa6, 0xc5, 0x38, 0xf8, 0xe6, 0x71, 0xc4, 0xe0,
-	0x43, 0x38, 0x45, 0x0e, 0x56, 0x9a, 0x22, 0x67, 0xd2, 0x96, 0x3d, 0x0a, 0x42, 0x32, 0x2e, 0x6b,
-	0x19, 0x97, 0x70, 0xb1, 0x28, 0x43, 0xc3, 0xf8, 0x6e, 0xc6, 0xe5, 0xf7, 0xf0, 0x17, 0x06, 0xa7,
-	0xe9, 0x1b, 0xc4, 0x52, 0xf2, 0xbc, 0x1f, 0x5a, 0x8b, 0x23, 0x31, 0xa4, 0xe0, 0xae, 0x56, 0x70,
-	0x1b, 0xdf, 0x19, 0xb3, 0x11, 0xc9, 0xb7, 0xcf, 0x77, 0x53, 0x7f, 0xdc, 0xc3, 0x1f, 0x18, 0x54,
-	0x13, 0x43, 0xc1, 0x51, 0x69, 0xe5, 0xc8, 0x4f, 0xa5, 0xe8, 0x49, 0xf6, 0x4d, 0x2d, 0xae, 0x89,
-	0xfc, 0x98, 0xe2, 0xf0, 0x31, 0x83, 0x99, 0xcc, 0xc7, 0x8d, 0x4b, 0x65, 0xe9, 0x0e, 0x9b, 0x8d,
-	0xb5, 0x7c, 0x24, 0xee, 0x29, 0xf7, 0x47, 0x9b, 0xcb, 0x9d, 0x0f, 0x7e, 0xdf, 0x6f, 0xb0, 0x27,
-	0xfb, 0x0d, 0xf6, 0xef, 0x7e, 0x83, 0xfd, 0x74, 0xd0, 0x98, 0x78, 0x72, 0xd0, 0x98, 0xf8, 0xeb,
-	0xa0, 0x31, 0xf1, 0xf9, 0x65, 0x3f, 0x50, 0x5f, 0xf4, 0x36, 0x9d, 0x2d, 0xb1, 0x9d, 0x30, 0x9a,
-	0x3f, 0x57, 0x64, 0xfb, 0x4b, 0xfe, 0x95, 0xa6, 0x8f, 0xb7, 0x40, 0xc6, 0x3f, 0x2e, 0x4e, 0xe9,
-	0xff, 0xfd, 0xaf, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xce, 0x57, 0x85, 0xcf, 0xa5, 0x0c, 0x00,
-	0x00,
+	// 1003 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x38, 0x1f, 0x8d, 0x5f, 0x3e, 0x28, 0xaf, 0x69, 0xe3, 0x2e, 0xc1, 0x0d, 0x1b, 0x9a,
+	0x04, 0xda, 0xee, 0xe2, 0xb4, 0xa5, 0x12, 0x6d, 0x05, 0xa2, 0x10, 0xa8, 0xc4, 0x21, 0x98, 0x88,
+	0x03, 0x97, 0x68, 0x53, 0xaf, 0x16, 0x0b, 0xc7, 0xb3, 0xf5, 0x8c, 0x0d, 0x21, 0x8d, 0x90, 0x2a,
+	0x21, 0x50, 0x0f, 0x08, 0x89, 0x4a, 0x70, 0xe3, 0xdf, 0xe1, 0x58, 0x89, 0x0b, 0x12, 0x17, 0x94,
+	0xf0, 0x67, 0x70, 0x40, 0x3b, 0xf3, 0x66, 0xbd, 0xbb, 0x59, 0x6f, 0x9c, 0xa8, 0xea, 0xc9, 0x9a,
+	0x99, 0xdf, 0xfb, 0xbd, 0xdf, 0xfb, 0x98, 0x37, 0x5e, 0xb8, 0xf8, 0x80, 0x8b, 0x1d, 0x2e, 0xdc,
+	0x80, 0xf7, 0xdc, 0x5e, 0xcd, 0x7d, 0xd8, 0xf5, 0x3b, 0xbb, 0x4e, 0xd8, 0xe1, 0x92, 0xe3, 0x8c,
+	0x3e, 0x72, 0x02, 0xde, 0x73, 0x7a, 0x35, 0xeb, 0x4d, 0x42, 0x6e, 0x7b, 0xc2, 0xd7, 0x38, 0xb7,
+	0x57, 0xdb, 0xf6, 0xa5, 0x57, 0x73, 0x43, 0x2f, 0x68, 0xb6, 0x3d, 0xd9, 0xe4, 0x6d, 0x6d, 0x6a,
+	0x2d, 0x04, 0x9c, 0x07, 0x2d, 0xdf, 0xf5, 0xc2, 0xa6, 0xeb, 0xb5, 0xdb, 0x5c, 0xaa, 0x43, 0x41,
+	0xa7, 0xf3, 0x69, 0x9f, 0x11, 0xbf, 0x3a, 0xb0, 0x6f, 0xc1, 0xdc, 0xa7, 0x11, 0xf1, 0x46, 0x87,
+	0x87, 0x5c, 0x78, 0xad, 0xba, 0xff, 0xb0, 0xeb, 0x0b, 0x89, 0x97, 0x60, 0x2a, 0xa4, 0xad, 0xad,
+	0x66, 0xa3, 0xc2, 0x16, 0xd9, 0xea, 0x58, 0x1d, 0xcc, 0xd6, 0xfd, 0x86, 0xfd, 0x09, 0x9c, 0xcf,
+	0x18, 0x8a, 0x90, 0xb7, 0x85, 0x8f, 0xd7, 0x61, 0xd2, 0xc0, 0x94, 0xd9, 0xd4, 0xda, 0xbc, 0x93,
+	0x0a, 0xcb, 0x89, 0x4d, 0x62, 0xa0, 0xfd, 0x37, 0xcb, 0xd0, 0x09, 0x23, 0x64, 0x1d, 0x5e, 0x8a,
+	0x85, 0x08, 0xe9, 0xc9, 0xae, 0x50, 0xac, 0xb3, 0x6b, 0xaf, 0x0e, 0x60, 0xfd, 0x4c, 0x81, 0xea,
+	0xb3, 0x61, 0x6a, 0x8d, 0x73, 0x30, 0xde, 0xe3, 0xd2, 0xef, 0x54, 0x4a, 0x8b, 0x6c, 0xb5, 0x5c,
+	0xd7, 0x0b, 0x5c, 0x80, 0x72, 0xc3, 0x0f, 0xb9, 0x68, 0x4a, 0xde, 0xa9, 0x8c, 0xaa, 0x93, 0xfe,
+	0x06, 0xae, 0x03, 0xf4, 0xf3, 0x5c, 0x19, 0x53, 0xc1, 0x2c, 0x1b, 0xb7, 0x51, 0x51, 0x1c, 0x5d,
+	0x3c, 0x2a, 0x8a, 0xb3, 0xe1, 0x05, 0x3e, 0xe9, 0xae, 0x27, 0x2c, 0xed, 0xdf, 0x18, 0x5c, 0xc8,
+	0x46, 0x47, 0xd9, 0xba, 0x09, 0x65, 0x23, 0x34, 0x0a, 0x6c, 0xb4, 0x28, 0x5d, 0x7d, 0x24, 0x7e,
+	0x94, 0x52, 0x56, 0x52, 0xca, 0x56, 0x8e, 0x55, 0xa6, 0x7d, 0xa6, 0xa4, 0xdd, 0x87, 0xb3, 0x4a,
+	0xd9, 0xe7, 0x5c, 0xfa, 0xc3, 0xd6, 0x3e, 0x3f, 0x97, 0xf6, 0x1d, 0x78, 0x39, 0x41, 0x45, 0xf1,
+	0xad, 0xc0, 0x58, 0x74, 0x4a, 0x9d, 0x70, 0x2e, 0x13, 0x9a, 0x82, 0x2a, 0x80, 0xfd, 0x28, 0x61,
+	0x2d, 0x86, 0x56, 0xb2, 0x9e, 0x93, 0x87, 0xd3, 0x54, 0xe8, 0x47, 0x06, 0x98, 0x74, 0x4f, 0xea,
+	0xdf, 0xd0, 0x81, 0x9a, 0xca, 0xe4, 0xca, 0xd7, 0x88, 0xe7, 0x57, 0x91, 0x9b, 0xa4, 0x64, 0xc3,
+	0xeb, 0x78, 0x3b, 0xa9, 0x4c, 0xa8, 0x8d, 0x2d, 0xb9, 0x1b, 0xea, 0x74, 0x96, 0xeb, 0xa0, 0xb7,
+	0x36, 0x77, 0x43, 0xdf, 0x7e, 0x52, 0x82, 0x73, 0x29, 0x3b, 0x0a, 0xe1, 0x3d, 0x98, 0xe9, 0x71,
+	0xd9, 0x6c, 0x07, 0x5b, 0x1a, 0x4c, 0x95, 0x78, 0xe5, 0x68, 0x28, 0xcd, 0x76, 0x40, 0xb6, 0xd3,
+	0xbd, 0xc4, 0x0a, 0xef, 0xc1, 0x2c, 0x5d, 0x09, 0x43, 0xa1, 0xa3, 0x5b, 0xc8, 0x50, 0x7c, 0xa0,
+	0x41, 0xc4, 0x31, 0xd3, 0x48, 0x2e, 0xf1, 0x2e, 0x4c, 0x4b, 0xaf, 0xd5, 0xda, 0x35, 0x14, 0xa3,
+	0x8a, 0xc2, 0xca, 0x50, 0x6c, 0x46, 0x10, 0x22, 0x98, 0x92, 0xfd, 0x05, 0x5e, 0x83, 0x09, 0x32,
+	0xd4, 0xb7, 0xf0, 0x7c, 0xf6, 0x8e, 0x68, 0x1b, 0x02, 0xd9, 0x9b, 0x94, 0x0b, 0x92, 0x34, 0x74,
+	0x3b, 0xa5, 0xc6, 0x41, 0x29, 0x33, 0x0e, 0xec, 0x8f, 0x61, 0x2e, 0xcd, 0x4a, 0x29, 0x7e, 0x0b,
+	0xce, 0x10, 0x88, 0x92, 0x7b, 0x21, 0x3f, 0x33, 0x75, 0x03, 0xb3, 0xbf, 0x4b, 0x33, 0xbd, 0xf8,
+	0x7e, 0x7f, 0x6a, 0xe6, 0x6d, 0x5f, 0x01, 0x05, 0xb3, 0x06, 0x93, 0xa4, 0xd2, 0x74, 0xfd, 0xa0,
+	0x68, 0x62, 0xdc, 0xf3, 0xeb, 0xfd, 0x77, 0x60, 0x5e, 0xa9, 0x52, 0x7d, 0x50, 0xf7, 0x45, 0xb7,
+	0x25, 0x4f, 0xf0, 0x20, 0x55, 0x8e, 0xda, 0xc6, 0x15, 0x1a, 0x57, 0xdd, 0x54, 0x61, 0x83, 0xdb,
+	0x8e, 0x4c, 0x34, 0xd0, 0xbe, 0x4d, 0x6c, 0x74, 0x2f, 0xf8, 0xd7, 0x7e, 0x67, 0xe8, 0x2a, 0xd9,
+	0x4f, 0x18, 0x5c, 0xcc, 0xb1, 0x26, 0x31, 0xef, 0xf6, 0x6f, 0xa4, 0x3a, 0xa0, 0x34, 0x5b, 0xf9,
+	0x37, 0x32, 0x82, 0xc4, 0x17, 0x52, 0xe1, 0xf1, 0x2a, 0xa0, 0xe4, 0xd2, 0x6b, 0x6d, 0x25, 0x69,
+	0xa8, 0x5d, 0xcf, 0xaa, 0x93, 0x84, 0xed, 0xda, 0x7f, 0x65, 0x18, 0x57, 0x62, 0xf0, 0x7b, 0x06,
+	0x93, 0xe6, 0x31, 0xc1, 0xa5, 0x8c, 0xbb, 0xbc, 0x7f, 0x01, 0xd6, 0xeb, 0xc5, 0x20, 0x1d, 0x90,
+	0xed, 0x3c, 0xfe, 0xf3, 0xdf, 0x5f, 0x4a, 0xab, 0xb8, 0xec, 0xa6, 0xff, 0x65, 0xc4, 0xcf, 0x95,
+	0xbb, 0x97, 0xc8, 0xd7, 0x3e, 0x7e, 0x0b, 0x65, 0xc3, 0x21, 0xb0, 0xd0, 0x85, 0x49, 0xb9, 0x75,
+	0xf9, 0x18, 0x14, 0x29, 0x59, 0x54, 0x4a, 0x2c, 0xac, 0x0c, 0x52, 0x82, 0x3f, 0x30, 0x18, 0x8b,
+	0xc6, 0x36, 0x5e, 0xca, 0x63, 0x4c, 0xbc, 0x82, 0xd6, 0xe2, 0x60, 0x00, 0x79, 0xbb, 0xa3, 0xbc,
+	0xbd, 0x8d, 0x37, 0x86, 0x8b, 0xdb, 0x55, 0x0f, 0x85, 0xbb, 0x17, 0xfd, 0x74, 0xf6, 0xf1, 0x31,
+	0x83, 0xf1, 0x88, 0x4e, 0xe0, 0x40, 0x4f, 0x71, 0xf8, 0xaf, 0x15, 0x20, 0x48, 0xcc, 0x0d, 0x25,
+	0xc6, 0xc1, 0xab, 0x27, 0x11, 0x83, 0x8f, 0x60, 0x82, 0x26, 0x6c, 0xae, 0x8b, 0xd4, 0x1b, 0x64,
+	0xd9, 0x45, 0x10, 0x92, 0x71, 0x45, 0xc9, 0xb8, 0x8c, 0x4b, 0x59, 0x19, 0x0a, 0xe6, 0xee, 0x25,
+	0x1e, 0xb1, 0x7d, 0xfc, 0x95, 0xc1, 0x19, 0x9a, 0x26, 0x98, 0x4b, 0x9e, 0x9e, 0xdf, 0xd6, 0x52,
+	0x21, 0x86, 0x14, 0xdc, 0x53, 0x0a, 0xee, 0xe2, 0xed, 0x21, 0x13, 0x61, 0xa6, 0x98, 0xbb, 0x17,
+	0x4f, 0xfa, 0x7d, 0xfc, 0x89, 0xc1, 0x24, 0x11, 0x0b, 0x2c, 0x72, 0x2b, 0x0a, 0xaf, 0x4a, 0x76,
+	0xba, 0xda, 0xb7, 0x94, 0xb8, 0x1a, 0xba, 0x27, 0x14, 0x87, 0x4f, 0x19, 0x4c, 0x25, 0xc6, 0x14,
+	0x2e, 0xe7, 0xb9, 0x3b, 0x3a, 0x36, 0xad, 0x95, 0x63, 0x71, 0xa7, 0xec, 0x1f, 0x35, 0x26, 0xf1,
+	0x77, 0x06, 0xd3, 0xc9, 0x21, 0x87, 0x2b, 0x03, 0x3a, 0x35, 0x3b, 0x44, 0xad, 0xd5, 0xe3, 0x81,
+	0xa7, 0xbf, 0x66, 0xfd, 0xe1, 0xfa, 0xfe, 0x87, 0x7f, 0x1c, 0x54, 0xd9, 0xb3, 0x83, 0x2a, 0xfb,
+	0xe7, 0xa0, 0xca, 0x7e, 0x3e, 0xac, 0x8e, 0x3c, 0x3b, 0xac, 0x8e, 0xfc, 0x75, 0x58, 0x1d, 0xf9,
+	0xe2, 0x4a, 0xd0, 0x94, 0x5f, 0x76, 0xb7, 0x9d, 0x07, 0x7c, 0xc7, 0x30, 0xeb, 0x9f, 0x6b, 0xa2,
+	0xf1, 0x95, 0xfb, 0x8d, 0x72, 0x13, 0xf5, 0xa9, 0x88, 0x3e, 0xba, 0x26, 0xd4, 0xe7, 0xd2, 0xf5,
+	0xff, 0x07, 0x00, 0xe2, 0xc4, 0x1e, 0xb3, 0xbd, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -986,6 +1104,9 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// VotingPowers queries the voting power of the voters of a proposal, per
+	// voting power source, as counted by its tally.
+	VotingPowers(ctx context.Context, in *QueryVotingPowersRequest, opts ...grpc.CallOption) (*QueryVotingPowersResponse, error)
 }
 
 type queryClient struct {
@@ -1068,6 +1189,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) VotingPowers(ctx context.Context, in *QueryVotingPowersRequest, opts ...grpc.CallOption) (*QueryVotingPowersResponse, error) {
+	out := new(QueryVotingPowersResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/VotingPowers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1086,6 +1216,9 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// VotingPowers queries the voting power of the voters of a proposal, per
+	// voting power source, as counted by its tally.
+	VotingPowers(context.Context, *QueryVotingPowersRequest) (*QueryVotingPowersResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1116,6 +1249,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) VotingPowers(ctx context.Context, req *QueryVotingPowersRequest) (*QueryVotingPowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPowers not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1265,6 +1401,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VotingPowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/VotingPowers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPowers(ctx, req.(*QueryVotingPowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1301,6 +1455,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "VotingPowers",
+			Handler:    _Query_VotingPowers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -1681,6 +1839,18 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.TallyParams != nil {
 		{
 			size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
@@ -1942,6 +2112,78 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryVotingPowersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalVotingPower) > 0 {
+		i -= len(m.TotalVotingPower)
+		copy(dAtA[i:], m.TotalVotingPower)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalVotingPower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VotingPowers) > 0 {
+		for iNdEx := len(m.VotingPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2116,6 +2358,10 @@ func (m *QueryParamsResponse) Size() (n int) {
 		l = m.TallyParams.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2208,6 +2454,37 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryVotingPowersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryVotingPowersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VotingPowers) > 0 {
+		for _, e := range m.VotingPowers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TotalVotingPower)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3289,6 +3566,42 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3877,6 +4190,191 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVotingPowersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowers = append(m.VotingPowers, &VotingPower{})
+			if err := m.VotingPowers[len(m.VotingPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalVotingPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
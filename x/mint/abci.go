@@ -14,18 +14,26 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	// fetch stored minter & params
+	minter := k.GetMinter(ctx)
 	params := k.GetParams(ctx)
 
-	// skip if all tokens already minted
-	if uint64(ctx.BlockHeight()) >= params.EndBlock {
-		return
+	// recalculate inflation rate
+	supply := k.MintDenomSupply(ctx)
+	bondedRatio := sdk.ZeroDec()
+	if params.Schedule == types.ScheduleBondedRatio {
+		bondedRatio = k.BondedRatio(ctx)
 	}
 
-	monthReward := sdk.NewDecFromInt(params.MonthReward.Amount)
-	mintedAmount := monthReward.QuoInt(sdk.NewInt(int64(params.BlocksPerMonth)))
+	minter, mintedAmount := minter.NextBlock(params, ctx.BlockHeight(), supply, bondedRatio)
+	k.SetMinter(ctx, minter)
+
+	// skip if all tokens already minted or the max supply is reached
+	if !mintedAmount.IsPositive() {
+		return
+	}
 
 	// mint coins, update supply
-	mintedCoin := sdk.NewCoin(params.MintDenom, mintedAmount.TruncateInt())
+	mintedCoin := sdk.NewCoin(params.MintDenom, mintedAmount)
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err := k.MintCoins(ctx, mintedCoins)
@@ -46,6 +54,9 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeKeyBondedRatio, bondedRatio.String()),
+			sdk.NewAttribute(types.AttributeKeyInflation, minter.Inflation.String()),
+			sdk.NewAttribute(types.AttributeKeyAnnualProvisions, minter.AnnualProvisions.String()),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
 		),
 	)
@@ -1,10 +1,14 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -20,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 
 	mintingQueryCmd.AddCommand(
 		GetCmdQueryParams(),
+		GetCmdQueryIssuanceProjection(),
 	)
 
 	return mintingQueryCmd
@@ -53,3 +58,37 @@ func GetCmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryIssuanceProjection implements a command to return the tokens
+// minted by the next blocks under the current schedule.
+func GetCmdQueryIssuanceProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "issuance-projection [blocks]",
+		Short:   "Query the tokens minted by the next blocks under the current schedule",
+		Example: fmt.Sprintf("$ %s query %s issuance-projection 100000", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			blocks, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid blocks: %w", err)
+			}
+
+			res, err := queryClient.IssuanceProjection(cmd.Context(), &types.QueryIssuanceProjectionRequest{Blocks: blocks})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
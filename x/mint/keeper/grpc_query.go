@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -16,3 +17,39 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// IssuanceProjection returns the tokens minted by the next blocks under the
+// current schedule.
+func (k Keeper) IssuanceProjection(c context.Context, req *types.QueryIssuanceProjectionRequest) (*types.QueryIssuanceProjectionResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	// rate-based schedules are projected block by block, hence the lower limit
+	limit := params.Schedule.ProjectionLimit()
+	if req.Blocks == 0 || req.Blocks > limit {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "blocks must be between 1 and %d for the %s", limit, params.Schedule)
+	}
+
+	supply := k.MintDenomSupply(ctx)
+
+	bondedRatio := sdk.ZeroDec()
+	if params.Schedule == types.ScheduleBondedRatio {
+		bondedRatio = k.BondedRatio(ctx)
+	}
+
+	// the tokens of the current block are already minted
+	height := ctx.BlockHeight() + 1
+	issuance, maxSupplyHeight := types.ProjectIssuance(k.GetMinter(ctx), params, height, req.Blocks, supply, bondedRatio)
+
+	return &types.QueryIssuanceProjectionResponse{
+		Schedule:        params.Schedule,
+		EndHeight:       height + int64(req.Blocks) - 1,
+		Issuance:        sdk.NewCoin(params.MintDenom, issuance),
+		Supply:          supply.Add(issuance),
+		MaxSupplyHeight: maxSupplyHeight,
+	}, nil
+}
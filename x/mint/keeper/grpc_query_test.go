@@ -43,6 +43,66 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(params.Params, app.MintKeeper.GetParams(ctx))
 }
 
+func (suite *MintTestSuite) TestGRPCIssuanceProjection() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.IssuanceProjection(gocontext.Background(), &types.QueryIssuanceProjectionRequest{})
+	suite.Require().Error(err)
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.Schedule = types.ScheduleFixedRate
+	suite.Require().NoError(app.MintKeeper.SetParams(ctx, params))
+
+	// rate-based schedules are projected over fewer blocks
+	_, err = queryClient.IssuanceProjection(gocontext.Background(), &types.QueryIssuanceProjectionRequest{Blocks: types.MaxRateBasedProjectedBlocks + 1})
+	suite.Require().Error(err)
+	_, err = queryClient.IssuanceProjection(gocontext.Background(), &types.QueryIssuanceProjectionRequest{Blocks: types.MaxRateBasedProjectedBlocks})
+	suite.Require().NoError(err)
+
+	params.Schedule = types.ScheduleHalving
+	suite.Require().NoError(app.MintKeeper.SetParams(ctx, params))
+
+	_, err = queryClient.IssuanceProjection(gocontext.Background(), &types.QueryIssuanceProjectionRequest{Blocks: types.MaxProjectedBlocks + 1})
+	suite.Require().Error(err)
+
+	supply := app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+
+	params.BlocksPerMonth = 10
+	params.MonthReward = sdk.NewCoin(params.MintDenom, sdk.NewInt(1000))
+	params.HalvingInterval = 50
+	params.MaxSupply = supply.AddRaw(9000)
+	suite.Require().NoError(app.MintKeeper.SetParams(ctx, params))
+
+	res, err := queryClient.IssuanceProjection(gocontext.Background(), &types.QueryIssuanceProjectionRequest{Blocks: 100})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ScheduleHalving, res.Schedule)
+	suite.Require().Equal(ctx.BlockHeight()+100, res.EndHeight)
+	// 49 blocks of 100 tokens, 50 blocks of 50 tokens, then 1 block of 25 tokens
+	suite.Require().Equal(sdk.NewCoin(params.MintDenom, sdk.NewInt(49*100+50*50+25)), res.Issuance)
+	suite.Require().Equal(supply.AddRaw(49*100+50*50+25), res.Supply)
+	suite.Require().Zero(res.MaxSupplyHeight)
+
+	res, err = queryClient.IssuanceProjection(gocontext.Background(), &types.QueryIssuanceProjectionRequest{Blocks: 1000})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(params.MintDenom, sdk.NewInt(9000)), res.Issuance)
+	suite.Require().Equal(params.MaxSupply, res.Supply)
+	// the remaining 9000 - 49*100 - 50*50 - 50*25 tokens are minted by 30
+	// blocks of 12 tokens
+	suite.Require().Equal(ctx.BlockHeight()+179, res.MaxSupplyHeight)
+
+	// the max supply applies to the supply of the mint denom
+	params.MintDenom = "mint"
+	params.MonthReward = sdk.NewCoin(params.MintDenom, sdk.NewInt(1000))
+	params.MaxSupply = sdk.NewInt(6000)
+	suite.Require().NoError(app.MintKeeper.SetParams(ctx, params))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 5000))))
+
+	res, err = queryClient.IssuanceProjection(gocontext.Background(), &types.QueryIssuanceProjectionRequest{Blocks: 100})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(params.MintDenom, sdk.NewInt(1000)), res.Issuance)
+	suite.Require().Equal(params.MaxSupply, res.Supply)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
@@ -0,0 +1,55 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+//
+// - Moving the params from the x/params subspace to the x/mint store.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	// the subspace only holds the fixed reward schedule params
+	params := types.DefaultParams()
+	legacySubspace.GetParamSet(ctx, &params)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
+
+// MigrateSchedule performs the in-place store migration of the minting
+// schedules. The migration includes:
+//
+// - Setting the params of the schedules, the max supply being unset and the
+// fixed reward schedule kept.
+// - Setting the default initial minter.
+func MigrateSchedule(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var legacyParams types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		cdc.MustUnmarshal(bz, &legacyParams)
+	}
+
+	params := types.NewParams(
+		legacyParams.MintDenom, legacyParams.BlocksPerMonth, legacyParams.MonthReward, legacyParams.EndBlock,
+	)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	minter := types.DefaultInitialMinter()
+	store.Set(types.MinterKey, cdc.MustMarshal(&minter))
+
+	return nil
+}
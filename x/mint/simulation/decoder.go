@@ -1,10 +1,12 @@
 package simulation
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 // NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
@@ -12,6 +14,18 @@ import (
 func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
+		case bytes.Equal(kvA.Key, types.MinterKey):
+			var minterA, minterB types.Minter
+			cdc.MustUnmarshal(kvA.Value, &minterA)
+			cdc.MustUnmarshal(kvB.Value, &minterB)
+			return fmt.Sprintf("%v\n%v", minterA, minterB)
+
+		case bytes.Equal(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
 		default:
 			panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 		}
@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -19,6 +20,11 @@ const (
 	InflationMax        = "inflation_max"
 	InflationMin        = "inflation_min"
 	GoalBonded          = "goal_bonded"
+	Schedule            = "schedule"
+	MaxSupply           = "max_supply"
+	FixedInflationRate  = "fixed_inflation_rate"
+	HalvingInterval     = "halving_interval"
+	RewardSteps         = "reward_steps"
 )
 
 // GenInflation randomized Inflation
@@ -46,6 +52,56 @@ func GenGoalBonded(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(67, 2)
 }
 
+// GenBlocksPerMonth randomized BlocksPerMonth
+func GenBlocksPerMonth(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1000, 600000))
+}
+
+// GenEndBlock randomized EndBlock, 0 for none
+func GenEndBlock(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return uint64(simtypes.RandIntBetween(r, 1, 1000000))
+}
+
+// GenSchedule randomized Schedule
+func GenSchedule(r *rand.Rand) types.Schedule {
+	return types.Schedule(r.Intn(len(types.Schedule_name)))
+}
+
+// GenMaxSupply randomized MaxSupply, unset or above the initial supply
+func GenMaxSupply(r *rand.Rand, initialSupply sdk.Int) sdk.Int {
+	if r.Intn(2) == 0 {
+		return sdk.ZeroInt()
+	}
+	return initialSupply.Add(sdk.NewInt(r.Int63n(1e12)))
+}
+
+// GenFixedInflationRate randomized FixedInflationRate
+func GenFixedInflationRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(99)), 2)
+}
+
+// GenHalvingInterval randomized HalvingInterval
+func GenHalvingInterval(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 1000))
+}
+
+// GenRewardSteps randomized RewardSteps
+func GenRewardSteps(r *rand.Rand) []types.RewardStep {
+	steps := make([]types.RewardStep, simtypes.RandIntBetween(r, 1, 5))
+	startBlock := uint64(0)
+	for i := range steps {
+		steps[i] = types.RewardStep{
+			StartBlock:  startBlock,
+			BlockReward: sdk.NewInt(r.Int63n(1e9)),
+		}
+		startBlock += uint64(simtypes.RandIntBetween(r, 1, 100))
+	}
+	return steps
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -80,9 +136,50 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { goalBonded = GenGoalBonded(r) },
 	)
 
-	params := types.DefaultParams()
+	var schedule types.Schedule
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Schedule, &schedule, simState.Rand,
+		func(r *rand.Rand) { schedule = GenSchedule(r) },
+	)
 
-	mintGenesis := types.NewGenesisState(params)
+	var maxSupply sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxSupply, &maxSupply, simState.Rand,
+		func(r *rand.Rand) {
+			maxSupply = GenMaxSupply(r, simState.InitialStake.MulRaw(int64(len(simState.Accounts))))
+		},
+	)
+
+	var fixedInflationRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FixedInflationRate, &fixedInflationRate, simState.Rand,
+		func(r *rand.Rand) { fixedInflationRate = GenFixedInflationRate(r) },
+	)
+
+	var halvingInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, HalvingInterval, &halvingInterval, simState.Rand,
+		func(r *rand.Rand) { halvingInterval = GenHalvingInterval(r) },
+	)
+
+	var rewardSteps []types.RewardStep
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RewardSteps, &rewardSteps, simState.Rand,
+		func(r *rand.Rand) { rewardSteps = GenRewardSteps(r) },
+	)
+
+	params := types.DefaultParams()
+	params.Schedule = schedule
+	params.MaxSupply = maxSupply
+	params.InflationRateChange = inflationRateChange
+	params.InflationMax = inflationMax
+	params.InflationMin = inflationMin
+	params.GoalBonded = goalBonded
+	params.FixedInflationRate = fixedInflationRate
+	params.HalvingInterval = halvingInterval
+	params.RewardSteps = rewardSteps
+
+	mintGenesis := types.NewGenesisState(types.InitialMinter(inflation), params)
 
 	bz, err := json.MarshalIndent(&mintGenesis, "", " ")
 	if err != nil {
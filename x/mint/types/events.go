@@ -3,4 +3,8 @@ package types
 // Minting module event types
 const (
 	EventTypeMint = ModuleName
+
+	AttributeKeyBondedRatio      = "bonded_ratio"
+	AttributeKeyInflation        = "inflation"
+	AttributeKeyAnnualProvisions = "annual_provisions"
 )
@@ -1,8 +1,9 @@
 package types
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params) *GenesisState {
+func NewGenesisState(minter Minter, params Params) *GenesisState {
 	return &GenesisState{
+		Minter: minter,
 		Params: params,
 	}
 }
@@ -10,6 +11,7 @@ func NewGenesisState(params Params) *GenesisState {
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
+		Minter: DefaultInitialMinter(),
 		Params: DefaultParams(),
 	}
 }
@@ -17,5 +19,9 @@ func DefaultGenesisState() *GenesisState {
 // ValidateGenesis validates the provided genesis state to ensure the
 // expected invariants holds.
 func ValidateGenesis(data GenesisState) error {
-	return data.Params.Validate()
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	return ValidateMinter(data.Minter)
 }
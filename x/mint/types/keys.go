@@ -1,5 +1,13 @@
 package types
 
+var (
+	// MinterKey is the key to use for the keeper store.
+	MinterKey = []byte{0x00}
+
+	// ParamsKey is the key to use for the keeper store.
+	ParamsKey = []byte{0x01}
+)
+
 const (
 	// module name
 	ModuleName = "mint"
@@ -7,6 +15,9 @@ const (
 	// StoreKey is the default store key for mint
 	StoreKey = ModuleName
 
+	// RouterKey is the message route for mint
+	RouterKey = ModuleName
+
 	// QuerierRoute is the querier route for the minting store.
 	QuerierRoute = StoreKey
 
@@ -25,21 +25,171 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Schedule defines how the tokens minted every block are computed.
+type Schedule int32
+
+const (
+	// SCHEDULE_FIXED_REWARD mints month_reward / blocks_per_month every block.
+	ScheduleFixedReward Schedule = 0
+	// SCHEDULE_BONDED_RATIO mints at an annual inflation rate moving towards
+	// goal_bonded, between inflation_min and inflation_max.
+	ScheduleBondedRatio Schedule = 1
+	// SCHEDULE_FIXED_RATE mints at the fixed_inflation_rate annual inflation rate.
+	ScheduleFixedRate Schedule = 2
+	// SCHEDULE_HALVING mints month_reward / blocks_per_month every block, halved
+	// every halving_interval blocks.
+	ScheduleHalving Schedule = 3
+	// SCHEDULE_PIECEWISE mints the block_reward of the last of the reward_steps
+	// started.
+	SchedulePiecewise Schedule = 4
+)
+
+var Schedule_name = map[int32]string{
+	0: "SCHEDULE_FIXED_REWARD",
+	1: "SCHEDULE_BONDED_RATIO",
+	2: "SCHEDULE_FIXED_RATE",
+	3: "SCHEDULE_HALVING",
+	4: "SCHEDULE_PIECEWISE",
+}
+
+var Schedule_value = map[string]int32{
+	"SCHEDULE_FIXED_REWARD": 0,
+	"SCHEDULE_BONDED_RATIO": 1,
+	"SCHEDULE_FIXED_RATE":   2,
+	"SCHEDULE_HALVING":      3,
+	"SCHEDULE_PIECEWISE":    4,
+}
+
+func (x Schedule) String() string {
+	return proto.EnumName(Schedule_name, int32(x))
+}
+
+func (Schedule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{0}
+}
+
+// Minter represents the minting state.
+type Minter struct {
+	// current annual inflation rate
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// current annual expected provisions
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+}
+
+func (m *Minter) Reset()         { *m = Minter{} }
+func (m *Minter) String() string { return proto.CompactTextString(m) }
+func (*Minter) ProtoMessage()    {}
+func (*Minter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{0}
+}
+func (m *Minter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Minter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Minter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Minter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Minter.Merge(m, src)
+}
+func (m *Minter) XXX_Size() int {
+	return m.Size()
+}
+func (m *Minter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Minter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Minter proto.InternalMessageInfo
+
+// RewardStep is a step of the piecewise schedule.
+type RewardStep struct {
+	// block from which the step applies
+	StartBlock uint64 `protobuf:"varint,1,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// tokens minted every block of the step
+	BlockReward github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=block_reward,json=blockReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"block_reward"`
+}
+
+func (m *RewardStep) Reset()         { *m = RewardStep{} }
+func (m *RewardStep) String() string { return proto.CompactTextString(m) }
+func (*RewardStep) ProtoMessage()    {}
+func (*RewardStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{1}
+}
+func (m *RewardStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardStep.Merge(m, src)
+}
+func (m *RewardStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardStep proto.InternalMessageInfo
+
+func (m *RewardStep) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
 // Params holds parameters for the mint module.
 type Params struct {
 	// type of coin to mint
 	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
 	// expected blocks per month
 	BlocksPerMonth uint64 `protobuf:"varint,2,opt,name=blocks_per_month,json=blocksPerMonth,proto3" json:"blocks_per_month,omitempty"`
-	// block when no additional tokens will be minted
+	// block when no additional tokens will be minted, 0 for none
 	EndBlock    uint64                                  `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
 	MonthReward github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=month_reward,json=monthReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"month_reward"`
+	// schedule of the tokens minted every block
+	Schedule Schedule `protobuf:"varint,5,opt,name=schedule,proto3,enum=cosmos.mint.v1beta1.Schedule" json:"schedule,omitempty"`
+	// mint denom supply above which no tokens are minted, 0 for no maximum
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	// maximum annual change in inflation rate of the bonded ratio schedule
+	InflationRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=inflation_rate_change,json=inflationRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate_change"`
+	// maximum inflation rate of the bonded ratio schedule
+	InflationMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=inflation_max,json=inflationMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_max"`
+	// minimum inflation rate of the bonded ratio schedule
+	InflationMin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=inflation_min,json=inflationMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_min"`
+	// goal of percent bonded atoms of the bonded ratio schedule
+	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
+	// annual inflation rate of the fixed rate schedule
+	FixedInflationRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=fixed_inflation_rate,json=fixedInflationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fixed_inflation_rate"`
+	// blocks between two halvings of the block reward of the halving schedule
+	HalvingInterval uint64 `protobuf:"varint,12,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+	// steps of the piecewise schedule, ordered by start block
+	RewardSteps []RewardStep `protobuf:"bytes,13,rep,name=reward_steps,json=rewardSteps,proto3" json:"reward_steps"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2df116d183c1e223, []int{0}
+	return fileDescriptor_2df116d183c1e223, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -89,34 +239,167 @@ func (m *Params) GetEndBlock() uint64 {
 	return 0
 }
 
+func (m *Params) GetSchedule() Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return ScheduleFixedReward
+}
+
+func (m *Params) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
+func (m *Params) GetRewardSteps() []RewardStep {
+	if m != nil {
+		return m.RewardSteps
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.mint.v1beta1.Schedule", Schedule_name, Schedule_value)
+	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
+	proto.RegisterType((*RewardStep)(nil), "cosmos.mint.v1beta1.RewardStep")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xbd, 0x4e, 0xc3, 0x30,
-	0x10, 0xc7, 0x63, 0xa8, 0x2a, 0xea, 0x22, 0x84, 0x02, 0x43, 0x28, 0xc2, 0xad, 0x58, 0x08, 0x03,
-	0xb1, 0x0a, 0x1b, 0x63, 0xcb, 0x8a, 0x54, 0x65, 0x64, 0x89, 0xf2, 0x61, 0xb5, 0x51, 0xb1, 0x2f,
-	0xb2, 0xcd, 0xd7, 0x5b, 0x30, 0x32, 0xf2, 0x38, 0x1d, 0x3b, 0x22, 0x86, 0x0a, 0x25, 0x2f, 0x82,
-	0x6c, 0xa7, 0x8c, 0x4c, 0xc9, 0xfd, 0xfe, 0x77, 0x3f, 0xf9, 0x6c, 0x4c, 0x72, 0x50, 0x1c, 0x14,
-	0xe5, 0xa5, 0xd0, 0xf4, 0x79, 0x9c, 0x31, 0x9d, 0x8e, 0x6d, 0x11, 0x55, 0x12, 0x34, 0xf8, 0x47,
-	0x2e, 0x8f, 0x2c, 0x6a, 0xf3, 0xc1, 0xf1, 0x1c, 0xe6, 0x60, 0x73, 0x6a, 0xfe, 0x5c, 0xeb, 0x60,
-	0xab, 0xca, 0x52, 0xc5, 0xfe, 0x54, 0x39, 0x94, 0xc2, 0xe5, 0xe7, 0x0d, 0xc2, 0xdd, 0x59, 0x2a,
-	0x53, 0xae, 0xfc, 0x33, 0x8c, 0x8d, 0x30, 0x29, 0x98, 0x00, 0x1e, 0xa0, 0x11, 0x0a, 0x7b, 0x71,
-	0xcf, 0x90, 0x3b, 0x03, 0xfc, 0x10, 0x1f, 0x66, 0x8f, 0x90, 0x2f, 0x55, 0x52, 0x31, 0x99, 0x70,
-	0x10, 0x7a, 0x11, 0xec, 0x8c, 0x50, 0xd8, 0x89, 0x0f, 0x1c, 0x9f, 0x31, 0x79, 0x6f, 0xa8, 0x7f,
-	0x8a, 0x7b, 0x4c, 0x14, 0x89, 0xa5, 0xc1, 0xae, 0x6d, 0xd9, 0x63, 0xa2, 0x98, 0x98, 0xda, 0xe7,
-	0x78, 0xdf, 0xce, 0x26, 0x92, 0xbd, 0xa4, 0xb2, 0x08, 0x3a, 0x23, 0x14, 0xf6, 0xaf, 0x4f, 0xa2,
-	0x76, 0x25, 0x73, 0xce, 0xed, 0x4a, 0xd1, 0x14, 0x4a, 0x31, 0xa1, 0xab, 0xcd, 0xd0, 0xfb, 0xde,
-	0x0c, 0x2f, 0xe6, 0xa5, 0x5e, 0x3c, 0x65, 0x51, 0x0e, 0x9c, 0xb6, 0x4b, 0xb9, 0xcf, 0x95, 0x2a,
-	0x96, 0x54, 0xbf, 0x55, 0x4c, 0xd9, 0x81, 0xb8, 0x6f, 0xfd, 0xb1, 0xd5, 0xdf, 0x76, 0x3e, 0x3e,
-	0x87, 0xde, 0x64, 0xba, 0xaa, 0x09, 0x5a, 0xd7, 0x04, 0xfd, 0xd4, 0x04, 0xbd, 0x37, 0xc4, 0x5b,
-	0x37, 0xc4, 0xfb, 0x6a, 0x88, 0xf7, 0x70, 0xf9, 0xaf, 0xf5, 0xd5, 0x3d, 0x81, 0x95, 0x67, 0x5d,
-	0x7b, 0x63, 0x37, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x50, 0xfc, 0x36, 0x50, 0x9e, 0x01, 0x00,
-	0x00,
+	// 757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x4e, 0xc3, 0x46,
+	0x10, 0xc6, 0x63, 0x48, 0x43, 0xb2, 0x0e, 0x60, 0x36, 0xa0, 0xba, 0xa9, 0x70, 0x22, 0x0e, 0x6d,
+	0xa8, 0x84, 0x23, 0xd2, 0x53, 0x7b, 0xcb, 0x1f, 0xd3, 0x58, 0x22, 0x90, 0x3a, 0xb4, 0x54, 0xed,
+	0xc1, 0xdd, 0xd8, 0x4b, 0x62, 0x61, 0xef, 0x5a, 0x5e, 0x27, 0x84, 0x5b, 0xd5, 0x53, 0xc5, 0xa9,
+	0xc7, 0x5e, 0x90, 0x2a, 0xf1, 0x08, 0x7d, 0x09, 0x8e, 0x1c, 0xab, 0x1e, 0x50, 0x05, 0x2f, 0x52,
+	0x79, 0xed, 0x18, 0x82, 0x50, 0x55, 0xa5, 0xa7, 0x24, 0xdf, 0xcc, 0xfc, 0x76, 0x67, 0xe6, 0x8b,
+	0x0d, 0x14, 0x8b, 0x32, 0x8f, 0xb2, 0xba, 0xe7, 0x90, 0xb0, 0x3e, 0x3d, 0x1c, 0xe2, 0x10, 0x1d,
+	0xf2, 0x1f, 0xaa, 0x1f, 0xd0, 0x90, 0xc2, 0x52, 0x1c, 0x57, 0xb9, 0x94, 0xc4, 0xcb, 0xdb, 0x23,
+	0x3a, 0xa2, 0x3c, 0x5e, 0x8f, 0xbe, 0xc5, 0xa9, 0xe5, 0x39, 0x6a, 0x88, 0x18, 0x4e, 0x51, 0x16,
+	0x75, 0x48, 0x1c, 0xdf, 0xfb, 0x43, 0x00, 0xb9, 0x9e, 0x43, 0x42, 0x1c, 0xc0, 0x63, 0x50, 0x70,
+	0xc8, 0x85, 0x8b, 0x42, 0x87, 0x12, 0x59, 0xa8, 0x0a, 0xb5, 0x42, 0x4b, 0xbd, 0x7f, 0xac, 0x64,
+	0xfe, 0x7a, 0xac, 0x7c, 0x32, 0x72, 0xc2, 0xf1, 0x64, 0xa8, 0x5a, 0xd4, 0xab, 0x27, 0xc0, 0xf8,
+	0xe3, 0x80, 0xd9, 0x97, 0xf5, 0xf0, 0xda, 0xc7, 0x4c, 0xed, 0x60, 0xcb, 0x78, 0x01, 0xc0, 0x1f,
+	0xc0, 0x16, 0x22, 0x64, 0x82, 0x5c, 0xd3, 0x0f, 0xe8, 0xd4, 0x61, 0x0e, 0x25, 0x4c, 0x5e, 0x59,
+	0x8a, 0x2a, 0xc5, 0xa0, 0x7e, 0xca, 0xd9, 0xfb, 0x49, 0x00, 0xc0, 0xc0, 0x57, 0x28, 0xb0, 0x07,
+	0x21, 0xf6, 0x61, 0x05, 0x88, 0x2c, 0x44, 0x41, 0x68, 0x0e, 0x5d, 0x6a, 0x5d, 0xf2, 0xbb, 0x67,
+	0x0d, 0xc0, 0xa5, 0x56, 0xa4, 0xc0, 0xaf, 0x41, 0x91, 0x87, 0xcc, 0x80, 0x17, 0x2d, 0x71, 0x0f,
+	0x9d, 0x84, 0x86, 0xc8, 0x19, 0xf1, 0xb9, 0x7b, 0x77, 0x6b, 0x20, 0xd7, 0x47, 0x01, 0xf2, 0x18,
+	0xdc, 0x05, 0x20, 0xda, 0x84, 0x69, 0x63, 0x42, 0xbd, 0x78, 0x72, 0x46, 0x21, 0x52, 0x3a, 0x91,
+	0x00, 0x6b, 0x40, 0xe2, 0x85, 0xcc, 0xf4, 0x71, 0x60, 0x7a, 0x94, 0x84, 0x63, 0x7e, 0x81, 0xac,
+	0xb1, 0x11, 0xeb, 0x7d, 0x1c, 0xf4, 0x22, 0x15, 0x7e, 0x0c, 0x0a, 0x98, 0xd8, 0x49, 0x17, 0xab,
+	0x3c, 0x25, 0x8f, 0x89, 0x1d, 0xf7, 0xe0, 0x81, 0x22, 0xaf, 0x9d, 0xf7, 0x90, 0xad, 0x0a, 0x35,
+	0xb1, 0xf1, 0x91, 0x9a, 0x78, 0x21, 0x5a, 0xf0, 0xdc, 0x0b, 0x6a, 0x9b, 0x3a, 0xa4, 0x55, 0x4f,
+	0xda, 0xfb, 0xf4, 0x3f, 0xb4, 0x17, 0x15, 0x18, 0x22, 0xe7, 0xc7, 0xfd, 0xc1, 0x2f, 0x40, 0x9e,
+	0x59, 0x63, 0x6c, 0x4f, 0x5c, 0x2c, 0x7f, 0x50, 0x15, 0x6a, 0x1b, 0x8d, 0x5d, 0xf5, 0x1d, 0xdb,
+	0xa9, 0x83, 0x24, 0xc9, 0x48, 0xd3, 0x61, 0x0f, 0x00, 0x0f, 0xcd, 0x4c, 0x36, 0xf1, 0x7d, 0xf7,
+	0x5a, 0xce, 0x2d, 0x35, 0xeb, 0x82, 0x87, 0x66, 0x03, 0x0e, 0x80, 0x43, 0xb0, 0x93, 0xda, 0xca,
+	0x0c, 0x50, 0x88, 0x4d, 0x6b, 0x8c, 0xc8, 0x08, 0xcb, 0x6b, 0x4b, 0xb9, 0xa9, 0x94, 0xc2, 0x0c,
+	0x14, 0xe2, 0x36, 0x47, 0xc1, 0x01, 0x58, 0x7f, 0x39, 0xc3, 0x43, 0x33, 0x39, 0xbf, 0x14, 0xbb,
+	0x98, 0x42, 0x7a, 0x68, 0xf6, 0x06, 0xea, 0x10, 0xb9, 0xf0, 0x7f, 0xa1, 0x0e, 0x81, 0xa7, 0x40,
+	0x1c, 0x51, 0xe4, 0x9a, 0x43, 0x4a, 0x6c, 0x6c, 0xcb, 0x60, 0x29, 0x24, 0x88, 0x10, 0x2d, 0x4e,
+	0x80, 0x3f, 0x82, 0xed, 0x0b, 0x67, 0x86, 0x6d, 0x73, 0x71, 0xc8, 0xb2, 0xb8, 0x14, 0x19, 0x72,
+	0x96, 0xfe, 0x7a, 0xc4, 0x70, 0x1f, 0x48, 0x63, 0xe4, 0x4e, 0x1d, 0x32, 0x32, 0xf9, 0x93, 0x66,
+	0x8a, 0x5c, 0xb9, 0xc8, 0xdd, 0xbd, 0x99, 0xe8, 0x7a, 0x22, 0xc3, 0x2e, 0x28, 0xc6, 0xf6, 0x36,
+	0x59, 0x88, 0x7d, 0x26, 0xaf, 0x57, 0x57, 0x6b, 0x62, 0xa3, 0xf2, 0xae, 0xf3, 0x5e, 0x1e, 0x00,
+	0xad, 0x6c, 0x74, 0x4b, 0x43, 0x0c, 0x52, 0x85, 0x7d, 0x99, 0xfd, 0xed, 0xf7, 0x4a, 0xe6, 0xb3,
+	0x9f, 0x57, 0x40, 0x7e, 0xee, 0x50, 0xd8, 0x00, 0x3b, 0x83, 0x76, 0x57, 0xeb, 0x7c, 0x73, 0xac,
+	0x99, 0x47, 0xfa, 0x77, 0x5a, 0xc7, 0x34, 0xb4, 0xf3, 0xa6, 0xd1, 0x91, 0x32, 0xe5, 0x0f, 0x6f,
+	0x6e, 0xab, 0xa5, 0x79, 0xe2, 0x51, 0xd4, 0x42, 0xf2, 0x37, 0x78, 0x5d, 0xd3, 0x3a, 0x3d, 0xe9,
+	0x44, 0x45, 0xcd, 0x33, 0xfd, 0x54, 0x12, 0x16, 0x6b, 0xe2, 0x61, 0x1a, 0x51, 0xd3, 0x50, 0x05,
+	0xa5, 0xb7, 0xe7, 0x34, 0xcf, 0x34, 0x69, 0xa5, 0xbc, 0x73, 0x73, 0x5b, 0xdd, 0x5a, 0x3c, 0x25,
+	0x99, 0x4f, 0x9a, 0xdf, 0x6d, 0x1e, 0x7f, 0xab, 0x9f, 0x7c, 0x25, 0xad, 0x96, 0x4b, 0x37, 0xb7,
+	0xd5, 0xcd, 0x79, 0x72, 0x37, 0x9e, 0x13, 0x3c, 0x00, 0x30, 0x4d, 0xed, 0xeb, 0x5a, 0x5b, 0x3b,
+	0xd7, 0x07, 0x9a, 0x94, 0x5d, 0x24, 0xf7, 0x1d, 0x6c, 0xe1, 0x2b, 0x87, 0xe1, 0x72, 0xf6, 0x97,
+	0x3b, 0x25, 0xd3, 0x6a, 0xdf, 0x3f, 0x29, 0xc2, 0xc3, 0x93, 0x22, 0xfc, 0xfd, 0xa4, 0x08, 0xbf,
+	0x3e, 0x2b, 0x99, 0x87, 0x67, 0x25, 0xf3, 0xe7, 0xb3, 0x92, 0xf9, 0x7e, 0xff, 0x5f, 0xb7, 0x3a,
+	0x8b, 0x5f, 0x40, 0x7c, 0xb9, 0xc3, 0x1c, 0x7f, 0x5f, 0x7c, 0xfe, 0xcf, 0x00, 0xa2, 0xd5, 0xd9,
+	0x2d, 0x9c, 0x06, 0x00, 0x00,
+}
+
+func (m *Minter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BlockReward.Size()
+		i -= size
+		if _, err := m.BlockReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartBlock != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -139,6 +422,90 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardSteps) > 0 {
+		for iNdEx := len(m.RewardSteps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardSteps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.HalvingInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HalvingInterval))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.FixedInflationRate.Size()
+		i -= size
+		if _, err := m.FixedInflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.InflationRateChange.Size()
+		i -= size
+		if _, err := m.InflationRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Schedule != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Schedule))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.MonthReward.Size()
 		i -= size
@@ -180,6 +547,33 @@ func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *RewardStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartBlock != 0 {
+		n += 1 + sovMint(uint64(m.StartBlock))
+	}
+	l = m.BlockReward.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -198,6 +592,30 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MonthReward.Size()
 	n += 1 + l + sovMint(uint64(l))
+	if m.Schedule != 0 {
+		n += 1 + sovMint(uint64(m.Schedule))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.FixedInflationRate.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.HalvingInterval != 0 {
+		n += 1 + sovMint(uint64(m.HalvingInterval))
+	}
+	if len(m.RewardSteps) > 0 {
+		for _, e := range m.RewardSteps {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -207,7 +625,7 @@ func sovMint(x uint64) (n int) {
 func sozMint(x uint64) (n int) {
 	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Minter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -230,15 +648,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -266,13 +684,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonth", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
 			}
-			m.BlocksPerMonth = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -282,31 +702,250 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksPerMonth |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
 			}
-			m.EndBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndBlock |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonth", wireType)
+			}
+			m.BlocksPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MonthReward", wireType)
 			}
@@ -339,6 +978,282 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			m.Schedule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Schedule |= Schedule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedInflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FixedInflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			m.HalvingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSteps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardSteps = append(m.RewardSteps, RewardStep{})
+			if err := m.RewardSteps[len(m.RewardSteps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
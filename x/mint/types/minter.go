@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMinter returns a new Minter object with the given inflation and annual
+// provisions values.
+func NewMinter(inflation, annualProvisions sdk.Dec) Minter {
+	return Minter{
+		Inflation:        inflation,
+		AnnualProvisions: annualProvisions,
+	}
+}
+
+// InitialMinter returns an initial Minter object with a given inflation value.
+func InitialMinter(inflation sdk.Dec) Minter {
+	return NewMinter(
+		inflation,
+		sdk.NewDec(0),
+	)
+}
+
+// DefaultInitialMinter returns a default initial Minter object for a new chain
+// which uses an inflation rate of 13%.
+func DefaultInitialMinter() Minter {
+	return InitialMinter(
+		sdk.NewDecWithPrec(13, 2),
+	)
+}
+
+// ValidateMinter validates the minter.
+func ValidateMinter(minter Minter) error {
+	if minter.Inflation.IsNil() || minter.Inflation.IsNegative() {
+		return fmt.Errorf("mint parameter Inflation should be positive, is %s",
+			minter.Inflation)
+	}
+	if minter.AnnualProvisions.IsNil() || minter.AnnualProvisions.IsNegative() {
+		return fmt.Errorf("mint parameter AnnualProvisions should be positive, is %s",
+			minter.AnnualProvisions)
+	}
+	return nil
+}
+
+// NextInflationRate returns the new inflation rate of the bonded ratio
+// schedule for the next block.
+func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec) sdk.Dec {
+	// The target annual inflation rate is recalculated for each block. The
+	// inflation is also subject to a rate change (positive or negative)
+	// depending on the distance from the desired ratio (67%). The maximum rate
+	// change possible is defined to be 13% per year, however the annual
+	// inflation is capped as between 7% and 20%.
+
+	// (1 - bondedRatio/GoalBonded) * InflationRateChange
+	inflationRateChangePerYear := sdk.OneDec().
+		Sub(bondedRatio.Quo(params.GoalBonded)).
+		Mul(params.InflationRateChange)
+	inflationRateChange := inflationRateChangePerYear.Quo(sdk.NewDec(int64(params.BlocksPerYear())))
+
+	// adjust the new annual inflation for this next block
+	inflation := m.Inflation.Add(inflationRateChange) // note inflationRateChange may be negative
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+
+	return inflation
+}
+
+// NextAnnualProvisions returns the annual provisions based on current total
+// supply and inflation rate.
+func (m Minter) NextAnnualProvisions(_ Params, totalSupply math.Int) sdk.Dec {
+	return m.Inflation.MulInt(totalSupply)
+}
+
+// BlockProvision returns the provisions for a block based on the annual
+// provisions rate.
+func (m Minter) BlockProvision(params Params) sdk.Coin {
+	provisionAmt := m.AnnualProvisions.QuoInt(sdk.NewInt(int64(params.BlocksPerYear())))
+	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
+}
+
+// NextBlock returns the minter of the block at height, along with the amount
+// of tokens it mints, given the mint denom supply and the bonded ratio
+// before the block. The bonded ratio is only used by the bonded ratio
+// schedule.
+//
+// The minted amount is capped so that the supply does not exceed the max
+// supply, while the minter keeps the inflation and annual provisions of the
+// schedule.
+func (m Minter) NextBlock(params Params, height int64, supply math.Int, bondedRatio sdk.Dec) (Minter, math.Int) {
+	if params.EndBlock != 0 && uint64(height) >= params.EndBlock {
+		return NewMinter(sdk.ZeroDec(), sdk.ZeroDec()), math.ZeroInt()
+	}
+
+	var minted math.Int
+	switch params.Schedule {
+	case ScheduleBondedRatio, ScheduleFixedRate:
+		if params.Schedule == ScheduleBondedRatio {
+			m.Inflation = m.NextInflationRate(params, bondedRatio)
+		} else {
+			m.Inflation = params.FixedInflationRate
+		}
+		m.AnnualProvisions = m.NextAnnualProvisions(params, supply)
+		minted = m.BlockProvision(params).Amount
+
+	default:
+		minted = params.BlockReward(height)
+		m.AnnualProvisions = sdk.NewDecFromInt(minted.MulRaw(int64(params.BlocksPerYear())))
+		m.Inflation = sdk.ZeroDec()
+		if supply.IsPositive() {
+			m.Inflation = m.AnnualProvisions.QuoInt(supply)
+		}
+	}
+
+	return m, params.capProvision(minted, supply)
+}
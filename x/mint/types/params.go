@@ -24,24 +24,37 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// NewParams returns the params of the fixed reward schedule, the other
+// schedules params being set to their defaults.
 func NewParams(
 	mintDenom string, blocksPerMonth uint64, monthReward sdk.Coin, endBlock uint64,
 ) Params {
-	return Params{
-		MintDenom:      mintDenom,
-		BlocksPerMonth: blocksPerMonth,
-		MonthReward:    monthReward,
-		EndBlock:       endBlock,
-	}
+	params := DefaultParams()
+	params.MintDenom = mintDenom
+	params.BlocksPerMonth = blocksPerMonth
+	params.MonthReward = monthReward
+	params.EndBlock = endBlock
+	return params
 }
 
 // default minting module parameters
 func DefaultParams() Params {
+	blocksPerMonth := uint64(60 * 60 * 24 * 30 / 5) // assuming 5 second block times
+
 	return Params{
-		MintDenom:      sdk.DefaultBondDenom,
-		BlocksPerMonth: uint64(60 * 60 * 24 * 30 / 5), // assuming 5 second block times
-		MonthReward:    sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(223696209754194)),
-		EndBlock:       0,
+		MintDenom:           sdk.DefaultBondDenom,
+		BlocksPerMonth:      blocksPerMonth,
+		MonthReward:         sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(223696209754194)),
+		EndBlock:            0,
+		Schedule:            ScheduleFixedReward,
+		MaxSupply:           sdk.ZeroInt(),
+		InflationRateChange: sdk.NewDecWithPrec(13, 2),
+		InflationMax:        sdk.NewDecWithPrec(20, 2),
+		InflationMin:        sdk.NewDecWithPrec(7, 2),
+		GoalBonded:          sdk.NewDecWithPrec(67, 2),
+		FixedInflationRate:  sdk.NewDecWithPrec(10, 2),
+		HalvingInterval:     blocksPerMonth * 12 * 4, // every 4 years
+		RewardSteps:         []RewardStep{},
 	}
 }
 
@@ -59,6 +72,45 @@ func (p Params) Validate() error {
 	if err := validateEndBlock(p.EndBlock); err != nil {
 		return err
 	}
+	if _, ok := Schedule_name[int32(p.Schedule)]; !ok {
+		return fmt.Errorf("invalid schedule: %d", p.Schedule)
+	}
+	if p.MaxSupply.IsNil() || p.MaxSupply.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", p.MaxSupply)
+	}
+	if err := validateRate("inflation rate change", p.InflationRateChange); err != nil {
+		return err
+	}
+	if err := validateRate("max inflation", p.InflationMax); err != nil {
+		return err
+	}
+	if err := validateRate("min inflation", p.InflationMin); err != nil {
+		return err
+	}
+	if p.InflationMax.LT(p.InflationMin) {
+		return fmt.Errorf(
+			"max inflation (%s) must be greater than or equal to min inflation (%s)",
+			p.InflationMax, p.InflationMin,
+		)
+	}
+	if err := validateRate("goal bonded", p.GoalBonded); err != nil {
+		return err
+	}
+	if !p.GoalBonded.IsPositive() {
+		return fmt.Errorf("goal bonded must be positive: %s", p.GoalBonded)
+	}
+	if err := validateRate("fixed inflation rate", p.FixedInflationRate); err != nil {
+		return err
+	}
+	if p.HalvingInterval == 0 {
+		return fmt.Errorf("halving interval must be positive: %d", p.HalvingInterval)
+	}
+	if err := validateRewardSteps(p.RewardSteps); err != nil {
+		return err
+	}
+	if p.Schedule == SchedulePiecewise && len(p.RewardSteps) == 0 {
+		return errors.New("piecewise schedule must have reward steps")
+	}
 	return nil
 }
 
@@ -114,20 +166,39 @@ func validateMonthReward(i interface{}) error {
 	}
 
 	if !v.Amount.IsPositive() {
-		return fmt.Errorf("month reward must be positive: %d", v)
+		return fmt.Errorf("month reward must be positive: %s", v)
 	}
 
 	return nil
 }
 
 func validateEndBlock(i interface{}) error {
-	v, ok := i.(uint64)
-	if !ok {
+	if _, ok := i.(uint64); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v == 0 {
-		return fmt.Errorf("end block must be positive: %d", v)
+	return nil
+}
+
+func validateRate(name string, v sdk.Dec) error {
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("%s cannot be negative: %s", name, v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("%s too large: %s", name, v)
+	}
+
+	return nil
+}
+
+func validateRewardSteps(steps []RewardStep) error {
+	for i, step := range steps {
+		if step.BlockReward.IsNil() || step.BlockReward.IsNegative() {
+			return fmt.Errorf("reward step %d block reward cannot be negative: %s", i, step.BlockReward)
+		}
+		if i > 0 && step.StartBlock <= steps[i-1].StartBlock {
+			return fmt.Errorf("reward step %d start block must be greater than %d", i, steps[i-1].StartBlock)
+		}
 	}
 
 	return nil
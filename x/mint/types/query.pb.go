@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,33 +114,173 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryIssuanceProjectionRequest is the request type for the
+// Query/IssuanceProjection RPC method.
+type QueryIssuanceProjectionRequest struct {
+	// blocks is the number of blocks to project, starting from the next one.
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *QueryIssuanceProjectionRequest) Reset()         { *m = QueryIssuanceProjectionRequest{} }
+func (m *QueryIssuanceProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuanceProjectionRequest) ProtoMessage()    {}
+func (*QueryIssuanceProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{2}
+}
+func (m *QueryIssuanceProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIssuanceProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIssuanceProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIssuanceProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuanceProjectionRequest.Merge(m, src)
+}
+func (m *QueryIssuanceProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIssuanceProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuanceProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIssuanceProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryIssuanceProjectionRequest) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// QueryIssuanceProjectionResponse is the response type for the
+// Query/IssuanceProjection RPC method.
+type QueryIssuanceProjectionResponse struct {
+	// schedule is the active schedule.
+	Schedule Schedule `protobuf:"varint,1,opt,name=schedule,proto3,enum=cosmos.mint.v1beta1.Schedule" json:"schedule,omitempty"`
+	// end_height is the height of the last projected block.
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// issuance is the amount of tokens minted over the projected blocks.
+	Issuance types.Coin `protobuf:"bytes,3,opt,name=issuance,proto3" json:"issuance"`
+	// supply is the mint denom supply after the projected blocks.
+	Supply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply"`
+	// max_supply_height is the height at which the max supply is reached, 0 if
+	// it is not reached over the projected blocks.
+	MaxSupplyHeight int64 `protobuf:"varint,5,opt,name=max_supply_height,json=maxSupplyHeight,proto3" json:"max_supply_height,omitempty"`
+}
+
+func (m *QueryIssuanceProjectionResponse) Reset()         { *m = QueryIssuanceProjectionResponse{} }
+func (m *QueryIssuanceProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuanceProjectionResponse) ProtoMessage()    {}
+func (*QueryIssuanceProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{3}
+}
+func (m *QueryIssuanceProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIssuanceProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIssuanceProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIssuanceProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuanceProjectionResponse.Merge(m, src)
+}
+func (m *QueryIssuanceProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIssuanceProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuanceProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIssuanceProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryIssuanceProjectionResponse) GetSchedule() Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return ScheduleFixedReward
+}
+
+func (m *QueryIssuanceProjectionResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *QueryIssuanceProjectionResponse) GetIssuance() types.Coin {
+	if m != nil {
+		return m.Issuance
+	}
+	return types.Coin{}
+}
+
+func (m *QueryIssuanceProjectionResponse) GetMaxSupplyHeight() int64 {
+	if m != nil {
+		return m.MaxSupplyHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryIssuanceProjectionRequest)(nil), "cosmos.mint.v1beta1.QueryIssuanceProjectionRequest")
+	proto.RegisterType((*QueryIssuanceProjectionResponse)(nil), "cosmos.mint.v1beta1.QueryIssuanceProjectionResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83, 0x58,
-	0x10, 0xa5, 0x52, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89,
-	0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x50, 0x59, 0x39, 0x6c, 0x36, 0x81,
-	0x4d, 0x05, 0xcb, 0x2b, 0x89, 0x70, 0x09, 0x05, 0x82, 0xec, 0x0d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d,
-	0x0e, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0x0a, 0xe0, 0x12, 0x46, 0x11, 0x2d, 0x2e, 0xc8,
-	0xcf, 0x2b, 0x4e, 0x15, 0xb2, 0xe4, 0x62, 0x2b, 0x00, 0x8b, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70,
-	0x1b, 0x49, 0xeb, 0x61, 0x71, 0xa6, 0x1e, 0x44, 0x93, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41,
-	0x50, 0x0d, 0x46, 0x5d, 0x8c, 0x5c, 0xac, 0x60, 0x23, 0x85, 0x1a, 0x18, 0xb9, 0xd8, 0x20, 0x4a,
-	0x84, 0xd4, 0xb1, 0xea, 0xc7, 0x74, 0x8f, 0x94, 0x06, 0x61, 0x85, 0x10, 0x27, 0x2a, 0x29, 0x37,
-	0x5d, 0x7e, 0x32, 0x99, 0x49, 0x56, 0x48, 0x5a, 0x1f, 0x9b, 0xc7, 0x21, 0x8e, 0x71, 0x72, 0x3e,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xcd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x98, 0x01, 0x10, 0x4a, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0x02, 0x62,
-	0x5a, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x00, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x71, 0x70, 0x9d, 0x1c, 0xcc, 0x01, 0x00, 0x00,
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6a, 0x13, 0x41,
+	0x18, 0xce, 0xa4, 0xe9, 0xd2, 0x8e, 0xa0, 0x38, 0x2d, 0x12, 0x53, 0xb3, 0x09, 0x2b, 0x68, 0x14,
+	0xdc, 0x21, 0x89, 0x07, 0x8b, 0xb7, 0x14, 0xc4, 0xde, 0xe2, 0xf6, 0xe6, 0x25, 0xcc, 0x6e, 0x86,
+	0xcd, 0xda, 0xec, 0xcc, 0x76, 0x67, 0x56, 0x12, 0x44, 0x10, 0x9f, 0x40, 0xf0, 0x35, 0x7c, 0x02,
+	0x5f, 0xc0, 0x1e, 0x0b, 0x5e, 0xc4, 0x43, 0x91, 0xc4, 0x07, 0x91, 0x9d, 0x99, 0x2c, 0xd4, 0x6c,
+	0x94, 0x9e, 0x92, 0x99, 0xff, 0xfb, 0xfe, 0xef, 0xfb, 0xff, 0x6f, 0x16, 0xb6, 0x02, 0x2e, 0x62,
+	0x2e, 0x70, 0x1c, 0x31, 0x89, 0xdf, 0x76, 0x7d, 0x2a, 0x49, 0x17, 0x9f, 0x65, 0x34, 0x9d, 0xbb,
+	0x49, 0xca, 0x25, 0x47, 0x7b, 0x1a, 0xe0, 0xe6, 0x00, 0xd7, 0x00, 0x1a, 0xfb, 0x21, 0x0f, 0xb9,
+	0xaa, 0xe3, 0xfc, 0x9f, 0x86, 0x36, 0xee, 0x85, 0x9c, 0x87, 0x53, 0x8a, 0x49, 0x12, 0x61, 0xc2,
+	0x18, 0x97, 0x44, 0x46, 0x9c, 0x09, 0x53, 0xb5, 0x8d, 0x92, 0x4f, 0x04, 0x2d, 0x94, 0x02, 0x1e,
+	0xb1, 0xbf, 0xea, 0x57, 0x9c, 0x28, 0x55, 0x55, 0x77, 0xf6, 0x21, 0x7a, 0x95, 0xfb, 0x1a, 0x92,
+	0x94, 0xc4, 0xc2, 0xa3, 0x67, 0x19, 0x15, 0xd2, 0x19, 0xc2, 0xbd, 0x2b, 0xb7, 0x22, 0xe1, 0x4c,
+	0x50, 0x74, 0x08, 0xad, 0x44, 0xdd, 0xd4, 0x41, 0x1b, 0x74, 0x6e, 0xf4, 0x0e, 0xdc, 0x92, 0x31,
+	0x5c, 0x4d, 0x1a, 0xd4, 0xce, 0x2f, 0x5b, 0x15, 0xcf, 0x10, 0x9c, 0x67, 0xd0, 0x56, 0x1d, 0x8f,
+	0x85, 0xc8, 0x08, 0x0b, 0xe8, 0x30, 0xe5, 0x6f, 0x68, 0x90, 0x4f, 0x62, 0x34, 0xd1, 0x1d, 0x68,
+	0xf9, 0x53, 0x1e, 0x9c, 0xea, 0xe6, 0x35, 0xcf, 0x9c, 0x9c, 0x2f, 0x55, 0xd8, 0xda, 0x48, 0x2d,
+	0x8c, 0xed, 0x88, 0x60, 0x42, 0xc7, 0xd9, 0x94, 0x2a, 0xf6, 0xcd, 0x5e, 0xb3, 0xd4, 0xda, 0x89,
+	0x01, 0x79, 0x05, 0x1c, 0x35, 0x21, 0xa4, 0x6c, 0x3c, 0x9a, 0xd0, 0x28, 0x9c, 0xc8, 0x7a, 0xb5,
+	0x0d, 0x3a, 0x5b, 0xde, 0x2e, 0x65, 0xe3, 0x97, 0xea, 0x02, 0x3d, 0x87, 0x3b, 0x91, 0xd1, 0xad,
+	0x6f, 0xa9, 0xa1, 0xef, 0xae, 0x3a, 0xe7, 0x2b, 0x2f, 0x3a, 0x1f, 0xf1, 0x88, 0x99, 0x91, 0x0b,
+	0x02, 0x7a, 0x01, 0x2d, 0x91, 0x25, 0xc9, 0x74, 0x5e, 0xaf, 0xb5, 0x41, 0x67, 0x77, 0xe0, 0xe6,
+	0xf5, 0x9f, 0x97, 0xad, 0x07, 0x61, 0x24, 0x27, 0x99, 0xef, 0x06, 0x3c, 0xc6, 0x26, 0x1f, 0xfd,
+	0xf3, 0x44, 0x8c, 0x4f, 0xb1, 0x9c, 0x27, 0x54, 0xb8, 0xc7, 0x4c, 0x7a, 0x86, 0x8d, 0x1e, 0xc3,
+	0xdb, 0x31, 0x99, 0x8d, 0xf4, 0x69, 0x65, 0x75, 0x5b, 0x59, 0xbd, 0x15, 0x93, 0xd9, 0x89, 0xba,
+	0xd7, 0x86, 0x7b, 0xdf, 0xaa, 0x70, 0x5b, 0xad, 0x0b, 0x7d, 0x00, 0xd0, 0xd2, 0x59, 0xa0, 0x87,
+	0xa5, 0xdb, 0x58, 0x0f, 0xbe, 0xd1, 0xf9, 0x3f, 0x50, 0xaf, 0xdc, 0xb9, 0xff, 0xf1, 0xfb, 0xef,
+	0xcf, 0xd5, 0x26, 0x3a, 0xc0, 0x65, 0x2f, 0x4c, 0xa7, 0x8e, 0xbe, 0x02, 0x88, 0xd6, 0x63, 0x43,
+	0xfd, 0xcd, 0x2a, 0x1b, 0xdf, 0x47, 0xe3, 0xe9, 0xf5, 0x48, 0xc6, 0xe6, 0xa1, 0xb2, 0xd9, 0x47,
+	0xdd, 0x52, 0x9b, 0xab, 0xa4, 0x46, 0x49, 0xc1, 0xc4, 0xef, 0xf4, 0xbb, 0x7b, 0x3f, 0x38, 0x3a,
+	0x5f, 0xd8, 0xe0, 0x62, 0x61, 0x83, 0x5f, 0x0b, 0x1b, 0x7c, 0x5a, 0xda, 0x95, 0x8b, 0xa5, 0x5d,
+	0xf9, 0xb1, 0xb4, 0x2b, 0xaf, 0x1f, 0xfd, 0x33, 0xbf, 0x99, 0xd6, 0x50, 0x31, 0xfa, 0x96, 0xfa,
+	0xcc, 0xfa, 0x7f, 0x06, 0x00, 0x36, 0x19, 0x1b, 0x0f, 0x12, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +297,9 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params returns the total set of minting parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// IssuanceProjection projects the tokens minted over the next blocks under
+	// the active schedule, assuming the bonded ratio does not change.
+	IssuanceProjection(ctx context.Context, in *QueryIssuanceProjectionRequest, opts ...grpc.CallOption) (*QueryIssuanceProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -174,10 +319,22 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) IssuanceProjection(ctx context.Context, in *QueryIssuanceProjectionRequest, opts ...grpc.CallOption) (*QueryIssuanceProjectionResponse, error) {
+	out := new(QueryIssuanceProjectionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/IssuanceProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// IssuanceProjection projects the tokens minted over the next blocks under
+	// the active schedule, assuming the bonded ratio does not change.
+	IssuanceProjection(context.Context, *QueryIssuanceProjectionRequest) (*QueryIssuanceProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -187,6 +344,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) IssuanceProjection(ctx context.Context, req *QueryIssuanceProjectionRequest) (*QueryIssuanceProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuanceProjection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -210,6 +370,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IssuanceProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIssuanceProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IssuanceProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/IssuanceProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IssuanceProjection(ctx, req.(*QueryIssuanceProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -218,6 +396,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "IssuanceProjection",
+			Handler:    _Query_IssuanceProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -279,6 +461,92 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryIssuanceProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuanceProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuanceProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIssuanceProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuanceProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuanceProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSupplyHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxSupplyHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Issuance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Schedule != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Schedule))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -310,6 +578,40 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryIssuanceProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *QueryIssuanceProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schedule != 0 {
+		n += 1 + sovQuery(uint64(m.Schedule))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	l = m.Issuance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MaxSupplyHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxSupplyHeight))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -449,6 +751,249 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryIssuanceProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIssuanceProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIssuanceProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIssuanceProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIssuanceProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIssuanceProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			m.Schedule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Schedule |= Schedule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Issuance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyHeight", wireType)
+			}
+			m.MaxSupplyHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupplyHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
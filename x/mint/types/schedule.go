@@ -0,0 +1,185 @@
+package types
+
+import (
+	"fmt"
+	gomath "math"
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxProjectedBlocks is the maximum number of blocks of an issuance
+	// projection.
+	MaxProjectedBlocks = 10_000_000
+
+	// MaxRateBasedProjectedBlocks is the maximum number of blocks of an
+	// issuance projection under a rate-based schedule, which is projected
+	// block by block.
+	MaxRateBasedProjectedBlocks = 10_000
+)
+
+// IsRateBased returns true if the tokens minted by the schedule depend on the
+// mint denom supply.
+func (s Schedule) IsRateBased() bool {
+	return s == ScheduleBondedRatio || s == ScheduleFixedRate
+}
+
+// ProjectionLimit returns the maximum number of blocks of an issuance
+// projection under the schedule.
+func (s Schedule) ProjectionLimit() uint64 {
+	if s.IsRateBased() {
+		return MaxRateBasedProjectedBlocks
+	}
+	return MaxProjectedBlocks
+}
+
+// ScheduleFromString returns a Schedule from a string. It returns an error if
+// the string is invalid.
+func ScheduleFromString(str string) (Schedule, error) {
+	schedule, ok := Schedule_value[str]
+	if !ok {
+		return ScheduleFixedReward, fmt.Errorf("'%s' is not a valid schedule", str)
+	}
+	return Schedule(schedule), nil
+}
+
+// BlocksPerYear returns the expected blocks per year.
+func (p Params) BlocksPerYear() uint64 {
+	return p.BlocksPerMonth * 12
+}
+
+// BlockReward returns the tokens minted at height by the fixed reward, halving
+// and piecewise schedules, before the max supply is applied.
+func (p Params) BlockReward(height int64) math.Int {
+	switch p.Schedule {
+	case SchedulePiecewise:
+		reward := math.ZeroInt()
+		for _, step := range p.RewardSteps {
+			if step.StartBlock > uint64(height) {
+				break
+			}
+			reward = step.BlockReward
+		}
+		return reward
+
+	case ScheduleHalving:
+		halvings := uint64(height) / p.HalvingInterval
+		if halvings >= math.MaxBitLen {
+			return math.ZeroInt()
+		}
+		return math.NewIntFromBigInt(new(big.Int).Rsh(p.fixedBlockReward().BigInt(), uint(halvings)))
+
+	default:
+		return p.fixedBlockReward()
+	}
+}
+
+// fixedBlockReward returns the tokens minted every block by the fixed reward
+// schedule.
+func (p Params) fixedBlockReward() math.Int {
+	monthReward := sdk.NewDecFromInt(p.MonthReward.Amount)
+	return monthReward.QuoInt(sdk.NewIntFromUint64(p.BlocksPerMonth)).TruncateInt()
+}
+
+// nextRewardChange returns the first height after height at which the block
+// reward of the fixed reward, halving and piecewise schedules may change.
+func (p Params) nextRewardChange(height int64) int64 {
+	next := int64(gomath.MaxInt64)
+
+	switch p.Schedule {
+	case SchedulePiecewise:
+		for _, step := range p.RewardSteps {
+			if step.StartBlock > uint64(height) {
+				if step.StartBlock < uint64(next) {
+					next = int64(step.StartBlock)
+				}
+				break
+			}
+		}
+
+	case ScheduleHalving:
+		halvings := uint64(height)/p.HalvingInterval + 1
+		if halvings <= uint64(gomath.MaxInt64)/p.HalvingInterval {
+			next = int64(halvings * p.HalvingInterval)
+		}
+	}
+
+	if p.EndBlock != 0 && p.EndBlock > uint64(height) && p.EndBlock < uint64(next) {
+		next = int64(p.EndBlock)
+	}
+
+	return next
+}
+
+// capProvision returns the part of minted which can be minted without the
+// supply exceeding the max supply.
+func (p Params) capProvision(minted, supply math.Int) math.Int {
+	if !p.MaxSupply.IsPositive() {
+		return minted
+	}
+
+	remaining := p.MaxSupply.Sub(supply)
+	if !remaining.IsPositive() {
+		return math.ZeroInt()
+	}
+
+	return math.MinInt(minted, remaining)
+}
+
+// ProjectIssuance projects the tokens minted by the blocks from height to
+// height + blocks - 1, given the minter and the mint denom supply before
+// them, assuming the bonded ratio does not change. It returns the tokens
+// minted and the height at which the max supply is reached, 0 if it is not
+// reached by these blocks.
+func ProjectIssuance(
+	minter Minter, params Params, height int64, blocks uint64, supply math.Int, bondedRatio sdk.Dec,
+) (issuance math.Int, maxSupplyHeight int64) {
+	issuance = math.ZeroInt()
+	end := height + int64(blocks)
+
+	for h := height; h < end; {
+		if params.EndBlock != 0 && uint64(h) >= params.EndBlock {
+			break
+		}
+		if params.MaxSupply.IsPositive() && supply.GTE(params.MaxSupply) {
+			break
+		}
+
+		var (
+			minted math.Int
+			n      int64 = 1
+		)
+		if params.Schedule.IsRateBased() {
+			minter, minted = minter.NextBlock(params, h, supply, bondedRatio)
+		} else {
+			// the block reward is constant until the next change, so the
+			// blocks up to it are projected at once
+			n = params.nextRewardChange(h)
+			if n > end {
+				n = end
+			}
+			n -= h
+			reward := params.BlockReward(h)
+			minted = params.capProvision(reward.MulRaw(n), supply)
+
+			if minted.LT(reward.MulRaw(n)) {
+				// the max supply is reached by the ceil(minted / reward)th block
+				n = minted.Add(reward).SubRaw(1).Quo(reward).Int64()
+			}
+		}
+
+		supply = supply.Add(minted)
+		issuance = issuance.Add(minted)
+		h += n
+
+		if params.MaxSupply.IsPositive() && supply.GTE(params.MaxSupply) {
+			maxSupplyHeight = h - 1
+			break
+		}
+	}
+
+	return issuance, maxSupplyHeight
+}
@@ -0,0 +1,154 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestBlockReward(t *testing.T) {
+	params := types.DefaultParams()
+	params.BlocksPerMonth = 10
+	params.MonthReward = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1005)
+	params.HalvingInterval = 100
+	params.RewardSteps = []types.RewardStep{
+		{StartBlock: 10, BlockReward: sdk.NewInt(7)},
+		{StartBlock: 20, BlockReward: sdk.NewInt(3)},
+	}
+
+	tests := []struct {
+		schedule types.Schedule
+		height   int64
+		expected int64
+	}{
+		{types.ScheduleFixedReward, 1, 100},
+		{types.ScheduleFixedReward, 1000, 100},
+		{types.ScheduleHalving, 99, 100},
+		{types.ScheduleHalving, 100, 50},
+		{types.ScheduleHalving, 250, 25},
+		{types.ScheduleHalving, 100 * 300, 0},
+		{types.SchedulePiecewise, 9, 0},
+		{types.SchedulePiecewise, 10, 7},
+		{types.SchedulePiecewise, 19, 7},
+		{types.SchedulePiecewise, 1000, 3},
+	}
+	for _, tc := range tests {
+		params.Schedule = tc.schedule
+		require.Equal(t, sdk.NewInt(tc.expected), params.BlockReward(tc.height), "%s at %d", tc.schedule, tc.height)
+	}
+}
+
+func TestNextBlockMaxSupply(t *testing.T) {
+	params := types.DefaultParams()
+	params.BlocksPerMonth = 10
+	params.MonthReward = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	params.MaxSupply = sdk.NewInt(1050)
+	minter := types.DefaultInitialMinter()
+
+	_, minted := minter.NextBlock(params, 1, sdk.NewInt(900), sdk.ZeroDec())
+	require.Equal(t, sdk.NewInt(100), minted)
+
+	_, minted = minter.NextBlock(params, 2, sdk.NewInt(1000), sdk.ZeroDec())
+	require.Equal(t, sdk.NewInt(50), minted)
+
+	_, minted = minter.NextBlock(params, 3, sdk.NewInt(1050), sdk.ZeroDec())
+	require.True(t, minted.IsZero())
+
+	params.MaxSupply = sdk.ZeroInt()
+	params.EndBlock = 3
+	_, minted = minter.NextBlock(params, 3, sdk.NewInt(1050), sdk.ZeroDec())
+	require.True(t, minted.IsZero())
+}
+
+// TestProjectIssuance checks the projection against minting block by block.
+func TestProjectIssuance(t *testing.T) {
+	params := types.DefaultParams()
+	params.BlocksPerMonth = 100
+	params.MonthReward = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)
+	params.HalvingInterval = 150
+	params.RewardSteps = []types.RewardStep{
+		{StartBlock: 50, BlockReward: sdk.NewInt(20_000)},
+		{StartBlock: 300, BlockReward: sdk.NewInt(5_000)},
+	}
+
+	for schedule := range types.Schedule_name {
+		for _, maxSupply := range []int64{0, 4_000_000} {
+			for _, endBlock := range []uint64{0, 400} {
+				params.Schedule = types.Schedule(schedule)
+				params.MaxSupply = sdk.NewInt(maxSupply)
+				params.EndBlock = endBlock
+				require.NoError(t, params.Validate())
+
+				minter := types.DefaultInitialMinter()
+				supply := sdk.NewInt(1_000_000)
+				bondedRatio := sdk.NewDecWithPrec(5, 1)
+
+				issuance, maxSupplyHeight := types.ProjectIssuance(minter, params, 10, 500, supply, bondedRatio)
+
+				expected, expectedHeight := sdk.ZeroInt(), int64(0)
+				for h := int64(10); h < 510; h++ {
+					var minted sdk.Int
+					minter, minted = minter.NextBlock(params, h, supply, bondedRatio)
+					supply = supply.Add(minted)
+					expected = expected.Add(minted)
+					if expectedHeight == 0 && maxSupply != 0 && supply.Equal(params.MaxSupply) {
+						expectedHeight = h
+					}
+				}
+
+				require.Equal(t, expected, issuance, "%s, max supply %d, end block %d", params.Schedule, maxSupply, endBlock)
+				require.Equal(t, expectedHeight, maxSupplyHeight, "%s, max supply %d, end block %d", params.Schedule, maxSupply, endBlock)
+			}
+		}
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(*types.Params)
+		expErr   bool
+	}{
+		{"default", func(*types.Params) {}, false},
+		{"no end block", func(p *types.Params) { p.EndBlock = 0 }, false},
+		{"invalid schedule", func(p *types.Params) { p.Schedule = 5 }, true},
+		{"negative max supply", func(p *types.Params) { p.MaxSupply = sdk.NewInt(-1) }, true},
+		{"max inflation too large", func(p *types.Params) { p.InflationMax = sdk.NewDec(2) }, true},
+		{"max inflation below min", func(p *types.Params) { p.InflationMax = sdk.NewDecWithPrec(5, 2) }, true},
+		{"zero goal bonded", func(p *types.Params) { p.GoalBonded = sdk.ZeroDec() }, true},
+		{"zero halving interval", func(p *types.Params) { p.HalvingInterval = 0 }, true},
+		{"piecewise without steps", func(p *types.Params) { p.Schedule = types.SchedulePiecewise }, true},
+		{
+			"unordered steps",
+			func(p *types.Params) {
+				p.RewardSteps = []types.RewardStep{
+					{StartBlock: 10, BlockReward: sdk.NewInt(1)},
+					{StartBlock: 10, BlockReward: sdk.NewInt(2)},
+				}
+			},
+			true,
+		},
+		{
+			"negative step reward",
+			func(p *types.Params) {
+				p.RewardSteps = []types.RewardStep{{StartBlock: 10, BlockReward: sdk.NewInt(-1)}}
+			},
+			true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.malleate(&params)
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
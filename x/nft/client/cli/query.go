@@ -39,6 +39,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryOwner(),
 		GetCmdQueryBalance(),
 		GetCmdQuerySupply(),
+		GetCmdQueryRoyalty(),
 	)
 	return nftQueryCmd
 }
@@ -274,3 +275,34 @@ func GetCmdQuerySupply() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryRoyalty implements the query royalty command.
+func GetCmdQueryRoyalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "royalty [class-id] [sale-price]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the royalty due on the sale of an NFT of a given class for a sale price.",
+		Example: fmt.Sprintf(`$ %s query %s royalty <class-id> 1000stake`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			salePrice, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.Royalty(cmd.Context(), &nft.QueryRoyaltyRequest{
+				ClassId:   args[0],
+				SalePrice: salePrice,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
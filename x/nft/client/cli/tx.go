@@ -9,10 +9,27 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
+// Flag names of the tx commands
+const (
+	FlagName            = "name"
+	FlagSymbol          = "symbol"
+	FlagDescription     = "description"
+	FlagURI             = "uri"
+	FlagURIHash         = "uri-hash"
+	FlagMintRestricted  = "mint-restricted"
+	FlagBurnable        = "burnable"
+	FlagUpdatable       = "updatable"
+	FlagNonTransferable = "non-transferable"
+	FlagReceiver        = "receiver"
+	FlagRoyaltyReceiver = "royalty-receiver"
+	FlagRoyaltyRate     = "royalty-rate"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -26,6 +43,12 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdNewClass(),
+		NewCmdUpdateClass(),
+		NewCmdTransferClass(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdUpdate(),
 	)
 
 	return nftTxCmd
@@ -58,3 +81,276 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewCmdNewClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "new-class [class-id] --from [creator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create a new nft class owned by the creator",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s new-class <class-id> --name <name> --symbol <symbol> --uri <uri> --mint-restricted --burnable --royalty-receiver <receiver> --royalty-rate 0.05 --from <creator> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgNewClass{
+				Creator: clientCtx.GetFromAddress().String(),
+				Id:      args[0],
+			}
+			msg.Name, msg.Symbol, msg.Description, msg.Uri, msg.UriHash, err = parseClassMetadataFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if msg.Royalty, err = parseRoyaltyFlags(cmd); err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			if msg.MintRestricted, err = flagSet.GetBool(FlagMintRestricted); err != nil {
+				return err
+			}
+			if msg.Burnable, err = flagSet.GetBool(FlagBurnable); err != nil {
+				return err
+			}
+			if msg.Updatable, err = flagSet.GetBool(FlagUpdatable); err != nil {
+				return err
+			}
+			if msg.NonTransferable, err = flagSet.GetBool(FlagNonTransferable); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addClassMetadataFlags(cmd)
+	cmd.Flags().Bool(FlagMintRestricted, false, "Restrict the minting of nfts of the class to its owner")
+	cmd.Flags().Bool(FlagBurnable, false, "Allow the owners of nfts of the class to burn them")
+	cmd.Flags().Bool(FlagUpdatable, false, "Allow the owner of the class to update its nfts")
+	cmd.Flags().Bool(FlagNonTransferable, false, "Prevent the nfts of the class from being sent")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdUpdateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class [class-id] --from [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "update the metadata of a nft class, unset metadata flags are cleared",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update-class <class-id> --name <name> --symbol <symbol> --uri <uri> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgUpdateClass{
+				Owner: clientCtx.GetFromAddress().String(),
+				Id:    args[0],
+			}
+			msg.Name, msg.Symbol, msg.Description, msg.Uri, msg.UriHash, err = parseClassMetadataFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if msg.Royalty, err = parseRoyaltyFlags(cmd); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addClassMetadataFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdTransferClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-class [class-id] [receiver] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "transfer ownership of nft class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s transfer-class <class-id> <receiver> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgTransferClass{
+				Owner:    clientCtx.GetFromAddress().String(),
+				ClassId:  args[0],
+				Receiver: args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] --from [minter]",
+		Args:  cobra.ExactArgs(2),
+		Short: "mint a new nft of a class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint <class-id> <nft-id> --uri <uri> --receiver <receiver> --from <minter> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgMint{
+				Minter:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+			msg.Uri, msg.UriHash, err = parseNFTMetadataFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if msg.Receiver, err = cmd.Flags().GetString(FlagReceiver); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addNFTMetadataFlags(cmd)
+	cmd.Flags().String(FlagReceiver, "", "The owner of the minted nft, defaults to the minter")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [nft-id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn a nft of a burnable class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn <class-id> <nft-id> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgBurn{
+				Owner:   clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [class-id] [nft-id] --from [class-owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "update the metadata of a nft of an updatable class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update <class-id> <nft-id> --uri <uri> --uri-hash <uri-hash> --from <class-owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgUpdate{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+			msg.Uri, msg.UriHash, err = parseNFTMetadataFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addNFTMetadataFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addClassMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagName, "", "The human-readable name of the class")
+	cmd.Flags().String(FlagSymbol, "", "The abbreviated name of the class")
+	cmd.Flags().String(FlagDescription, "", "The description of the class")
+	cmd.Flags().String(FlagRoyaltyReceiver, "", "The receiver of the royalties on the sales of the nfts of the class")
+	cmd.Flags().String(FlagRoyaltyRate, "", "The fraction of the sale prices due as royalty, e.g. 0.05")
+	addNFTMetadataFlags(cmd)
+}
+
+func parseClassMetadataFlags(cmd *cobra.Command) (name, symbol, description, uri, uriHash string, err error) {
+	flagSet := cmd.Flags()
+	if name, err = flagSet.GetString(FlagName); err != nil {
+		return
+	}
+	if symbol, err = flagSet.GetString(FlagSymbol); err != nil {
+		return
+	}
+	if description, err = flagSet.GetString(FlagDescription); err != nil {
+		return
+	}
+	uri, uriHash, err = parseNFTMetadataFlags(cmd)
+	return
+}
+
+// parseRoyaltyFlags returns the royalty of the class, nil if the royalty flags
+// are not set.
+func parseRoyaltyFlags(cmd *cobra.Command) (*nft.RoyaltyInfo, error) {
+	flagSet := cmd.Flags()
+	receiver, err := flagSet.GetString(FlagRoyaltyReceiver)
+	if err != nil {
+		return nil, err
+	}
+	rateStr, err := flagSet.GetString(FlagRoyaltyRate)
+	if err != nil {
+		return nil, err
+	}
+	if receiver == "" && rateStr == "" {
+		return nil, nil
+	}
+
+	rate, err := sdk.NewDecFromStr(rateStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid royalty rate %q: %w", rateStr, err)
+	}
+	return &nft.RoyaltyInfo{Receiver: receiver, Rate: rate}, nil
+}
+
+func addNFTMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagURI, "", "The uri of the metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by the uri")
+}
+
+func parseNFTMetadataFlags(cmd *cobra.Command) (uri, uriHash string, err error) {
+	flagSet := cmd.Flags()
+	if uri, err = flagSet.GetString(FlagURI); err != nil {
+		return
+	}
+	uriHash, err = flagSet.GetString(FlagURIHash)
+	return
+}
@@ -4,11 +4,21 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
+		&MsgNewClass{},
+		&MsgUpdateClass{},
+		&MsgTransferClass{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgUpdate{},
+	)
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&TransferAuthorization{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -16,3 +16,11 @@ type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 }
+
+// NFTHooks event hooks for the transfers of nfts, which other modules can use
+// to enforce class specific rules, e.g. charging royalties.
+type NFTHooks interface {
+	// BeforeTransfer is called before a nft is transferred from sender to
+	// receiver. Returning an error vetoes the transfer.
+	BeforeTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+}
@@ -0,0 +1,24 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// combine multiple nft hooks, all hook functions are run in array sequence
+var _ NFTHooks = &MultiNFTHooks{}
+
+type MultiNFTHooks []NFTHooks
+
+func NewMultiNFTHooks(hooks ...NFTHooks) MultiNFTHooks {
+	return hooks
+}
+
+func (h MultiNFTHooks) BeforeTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].BeforeTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -65,3 +65,38 @@ func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(classStoreKey(classID))
 }
+
+// GetRoyalty returns the receiver and the amount of the royalty due on the sale
+// of a nft of the specified class at salePrice, rounded down. The receiver is
+// nil and the royalty zero if the class has no royalty.
+func (k Keeper) GetRoyalty(ctx sdk.Context, classID string, salePrice sdk.Coin) (sdk.AccAddress, sdk.Coin, error) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return nil, sdk.Coin{}, sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	royalty := sdk.NewCoin(salePrice.Denom, sdk.ZeroInt())
+	if class.Royalty == nil {
+		return nil, royalty, nil
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(class.Royalty.Receiver)
+	if err != nil {
+		return nil, sdk.Coin{}, err
+	}
+	royalty.Amount = class.Royalty.Rate.MulInt(salePrice.Amount).TruncateInt()
+	return receiver, royalty, nil
+}
+
+// getOwnedClass returns the class of the specified id if it is owned by owner
+func (k Keeper) getOwnedClass(ctx sdk.Context, classID string, owner string) (nft.Class, error) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return nft.Class{}, sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if class.Owner == "" || class.Owner != owner {
+		return nft.Class{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft class %s", owner, classID)
+	}
+	return class, nil
+}
@@ -198,3 +198,31 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// Royalty return the royalty due on the sale of an NFT of a given class for a sale price, similar to royaltyInfo in
+// ERC2981
+func (k Keeper) Royalty(goCtx context.Context, r *nft.QueryRoyaltyRequest) (*nft.QueryRoyaltyResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+
+	if err := r.SalePrice.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid sale price: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	receiver, royalty, err := k.GetRoyalty(ctx, r.ClassId, r.SalePrice)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &nft.QueryRoyaltyResponse{Royalty: royalty}
+	if receiver != nil {
+		res.Receiver = receiver.String()
+	}
+	return res, nil
+}
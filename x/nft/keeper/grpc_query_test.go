@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -590,3 +591,66 @@ func (s *TestSuite) TestClasses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestRoyalty() {
+	err := s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{
+		Id: testClassID,
+		Royalty: &nft.RoyaltyInfo{
+			Receiver: s.addrs[0].String(),
+			Rate:     sdk.NewDecWithPrec(25, 3),
+		},
+	})
+	s.Require().NoError(err)
+	err = s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: "kitty2"})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		msg      string
+		req      *nft.QueryRoyaltyRequest
+		expError string
+		expRes   *nft.QueryRoyaltyResponse
+	}{
+		{
+			"fail empty ClassId",
+			&nft.QueryRoyaltyRequest{SalePrice: sdk.NewInt64Coin("stake", 1000)},
+			"invalid class id",
+			nil,
+		},
+		{
+			"fail invalid sale price",
+			&nft.QueryRoyaltyRequest{ClassId: testClassID, SalePrice: sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}},
+			"invalid sale price",
+			nil,
+		},
+		{
+			"fail ClassId not exist",
+			&nft.QueryRoyaltyRequest{ClassId: "kitty3", SalePrice: sdk.NewInt64Coin("stake", 1000)},
+			"nft class does not exist",
+			nil,
+		},
+		{
+			"success without royalty",
+			&nft.QueryRoyaltyRequest{ClassId: "kitty2", SalePrice: sdk.NewInt64Coin("stake", 1000)},
+			"",
+			&nft.QueryRoyaltyResponse{Royalty: sdk.NewInt64Coin("stake", 0)},
+		},
+		{
+			"success rounded down",
+			&nft.QueryRoyaltyRequest{ClassId: testClassID, SalePrice: sdk.NewInt64Coin("stake", 1039)},
+			"",
+			&nft.QueryRoyaltyResponse{Receiver: s.addrs[0].String(), Royalty: sdk.NewInt64Coin("stake", 25)},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := s.queryClient.Royalty(gocontext.Background(), tc.req)
+			if tc.expError != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expRes, res)
+		})
+	}
+}
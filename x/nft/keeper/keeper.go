@@ -11,6 +11,7 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 	bk       nft.BankKeeper
+	hooks    nft.NFTHooks
 }
 
 // NewKeeper creates a new nft Keeper instance
@@ -28,3 +29,14 @@ func NewKeeper(key storetypes.StoreKey,
 		bk:       bk,
 	}
 }
+
+// SetHooks sets the nft hooks, which are called on every transfer of a nft.
+func (k *Keeper) SetHooks(nh nft.NFTHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set nft hooks twice")
+	}
+
+	k.hooks = nh
+
+	return k
+}
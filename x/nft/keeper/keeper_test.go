@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -298,6 +300,42 @@ func (s *TestSuite) TestTransfer() {
 	s.Require().EqualValues([]nft.NFT{expNFT}, actNFTs)
 }
 
+// transferHooks records the transfers of nfts and vetoes the ones to vetoed.
+type transferHooks struct {
+	vetoed    sdk.AccAddress
+	transfers []string
+}
+
+func (h *transferHooks) BeforeTransfer(_ sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	if receiver.Equals(h.vetoed) {
+		return sdkerrors.ErrUnauthorized.Wrapf("cannot transfer to %s", receiver)
+	}
+	h.transfers = append(h.transfers, fmt.Sprintf("%s/%s:%s->%s", classID, nftID, sender, receiver))
+	return nil
+}
+
+func (s *TestSuite) TestTransferHooks() {
+	hooks := &transferHooks{vetoed: s.addrs[2]}
+	k := s.app.NFTKeeper
+	k.SetHooks(nft.NewMultiNFTHooks(hooks))
+	s.Require().Panics(func() { k.SetHooks(hooks) })
+
+	err := k.SaveClass(s.ctx, nft.Class{Id: testClassID})
+	s.Require().NoError(err)
+	err = k.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0])
+	s.Require().NoError(err)
+
+	err = k.Transfer(s.ctx, testClassID, testID, s.addrs[1])
+	s.Require().NoError(err)
+	s.Require().Equal([]string{fmt.Sprintf("%s/%s:%s->%s", testClassID, testID, s.addrs[0], s.addrs[1])}, hooks.transfers)
+
+	// the hook vetoes the transfer
+	err = k.Transfer(s.ctx, testClassID, testID, s.addrs[2])
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	s.Require().Equal(s.addrs[1], k.GetOwner(s.ctx, testClassID, testID))
+	s.Require().Len(hooks.transfers, 1)
+}
+
 func (s *TestSuite) TestExportGenesis() {
 	class := nft.Class{
 		Id:          testClassID,
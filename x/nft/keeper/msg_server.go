@@ -8,10 +8,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
+
+var _ nft.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) nft.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
 
 // Send implement Send method of the types.MsgServer.
-func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+func (k msgServer) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -23,6 +33,10 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, msg.Id)
 	}
 
+	if class, has := k.GetClass(ctx, msg.ClassId); has && class.NonTransferable {
+		return nil, sdkerrors.Wrap(nft.ErrNonTransferable, msg.ClassId)
+	}
+
 	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
 	if err != nil {
 		return nil, err
@@ -40,3 +54,176 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// NewClass implement NewClass method of the types.MsgServer.
+func (k msgServer) NewClass(goCtx context.Context, msg *nft.MsgNewClass) (*nft.MsgNewClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class := nft.Class{
+		Id:              msg.Id,
+		Name:            msg.Name,
+		Symbol:          msg.Symbol,
+		Description:     msg.Description,
+		Uri:             msg.Uri,
+		UriHash:         msg.UriHash,
+		Data:            msg.Data,
+		Owner:           msg.Creator,
+		MintRestricted:  msg.MintRestricted,
+		Burnable:        msg.Burnable,
+		Updatable:       msg.Updatable,
+		NonTransferable: msg.NonTransferable,
+		Royalty:         msg.Royalty,
+	}
+	if err := k.SaveClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventNewClass{
+		ClassId: msg.Id,
+		Owner:   msg.Creator,
+	})
+	return &nft.MsgNewClassResponse{}, nil
+}
+
+// UpdateClass implement UpdateClass method of the types.MsgServer.
+func (k msgServer) UpdateClass(goCtx context.Context, msg *nft.MsgUpdateClass) (*nft.MsgUpdateClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, err := k.getOwnedClass(ctx, msg.Id, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	class.Name = msg.Name
+	class.Symbol = msg.Symbol
+	class.Description = msg.Description
+	class.Uri = msg.Uri
+	class.UriHash = msg.UriHash
+	class.Data = msg.Data
+	class.Royalty = msg.Royalty
+	if err := k.Keeper.UpdateClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdateClass{
+		ClassId: msg.Id,
+	})
+	return &nft.MsgUpdateClassResponse{}, nil
+}
+
+// TransferClass implement TransferClass method of the types.MsgServer.
+func (k msgServer) TransferClass(goCtx context.Context, msg *nft.MsgTransferClass) (*nft.MsgTransferClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, err := k.getOwnedClass(ctx, msg.ClassId, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	class.Owner = msg.Receiver
+	if err := k.Keeper.UpdateClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventTransferClass{
+		ClassId:  msg.ClassId,
+		Sender:   msg.Owner,
+		Receiver: msg.Receiver,
+	})
+	return &nft.MsgTransferClassResponse{}, nil
+}
+
+// Mint implement Mint method of the types.MsgServer.
+func (k msgServer) Mint(goCtx context.Context, msg *nft.MsgMint) (*nft.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, has := k.GetClass(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.ClassId)
+	}
+
+	if class.Owner == "" {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft class %s has no owner", msg.ClassId)
+	}
+
+	if class.MintRestricted && class.Owner != msg.Minter {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft class %s", msg.Minter, msg.ClassId)
+	}
+
+	receiver := msg.Receiver
+	if receiver == "" {
+		receiver = msg.Minter
+	}
+
+	receiverAddr, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+		Data:    msg.Data,
+	}
+	if err := k.Keeper.Mint(ctx, token, receiverAddr); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgMintResponse{}, nil
+}
+
+// Burn implement Burn method of the types.MsgServer.
+func (k msgServer) Burn(goCtx context.Context, msg *nft.MsgBurn) (*nft.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	class, has := k.GetClass(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.ClassId)
+	}
+
+	if !class.Burnable {
+		return nil, sdkerrors.Wrap(nft.ErrNotBurnable, msg.ClassId)
+	}
+
+	if !k.GetOwner(ctx, msg.ClassId, msg.Id).Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", owner, msg.Id)
+	}
+
+	if err := k.Keeper.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgBurnResponse{}, nil
+}
+
+// Update implement Update method of the types.MsgServer.
+func (k msgServer) Update(goCtx context.Context, msg *nft.MsgUpdate) (*nft.MsgUpdateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, err := k.getOwnedClass(ctx, msg.ClassId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if !class.Updatable {
+		return nil, sdkerrors.Wrap(nft.ErrNotUpdatable, msg.ClassId)
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+		Data:    msg.Data,
+	}
+	if err := k.Keeper.Update(ctx, token); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdate{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+	})
+	return &nft.MsgUpdateResponse{}, nil
+}
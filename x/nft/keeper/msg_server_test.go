@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+)
+
+func (s *TestSuite) TestMsgNewClass() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	msg := &nft.MsgNewClass{
+		Creator:        s.addrs[0].String(),
+		Id:             testClassID,
+		Name:           testClassName,
+		Symbol:         testClassSymbol,
+		Uri:            testClassURI,
+		UriHash:        testClassURIHash,
+		MintRestricted: true,
+		Burnable:       true,
+		Royalty:        &nft.RoyaltyInfo{Receiver: s.addrs[1].String(), Rate: sdk.NewDecWithPrec(5, 2)},
+	}
+	_, err := msgServer.NewClass(s.ctx, msg)
+	s.Require().NoError(err)
+
+	class, has := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(nft.Class{
+		Id:             testClassID,
+		Name:           testClassName,
+		Symbol:         testClassSymbol,
+		Uri:            testClassURI,
+		UriHash:        testClassURIHash,
+		Owner:          s.addrs[0].String(),
+		MintRestricted: true,
+		Burnable:       true,
+		Royalty:        &nft.RoyaltyInfo{Receiver: s.addrs[1].String(), Rate: sdk.NewDecWithPrec(5, 2)},
+	}, class)
+
+	_, err = msgServer.NewClass(s.ctx, msg)
+	s.Require().ErrorIs(err, nft.ErrClassExists)
+}
+
+func (s *TestSuite) TestMsgUpdateClass() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	_, err := msgServer.NewClass(s.ctx, &nft.MsgNewClass{
+		Creator:   s.addrs[0].String(),
+		Id:        testClassID,
+		Name:      testClassName,
+		Updatable: true,
+	})
+	s.Require().NoError(err)
+
+	msg := &nft.MsgUpdateClass{
+		Owner:       s.addrs[1].String(),
+		Id:          testClassID,
+		Description: testClassDescription,
+	}
+	_, err = msgServer.UpdateClass(s.ctx, msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	msg.Owner = s.addrs[0].String()
+	_, err = msgServer.UpdateClass(s.ctx, msg)
+	s.Require().NoError(err)
+
+	// the metadata is replaced while the permissions are kept
+	class, _ := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().Equal(nft.Class{
+		Id:          testClassID,
+		Description: testClassDescription,
+		Owner:       s.addrs[0].String(),
+		Updatable:   true,
+	}, class)
+}
+
+func (s *TestSuite) TestMsgTransferClass() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	_, err := msgServer.NewClass(s.ctx, &nft.MsgNewClass{
+		Creator:        s.addrs[0].String(),
+		Id:             testClassID,
+		MintRestricted: true,
+	})
+	s.Require().NoError(err)
+
+	_, err = msgServer.TransferClass(s.ctx, &nft.MsgTransferClass{
+		Owner:    s.addrs[0].String(),
+		ClassId:  testClassID,
+		Receiver: s.addrs[1].String(),
+	})
+	s.Require().NoError(err)
+
+	class, _ := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().Equal(s.addrs[1].String(), class.Owner)
+
+	// the previous owner cannot mint anymore
+	_, err = msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:  s.addrs[0].String(),
+		ClassId: testClassID,
+		Id:      testID,
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (s *TestSuite) TestMsgMint() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: "module"}))
+	_, err := msgServer.NewClass(s.ctx, &nft.MsgNewClass{
+		Creator:        s.addrs[0].String(),
+		Id:             "restricted",
+		MintRestricted: true,
+	})
+	s.Require().NoError(err)
+	_, err = msgServer.NewClass(s.ctx, &nft.MsgNewClass{
+		Creator: s.addrs[0].String(),
+		Id:      "open",
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		msg    *nft.MsgMint
+		expErr error
+	}{
+		{
+			"class not exists",
+			&nft.MsgMint{Minter: s.addrs[0].String(), ClassId: "unknown", Id: testID},
+			nft.ErrClassNotExists,
+		},
+		{
+			"class without owner",
+			&nft.MsgMint{Minter: s.addrs[0].String(), ClassId: "module", Id: testID},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"restricted class minted by other account",
+			&nft.MsgMint{Minter: s.addrs[1].String(), ClassId: "restricted", Id: testID},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"restricted class minted by owner",
+			&nft.MsgMint{Minter: s.addrs[0].String(), ClassId: "restricted", Id: testID, Receiver: s.addrs[2].String()},
+			nil,
+		},
+		{
+			"open class minted by other account",
+			&nft.MsgMint{Minter: s.addrs[1].String(), ClassId: "open", Id: testID, Uri: testURI},
+			nil,
+		},
+		{
+			"nft exists",
+			&nft.MsgMint{Minter: s.addrs[1].String(), ClassId: "open", Id: testID},
+			nft.ErrNFTExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.Mint(s.ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			receiver := tc.msg.Receiver
+			if receiver == "" {
+				receiver = tc.msg.Minter
+			}
+			s.Require().Equal(receiver, s.app.NFTKeeper.GetOwner(s.ctx, tc.msg.ClassId, tc.msg.Id).String())
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgBurn() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	for _, msg := range []*nft.MsgNewClass{
+		{Creator: s.addrs[0].String(), Id: "burnable", Burnable: true},
+		{Creator: s.addrs[0].String(), Id: "permanent"},
+	} {
+		_, err := msgServer.NewClass(s.ctx, msg)
+		s.Require().NoError(err)
+		_, err = msgServer.Mint(s.ctx, &nft.MsgMint{Minter: s.addrs[0].String(), ClassId: msg.Id, Id: testID, Receiver: s.addrs[1].String()})
+		s.Require().NoError(err)
+	}
+
+	_, err := msgServer.Burn(s.ctx, &nft.MsgBurn{Owner: s.addrs[1].String(), ClassId: "permanent", Id: testID})
+	s.Require().ErrorIs(err, nft.ErrNotBurnable)
+
+	// even the class owner cannot burn the nfts of other accounts
+	_, err = msgServer.Burn(s.ctx, &nft.MsgBurn{Owner: s.addrs[0].String(), ClassId: "burnable", Id: testID})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.Burn(s.ctx, &nft.MsgBurn{Owner: s.addrs[1].String(), ClassId: "burnable", Id: testID})
+	s.Require().NoError(err)
+	s.Require().False(s.app.NFTKeeper.HasNFT(s.ctx, "burnable", testID))
+	s.Require().Equal(uint64(0), s.app.NFTKeeper.GetTotalSupply(s.ctx, "burnable"))
+}
+
+func (s *TestSuite) TestMsgUpdate() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	for _, msg := range []*nft.MsgNewClass{
+		{Creator: s.addrs[0].String(), Id: "updatable", Updatable: true},
+		{Creator: s.addrs[0].String(), Id: "frozen"},
+	} {
+		_, err := msgServer.NewClass(s.ctx, msg)
+		s.Require().NoError(err)
+		_, err = msgServer.Mint(s.ctx, &nft.MsgMint{Minter: s.addrs[0].String(), ClassId: msg.Id, Id: testID, Receiver: s.addrs[1].String()})
+		s.Require().NoError(err)
+	}
+
+	_, err := msgServer.Update(s.ctx, &nft.MsgUpdate{Sender: s.addrs[0].String(), ClassId: "frozen", Id: testID, Uri: testURI})
+	s.Require().ErrorIs(err, nft.ErrNotUpdatable)
+
+	// the nft owner cannot update it, only the class owner can
+	_, err = msgServer.Update(s.ctx, &nft.MsgUpdate{Sender: s.addrs[1].String(), ClassId: "updatable", Id: testID, Uri: testURI})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.Update(s.ctx, &nft.MsgUpdate{Sender: s.addrs[0].String(), ClassId: "updatable", Id: "unknown", Uri: testURI})
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+
+	_, err = msgServer.Update(s.ctx, &nft.MsgUpdate{Sender: s.addrs[0].String(), ClassId: "updatable", Id: testID, Uri: testURI, UriHash: testURIHash})
+	s.Require().NoError(err)
+
+	token, _ := s.app.NFTKeeper.GetNFT(s.ctx, "updatable", testID)
+	s.Require().Equal(nft.NFT{ClassId: "updatable", Id: testID, Uri: testURI, UriHash: testURIHash}, token)
+}
+
+func (s *TestSuite) TestMsgSendNonTransferable() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	_, err := msgServer.NewClass(s.ctx, &nft.MsgNewClass{
+		Creator:         s.addrs[0].String(),
+		Id:              testClassID,
+		NonTransferable: true,
+	})
+	s.Require().NoError(err)
+	_, err = msgServer.Mint(s.ctx, &nft.MsgMint{Minter: s.addrs[0].String(), ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+
+	_, err = msgServer.Send(s.ctx, &nft.MsgSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   s.addrs[0].String(),
+		Receiver: s.addrs[1].String(),
+	})
+	s.Require().ErrorIs(err, nft.ErrNonTransferable)
+}
@@ -69,6 +69,7 @@ func (k Keeper) Update(ctx sdk.Context, token nft.NFT) error {
 }
 
 // Transfer defines a method for sending a nft from one account to another account.
+// The transfer is vetoed if a BeforeTransfer hook returns an error.
 // Note: When the upper module uses this method, it needs to authenticate nft
 func (k Keeper) Transfer(ctx sdk.Context,
 	classID string,
@@ -84,6 +85,12 @@ func (k Keeper) Transfer(ctx sdk.Context,
 	}
 
 	owner := k.GetOwner(ctx, classID, nftID)
+	if k.hooks != nil {
+		if err := k.hooks.BeforeTransfer(ctx, classID, nftID, owner, receiver); err != nil {
+			return err
+		}
+	}
+
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.setOwner(ctx, classID, nftID, receiver)
 	return nil
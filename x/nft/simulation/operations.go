@@ -19,15 +19,35 @@ import (
 
 const (
 	// OpWeightMsgSend Simulation operation weights constants
-	OpWeightMsgSend = "op_weight_msg_send" //nolint:gosec
+	OpWeightMsgSend          = "op_weight_msg_send"           //nolint:gosec
+	OpWeightMsgNewClass      = "op_weight_msg_new_class"      //nolint:gosec
+	OpWeightMsgUpdateClass   = "op_weight_msg_update_class"   //nolint:gosec
+	OpWeightMsgTransferClass = "op_weight_msg_transfer_class" //nolint:gosec
+	OpWeightMsgMint          = "op_weight_msg_mint"           //nolint:gosec
+	OpWeightMsgBurn          = "op_weight_msg_burn"           //nolint:gosec
+	OpWeightMsgUpdate        = "op_weight_msg_update"         //nolint:gosec
 )
 
 const (
 	// WeightSend nft operations weights
-	WeightSend = 100
+	WeightSend          = 100
+	WeightNewClass      = 20
+	WeightUpdateClass   = 10
+	WeightTransferClass = 10
+	WeightMint          = 50
+	WeightBurn          = 10
+	WeightUpdate        = 10
 )
 
-var TypeMsgSend = sdk.MsgTypeURL(&nft.MsgSend{})
+var (
+	TypeMsgSend          = sdk.MsgTypeURL(&nft.MsgSend{})
+	TypeMsgNewClass      = sdk.MsgTypeURL(&nft.MsgNewClass{})
+	TypeMsgUpdateClass   = sdk.MsgTypeURL(&nft.MsgUpdateClass{})
+	TypeMsgTransferClass = sdk.MsgTypeURL(&nft.MsgTransferClass{})
+	TypeMsgMint          = sdk.MsgTypeURL(&nft.MsgMint{})
+	TypeMsgBurn          = sdk.MsgTypeURL(&nft.MsgBurn{})
+	TypeMsgUpdate        = sdk.MsgTypeURL(&nft.MsgUpdate{})
+)
 
 // WeightedOperations returns all the operations from the module with their respective weights
 func WeightedOperations(
@@ -38,7 +58,15 @@ func WeightedOperations(
 	bk nft.BankKeeper,
 	k keeper.Keeper,
 ) simulation.WeightedOperations {
-	var weightMsgSend int
+	var (
+		weightMsgSend          int
+		weightMsgNewClass      int
+		weightMsgUpdateClass   int
+		weightMsgTransferClass int
+		weightMsgMint          int
+		weightMsgBurn          int
+		weightMsgUpdate        int
+	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
 		func(_ *rand.Rand) {
@@ -46,10 +74,71 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgNewClass, &weightMsgNewClass, nil,
+		func(_ *rand.Rand) {
+			weightMsgNewClass = WeightNewClass
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateClass, &weightMsgUpdateClass, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateClass = WeightUpdateClass
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgTransferClass, &weightMsgTransferClass, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferClass = WeightTransferClass
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMint, &weightMsgMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgMint = WeightMint
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurn = WeightBurn
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdate, &weightMsgUpdate, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdate = WeightUpdate
+		},
+	)
+
+	protoCdc := codec.NewProtoCodec(registry)
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSend,
-			SimulateMsgSend(codec.NewProtoCodec(registry), ak, bk, k),
+			SimulateMsgSend(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgNewClass,
+			SimulateMsgNewClass(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateClass,
+			SimulateMsgUpdateClass(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgTransferClass,
+			SimulateMsgTransferClass(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMint,
+			SimulateMsgMint(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurn,
+			SimulateMsgBurn(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdate,
+			SimulateMsgUpdate(protoCdc, ak, bk, k),
 		),
 	}
 }
@@ -142,8 +231,14 @@ func randNFT(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, minter sdk.AccAddre
 	return n, nil
 }
 
+// randClass returns a random transferable class, creating one if there is none.
 func randClass(ctx sdk.Context, r *rand.Rand, k keeper.Keeper) (nft.Class, error) {
-	classes := k.GetClasses(ctx)
+	var classes []*nft.Class
+	for _, c := range k.GetClasses(ctx) {
+		if !c.NonTransferable {
+			classes = append(classes, c)
+		}
+	}
 	if len(classes) == 0 {
 		c := nft.Class{
 			Id:          simtypes.RandStringOfLength(r, 10),
@@ -160,3 +255,273 @@ func randClass(ctx sdk.Context, r *rand.Rand, k keeper.Keeper) (nft.Class, error
 	}
 	return *classes[r.Intn(len(classes))], nil
 }
+
+// SimulateMsgNewClass generates a MsgNewClass with random values.
+func SimulateMsgNewClass(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		creator, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &nft.MsgNewClass{
+			Creator:         creator.Address.String(),
+			Id:              simtypes.RandStringOfLength(r, 10),
+			Name:            simtypes.RandStringOfLength(r, 10),
+			Symbol:          simtypes.RandStringOfLength(r, 10),
+			Description:     simtypes.RandStringOfLength(r, 10),
+			Uri:             simtypes.RandStringOfLength(r, 10),
+			MintRestricted:  r.Intn(2) == 0,
+			Burnable:        r.Intn(2) == 0,
+			Updatable:       r.Intn(2) == 0,
+			NonTransferable: r.Intn(4) == 0,
+			Royalty:         randRoyalty(r, accs),
+		}
+		if k.HasClass(ctx, msg.Id) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgNewClass, "class already exists"), nil, nil
+		}
+
+		return deliverTx(r, app, ctx, cdc, ak, bk, creator, msg, TypeMsgNewClass)
+	}
+}
+
+// SimulateMsgUpdateClass generates a MsgUpdateClass with random values.
+func SimulateMsgUpdateClass(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, owner, found := randOwnedClass(ctx, r, k, accs, func(nft.Class) bool { return true })
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdateClass, "no class owned by the accounts"), nil, nil
+		}
+
+		msg := &nft.MsgUpdateClass{
+			Owner:       owner.Address.String(),
+			Id:          class.Id,
+			Name:        simtypes.RandStringOfLength(r, 10),
+			Symbol:      class.Symbol,
+			Description: simtypes.RandStringOfLength(r, 10),
+			Uri:         simtypes.RandStringOfLength(r, 10),
+			Royalty:     class.Royalty,
+		}
+
+		return deliverTx(r, app, ctx, cdc, ak, bk, owner, msg, TypeMsgUpdateClass)
+	}
+}
+
+// SimulateMsgTransferClass generates a MsgTransferClass with random values.
+func SimulateMsgTransferClass(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, owner, found := randOwnedClass(ctx, r, k, accs, func(nft.Class) bool { return true })
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgTransferClass, "no class owned by the accounts"), nil, nil
+		}
+
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		if receiver.Address.Equals(owner.Address) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgTransferClass, "owner and receiver are same"), nil, nil
+		}
+
+		msg := &nft.MsgTransferClass{
+			Owner:    owner.Address.String(),
+			ClassId:  class.Id,
+			Receiver: receiver.Address.String(),
+		}
+
+		return deliverTx(r, app, ctx, cdc, ak, bk, owner, msg, TypeMsgTransferClass)
+	}
+}
+
+// SimulateMsgMint generates a MsgMint with random values.
+func SimulateMsgMint(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, minter, found := randOwnedClass(ctx, r, k, accs, func(nft.Class) bool { return true })
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMint, "no class owned by the accounts"), nil, nil
+		}
+
+		// anyone can mint in a class without minting restriction
+		if !class.MintRestricted {
+			minter, _ = simtypes.RandomAcc(r, accs)
+		}
+		receiver, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &nft.MsgMint{
+			Minter:   minter.Address.String(),
+			ClassId:  class.Id,
+			Id:       simtypes.RandStringOfLength(r, 10),
+			Uri:      simtypes.RandStringOfLength(r, 10),
+			Receiver: receiver.Address.String(),
+		}
+		if k.HasNFT(ctx, msg.ClassId, msg.Id) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMint, "nft already exists"), nil, nil
+		}
+
+		return deliverTx(r, app, ctx, cdc, ak, bk, minter, msg, TypeMsgMint)
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn with random values.
+func SimulateMsgBurn(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			token nft.NFT
+			owner simtypes.Account
+			found bool
+		)
+		for _, class := range k.GetClasses(ctx) {
+			if !class.Burnable {
+				continue
+			}
+
+			if token, owner, found = randOwnedNFT(ctx, r, k, accs, class.Id); found {
+				break
+			}
+		}
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgBurn, "no burnable nft owned by the accounts"), nil, nil
+		}
+
+		msg := &nft.MsgBurn{
+			Owner:   owner.Address.String(),
+			ClassId: token.ClassId,
+			Id:      token.Id,
+		}
+
+		return deliverTx(r, app, ctx, cdc, ak, bk, owner, msg, TypeMsgBurn)
+	}
+}
+
+// SimulateMsgUpdate generates a MsgUpdate with random values.
+func SimulateMsgUpdate(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, owner, found := randOwnedClass(ctx, r, k, accs, func(c nft.Class) bool {
+			return c.Updatable && k.GetTotalSupply(ctx, c.Id) > 0
+		})
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdate, "no updatable class owned by the accounts"), nil, nil
+		}
+
+		nfts := k.GetNFTsOfClass(ctx, class.Id)
+		token := nfts[r.Intn(len(nfts))]
+
+		msg := &nft.MsgUpdate{
+			Sender:  owner.Address.String(),
+			ClassId: token.ClassId,
+			Id:      token.Id,
+			Uri:     simtypes.RandStringOfLength(r, 10),
+		}
+
+		return deliverTx(r, app, ctx, cdc, ak, bk, owner, msg, TypeMsgUpdate)
+	}
+}
+
+// randRoyalty returns a random royalty paid to a random account, or nil.
+func randRoyalty(r *rand.Rand, accs []simtypes.Account) *nft.RoyaltyInfo {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+
+	receiver, _ := simtypes.RandomAcc(r, accs)
+	return &nft.RoyaltyInfo{
+		Receiver: receiver.Address.String(),
+		Rate:     sdk.NewDecWithPrec(int64(r.Intn(101)), 2),
+	}
+}
+
+// randOwnedClass returns a random class satisfying filter and owned by one of
+// the accounts.
+func randOwnedClass(
+	ctx sdk.Context, r *rand.Rand, k keeper.Keeper, accs []simtypes.Account, filter func(nft.Class) bool,
+) (nft.Class, simtypes.Account, bool) {
+	classes := k.GetClasses(ctx)
+	for _, i := range r.Perm(len(classes)) {
+		class := *classes[i]
+		if class.Owner == "" || !filter(class) {
+			continue
+		}
+
+		owner, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(class.Owner))
+		if found {
+			return class, owner, true
+		}
+	}
+
+	return nft.Class{}, simtypes.Account{}, false
+}
+
+// randOwnedNFT returns a random nft of the class owned by one of the accounts.
+func randOwnedNFT(
+	ctx sdk.Context, r *rand.Rand, k keeper.Keeper, accs []simtypes.Account, classID string,
+) (nft.NFT, simtypes.Account, bool) {
+	nfts := k.GetNFTsOfClass(ctx, classID)
+	for _, i := range r.Perm(len(nfts)) {
+		owner, found := simtypes.FindAccount(accs, k.GetOwner(ctx, classID, nfts[i].Id))
+		if found {
+			return nfts[i], owner, true
+		}
+	}
+
+	return nft.NFT{}, simtypes.Account{}, false
+}
+
+// deliverTx generates and delivers a tx with random fees containing msg signed
+// by signer.
+func deliverTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper, bk nft.BankKeeper, signer simtypes.Account, msg sdk.Msg, msgType string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             cdc,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      signer,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      nft.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
@@ -53,6 +53,13 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		opMsgName  string
 	}{
 		{simulation.WeightSend, simulation.TypeMsgSend, simulation.TypeMsgSend},
+		{simulation.WeightNewClass, simulation.TypeMsgNewClass, simulation.TypeMsgNewClass},
+		{simulation.WeightUpdateClass, simulation.TypeMsgUpdateClass, simulation.TypeMsgUpdateClass},
+		{simulation.WeightTransferClass, simulation.TypeMsgTransferClass, simulation.TypeMsgTransferClass},
+		{simulation.WeightMint, simulation.TypeMsgMint, simulation.TypeMsgMint},
+		// the class created by the operations above is neither burnable nor updatable
+		{simulation.WeightBurn, nft.ModuleName, simulation.TypeMsgBurn},
+		{simulation.WeightUpdate, nft.ModuleName, simulation.TypeMsgUpdate},
 	}
 
 	for i, w := range weightedOps {
@@ -109,6 +116,43 @@ func (suite *SimTestSuite) TestSimulateMsgSend() {
 	suite.Require().Len(futureOperations, 0)
 }
 
+func (suite *SimTestSuite) TestSimulateMsgBurn() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+	blockTime := time.Now().UTC()
+	ctx := suite.ctx.WithBlockTime(blockTime)
+
+	// setup a burnable class with a nft owned by one of the accounts
+	suite.Require().NoError(suite.app.NFTKeeper.SaveClass(ctx, nft.Class{
+		Id:       "kitty",
+		Owner:    accounts[0].Address.String(),
+		Burnable: true,
+	}))
+	suite.Require().NoError(suite.app.NFTKeeper.Mint(ctx, nft.NFT{ClassId: "kitty", Id: "kitty1"}, accounts[1].Address))
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	// execute operation
+	registry := suite.app.InterfaceRegistry()
+	op := simulation.SimulateMsgBurn(codec.NewProtoCodec(registry), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg nft.MsgBurn
+	suite.app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(accounts[1].Address.String(), msg.Owner)
+	suite.Require().Equal("kitty1", msg.Id)
+	suite.Require().Len(futureOperations, 0)
+}
+
 func TestSimTestSuite(t *testing.T) {
 	suite.Run(t, new(SimTestSuite))
 }
@@ -0,0 +1,93 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &TransferAuthorization{}
+
+// NewTransferAuthorization creates a new TransferAuthorization object.
+func NewTransferAuthorization(grants ...TransferGrant) *TransferAuthorization {
+	return &TransferAuthorization{
+		Grants: grants,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a TransferAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept. A nft granted by its id can only be
+// sent once, the authorization is deleted once no nft can be sent anymore.
+func (a TransferAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mSend, ok := msg.(*MsgSend)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	for i, grant := range a.Grants {
+		if grant.ClassId != mSend.ClassId {
+			continue
+		}
+
+		if len(grant.Ids) == 0 {
+			return authz.AcceptResponse{Accept: true}, nil
+		}
+
+		for j, id := range grant.Ids {
+			if id != mSend.Id {
+				continue
+			}
+
+			grants := make([]TransferGrant, 0, len(a.Grants))
+			grants = append(grants, a.Grants[:i]...)
+			if len(grant.Ids) > 1 {
+				ids := make([]string, 0, len(grant.Ids)-1)
+				ids = append(ids, grant.Ids[:j]...)
+				ids = append(ids, grant.Ids[j+1:]...)
+				grants = append(grants, TransferGrant{ClassId: grant.ClassId, Ids: ids})
+			}
+			grants = append(grants, a.Grants[i+1:]...)
+
+			if len(grants) == 0 {
+				return authz.AcceptResponse{Accept: true, Delete: true}, nil
+			}
+			return authz.AcceptResponse{Accept: true, Updated: &TransferAuthorization{Grants: grants}}, nil
+		}
+	}
+
+	return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot send nft %s of class %s", mSend.Id, mSend.ClassId)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TransferAuthorization) ValidateBasic() error {
+	if len(a.Grants) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("transfer authorization must grant at least one class")
+	}
+
+	classIDs := make(map[string]bool, len(a.Grants))
+	for _, grant := range a.Grants {
+		if err := ValidateClassID(grant.ClassId); err != nil {
+			return err
+		}
+		if classIDs[grant.ClassId] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate class id %s", grant.ClassId)
+		}
+		classIDs[grant.ClassId] = true
+
+		ids := make(map[string]bool, len(grant.Ids))
+		for _, id := range grant.Ids {
+			if err := ValidateNFTID(id); err != nil {
+				return err
+			}
+			if ids[id] {
+				return sdkerrors.ErrInvalidRequest.Wrapf("duplicate nft id %s of class %s", id, grant.ClassId)
+			}
+			ids[id] = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package nft_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+var (
+	fromAddr = sdk.AccAddress("_____from _____")
+	toAddr   = sdk.AccAddress("_______to________")
+)
+
+func newMsgSend(classID, id string) *nft.MsgSend {
+	return &nft.MsgSend{ClassId: classID, Id: id, Sender: fromAddr.String(), Receiver: toAddr.String()}
+}
+
+func TestTransferAuthorization(t *testing.T) {
+	ctx := sdk.Context{}
+	authorization := nft.NewTransferAuthorization(
+		nft.TransferGrant{ClassId: "kitty"},
+		nft.TransferGrant{ClassId: "doggo", Ids: []string{"doggo1", "doggo2"}},
+	)
+	require.Equal(t, "/cosmos.nft.v1beta1.MsgSend", authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+
+	t.Log("verify any nft of a class granted without ids can be sent")
+	resp, err := authorization.Accept(ctx, newMsgSend("kitty", "kitty1"))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	t.Log("verify nfts of other classes cannot be sent")
+	_, err = authorization.Accept(ctx, newMsgSend("birdy", "birdy1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = authorization.Accept(ctx, newMsgSend("doggo", "doggo3"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	t.Log("verify a nft granted by id can only be sent once")
+	resp, err = authorization.Accept(ctx, newMsgSend("doggo", "doggo1"))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, nft.NewTransferAuthorization(
+		nft.TransferGrant{ClassId: "kitty"},
+		nft.TransferGrant{ClassId: "doggo", Ids: []string{"doggo2"}},
+	).String(), resp.Updated.String())
+
+	resp, err = resp.Updated.Accept(ctx, newMsgSend("doggo", "doggo2"))
+	require.NoError(t, err)
+	require.Equal(t, nft.NewTransferAuthorization(nft.TransferGrant{ClassId: "kitty"}).String(), resp.Updated.String())
+
+	_, err = resp.Updated.Accept(ctx, newMsgSend("doggo", "doggo1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	t.Log("verify the authorization is deleted once its last nft is sent")
+	authorization = nft.NewTransferAuthorization(nft.TransferGrant{ClassId: "doggo", Ids: []string{"doggo1"}})
+	resp, err = authorization.Accept(ctx, newMsgSend("doggo", "doggo1"))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+}
+
+func TestTransferAuthorizationValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		grants []nft.TransferGrant
+		expErr bool
+	}{
+		{"valid", []nft.TransferGrant{{ClassId: "kitty"}, {ClassId: "doggo", Ids: []string{"doggo1"}}}, false},
+		{"no grants", nil, true},
+		{"invalid class id", []nft.TransferGrant{{ClassId: "k"}}, true},
+		{"duplicate class id", []nft.TransferGrant{{ClassId: "kitty"}, {ClassId: "kitty", Ids: []string{"kitty1"}}}, true},
+		{"invalid nft id", []nft.TransferGrant{{ClassId: "kitty", Ids: []string{"k"}}}, true},
+		{"duplicate nft id", []nft.TransferGrant{{ClassId: "kitty", Ids: []string{"kitty1", "kitty1"}}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := nft.NewTransferAuthorization(tc.grants...).ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -33,3 +34,17 @@ func ValidateNFTID(id string) error {
 	}
 	return nil
 }
+
+// ValidateRoyalty returns whether the royalty info is valid, no royalty being valid
+func ValidateRoyalty(royalty *RoyaltyInfo) error {
+	if royalty == nil {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(royalty.Receiver); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "invalid receiver address: %s", royalty.Receiver)
+	}
+	if royalty.Rate.IsNil() || royalty.Rate.IsNegative() || royalty.Rate.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "rate must be between 0 and 1: %s", royalty.Rate)
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ type Keeper struct {
 	key         storetypes.StoreKey
 	tkey        storetypes.StoreKey
 	spaces      map[string]*types.Subspace
+	migrated    map[string]bool
 }
 
 // NewKeeper constructs a params keeper
@@ -26,6 +27,7 @@ func NewKeeper(cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey
 		key:         key,
 		tkey:        tkey,
 		spaces:      make(map[string]*types.Subspace),
+		migrated:    make(map[string]bool),
 	}
 }
 
@@ -51,6 +53,22 @@ func (k Keeper) Subspace(s string) types.Subspace {
 	return space
 }
 
+// MigratedSubspace allocates the subspace of a module whose params were
+// migrated to its own store. The subspace is only kept to read the legacy
+// params in the store migrations, so a ParameterChangeProposal cannot update
+// it.
+func (k Keeper) MigratedSubspace(s string) types.Subspace {
+	space := k.Subspace(s)
+	k.migrated[s] = true
+
+	return space
+}
+
+// IsMigrated returns true if the subspace was allocated with MigratedSubspace.
+func (k Keeper) IsMigrated(s string) bool {
+	return k.migrated[s]
+}
+
 // Get existing substore from keeper
 func (k Keeper) GetSubspace(s string) (types.Subspace, bool) {
 	space, ok := k.spaces[s]
@@ -30,6 +30,10 @@ func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal
 			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
 		}
 
+		if k.IsMigrated(c.Subspace) {
+			return sdkerrors.Wrapf(proposal.ErrMigratedSubspace, "%s params must be updated with its MsgUpdateParams", c.Subspace)
+		}
+
 		k.Logger(ctx).Info(
 			fmt.Sprintf("attempt to set new parameter value; key: %s, value: %s", c.Key, c.Value),
 		)
@@ -4,16 +4,23 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
-
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -44,39 +51,45 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 		name     string
 		proposal *proposal.ParameterChangeProposal
 		onHandle func()
-		expErr   bool
+		expErr   error
 	}{
 		{
 			"all fields",
-			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1")),
+			testProposal(proposal.NewParamChange(baseapp.Paramspace, string(baseapp.ParamStoreKeyBlockParams), `{"max_bytes": "1000000", "max_gas": "500000"}`)),
 			func() {
-				maxVals := suite.app.StakingKeeper.MaxValidators(suite.ctx)
-				suite.Require().Equal(uint32(1), maxVals)
+				var blockParams abci.BlockParams
+				suite.app.GetSubspace(baseapp.Paramspace).Get(suite.ctx, baseapp.ParamStoreKeyBlockParams, &blockParams)
+				suite.Require().Equal(abci.BlockParams{MaxBytes: 1000000, MaxGas: 500000}, blockParams)
 			},
-			false,
+			nil,
 		},
 		{
 			"invalid type",
-			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "-")),
+			testProposal(proposal.NewParamChange(baseapp.Paramspace, string(baseapp.ParamStoreKeyBlockParams), "-")),
+			func() {},
+			proposal.ErrSettingParameter,
+		},
+		{
+			"unknown subspace",
+			testProposal(proposal.NewParamChange("unknown", string(baseapp.ParamStoreKeyBlockParams), "{}")),
 			func() {},
-			true,
+			proposal.ErrUnknownSubspace,
 		},
 		{
-			"omit empty fields",
+			"migrated staking subspace",
+			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1")),
+			func() {},
+			proposal.ErrMigratedSubspace,
+		},
+		{
+			"migrated gov subspace",
 			testProposal(proposal.ParamChange{
 				Subspace: govtypes.ModuleName,
 				Key:      string(govv1.ParamStoreKeyDepositParams),
 				Value:    `{"min_deposit": [{"denom": "uatom","amount": "64000000"}], "max_deposit_period": "34560"}`,
 			}),
-			func() {
-				depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
-				defaultPeriod := govv1.DefaultPeriod
-				suite.Require().Equal(govv1.DepositParams{
-					MinDeposit:       sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
-					MaxDepositPeriod: defaultPeriod,
-				}, depositParams)
-			},
-			false,
+			func() {},
+			proposal.ErrMigratedSubspace,
 		},
 	}
 
@@ -84,8 +97,8 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 		tc := tc
 		suite.Run(tc.name, func() {
 			err := suite.govHandler(suite.ctx, tc.proposal)
-			if tc.expErr {
-				suite.Require().Error(err)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
 			} else {
 				suite.Require().NoError(err)
 				tc.onHandle()
@@ -93,3 +106,13 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 		})
 	}
 }
+
+func (suite *HandlerTestSuite) TestMigratedSubspaces() {
+	for _, subspace := range []string{
+		authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName, minttypes.ModuleName,
+		distrtypes.ModuleName, slashingtypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName,
+	} {
+		suite.Require().True(suite.app.ParamsKeeper.IsMigrated(subspace), subspace)
+	}
+	suite.Require().False(suite.app.ParamsKeeper.IsMigrated(baseapp.Paramspace))
+}
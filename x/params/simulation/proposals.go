@@ -9,8 +9,13 @@ import (
 // OpWeightSubmitParamChangeProposal app params key for param change proposal
 const OpWeightSubmitParamChangeProposal = "op_weight_submit_param_change_proposal"
 
-// ProposalContents defines the module weighted proposals' contents
+// ProposalContents defines the module weighted proposals' contents. There is
+// none without any param change to propose.
 func ProposalContents(paramChanges []simtypes.ParamChange) []simtypes.WeightedProposalContent {
+	if len(paramChanges) == 0 {
+		return nil
+	}
+
 	return []simtypes.WeightedProposalContent{
 		simulation.NewWeightedProposalContent(
 			OpWeightSubmitParamChangeProposal,
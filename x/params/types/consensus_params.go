@@ -23,5 +23,8 @@ func ConsensusParamsKeyTable() KeyTable {
 		NewParamSetPair(
 			baseapp.ParamStoreKeyValidatorParams, tmproto.ValidatorParams{}, baseapp.ValidateValidatorParams,
 		),
+		NewParamSetPair(
+			baseapp.ParamStoreKeyMsgQuotas, []baseapp.MsgQuota{}, baseapp.ValidateMsgQuotas,
+		),
 	)
 }
@@ -71,16 +71,19 @@ func (s Subspace) WithKeyTable(table KeyTable) Subspace {
 
 // Returns a KVStore identical with ctx.KVStore(s.key).Prefix()
 func (s Subspace) kvStore(ctx sdk.Context) sdk.KVStore {
-	// append here is safe, appends within a function won't cause
-	// weird side effects when its singlethreaded
-	return prefix.NewStore(ctx.KVStore(s.key), append(s.name, '/'))
+	return prefix.NewStore(ctx.KVStore(s.key), s.storePrefix())
 }
 
 // Returns a transient store for modification
 func (s Subspace) transientStore(ctx sdk.Context) sdk.KVStore {
-	// append here is safe, appends within a function won't cause
-	// weird side effects when its singlethreaded
-	return prefix.NewStore(ctx.TransientStore(s.tkey), append(s.name, '/'))
+	return prefix.NewStore(ctx.TransientStore(s.tkey), s.storePrefix())
+}
+
+// storePrefix returns the prefix of the Subspace in the stores. The spare
+// capacity of s.name is never written to, as a Subspace may be used by txs
+// executed in parallel.
+func (s Subspace) storePrefix() []byte {
+	return append(s.name[:len(s.name):len(s.name)], '/')
 }
 
 // Validate attempts to validate a parameter value by its key. If the key is not
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
-	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -14,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -70,12 +70,12 @@ func TestSlashingMsgs(t *testing.T) {
 
 	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
 	txGen := simapp.MakeTestEncodingConfig().TxConfig
-	_, _, err = simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{createValidatorMsg}, "", []uint64{0}, []uint64{0}, true, true, priv1)
+	_, _, err = simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{epochingtypes.NewMsgWrappedCreateValidator(createValidatorMsg)}, "", []uint64{0}, []uint64{0}, true, true, priv1)
 	require.NoError(t, err)
 	simapp.CheckBalance(t, app, addr1, sdk.Coins{genCoin.Sub(bondCoin)})
 
-	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
-	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	// the validator is created at the end of the epoch
+	simapp.EndEpoch(app)
 
 	validator := checkValidator(t, app, addr1, true)
 	require.Equal(t, sdk.ValAddress(addr1).String(), validator.OperatorAddress)
@@ -85,10 +85,21 @@ func TestSlashingMsgs(t *testing.T) {
 
 	checkValidatorSigningInfo(t, app, sdk.ConsAddress(valAddr), true)
 
-	// unjail should fail with unknown validator
+	// unjail should fail as it is not queued by the epoching module
 	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
 	_, res, err := simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{unjailMsg}, "", []uint64{0}, []uint64{1}, false, false, priv1)
 	require.Error(t, err)
 	require.Nil(t, res)
-	require.True(t, errors.Is(types.ErrValidatorNotJailed, err))
+	require.True(t, errors.Is(epochingtypes.ErrUnwrappedMessage, err))
+
+	// the wrapped unjail is queued, but fails at the end of the epoch as the
+	// validator is not jailed
+	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{epochingtypes.NewMsgWrappedUnjail(unjailMsg)}, "", []uint64{0}, []uint64{1}, true, true, priv1)
+	require.NoError(t, err)
+	simapp.EndEpoch(app)
+
+	validator = checkValidator(t, app, addr1, true)
+	require.False(t, validator.IsJailed())
+	require.Empty(t, app.EpochingKeeper.GetAllQueuedMessages(app.BaseApp.NewContext(true, tmproto.Header{})))
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/simulation"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -54,8 +55,8 @@ func TestWeightedOperations(t *testing.T) {
 	}
 }
 
-// TestSimulateMsgUnjail tests the normal scenario of a valid message of type types.MsgUnjail.
-// Abonormal scenarios, where the message is created by an errors, are not tested here.
+// TestSimulateMsgUnjail tests that a valid message of type types.MsgUnjail is
+// rejected by SimApp as it is not wrapped in an epoching message.
 func TestSimulateMsgUnjail(t *testing.T) {
 	// setup 3 accounts
 	s := rand.NewSource(5)
@@ -96,14 +97,11 @@ func TestSimulateMsgUnjail(t *testing.T) {
 	// execute operation
 	op := simulation.SimulateMsgUnjail(app.AccountKeeper, app.BankKeeper, app.SlashingKeeper, app.StakingKeeper)
 	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
-	require.NoError(t, err)
-
-	var msg types.MsgUnjail
-	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
-
-	require.True(t, operationMsg.OK)
-	require.Equal(t, types.TypeMsgUnjail, msg.Type())
-	require.Equal(t, "cosmosvaloper17s94pzwhsn4ah25tec27w70n65h5t2scgxzkv2", msg.ValidatorAddr)
+	// SimApp rejects the unjail messages which are not queued by the epoching
+	// module, their wrapped counterparts are simulated by x/epoching
+	require.ErrorIs(t, err, epochingtypes.ErrUnwrappedMessage)
+	require.False(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgUnjail, operationMsg.Name)
 	require.Len(t, futureOperations, 0)
 }
 
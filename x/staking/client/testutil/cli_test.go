@@ -6,13 +6,24 @@ package testutil
 import (
 	"testing"
 
-	"github.com/cosmos/cosmos-sdk/testutil/network"
-
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 )
 
 func TestIntegrationTestSuite(t *testing.T) {
 	cfg := network.DefaultConfig()
 	cfg.NumValidators = 2
+
+	// execute the messages queued by the epoching module in the block they
+	// are sent
+	epochingGenesis := epochingtypes.DefaultGenesisState()
+	epochingGenesis.Params.EpochLength = 1
+	bz, err := cfg.Codec.MarshalJSON(epochingGenesis)
+	require.NoError(t, err)
+	cfg.GenesisState[epochingtypes.ModuleName] = bz
+
 	suite.Run(t, NewIntegrationTestSuite(cfg))
 }
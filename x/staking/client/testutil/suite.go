@@ -23,6 +23,8 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
+	epochingcli "github.com/cosmos/cosmos-sdk/x/epoching/client/cli"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -187,7 +189,7 @@ func (s *IntegrationTestSuite) TestNewCreateValidatorCmd() {
 			true, 0, nil,
 		},
 		{
-			"valid transaction",
+			"valid transaction not wrapped in an epoching message",
 			[]string{
 				fmt.Sprintf("--%s=%s", cli.FlagPubKey, consPubKeyBz),
 				fmt.Sprintf("--%s=%dstake", cli.FlagAmount, 100),
@@ -205,7 +207,7 @@ func (s *IntegrationTestSuite) TestNewCreateValidatorCmd() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 			},
-			false, 0, &sdk.TxResponse{},
+			false, epochingtypes.ErrUnwrappedMessage.ABCICode(), &sdk.TxResponse{},
 		},
 	}
 
@@ -227,15 +229,6 @@ func (s *IntegrationTestSuite) TestNewCreateValidatorCmd() {
 				txResp := tc.respType.(*sdk.TxResponse)
 				require.Equal(tc.expectedCode, txResp.Code,
 					"test: %s, output\n:", tc.name, out.String())
-
-				events := txResp.Logs[0].GetEvents()
-				for i := 0; i < len(events); i++ {
-					if events[i].GetType() == "create_validator" {
-						attributes := events[i].GetAttributes()
-						require.Equal(attributes[1].Value, "100stake")
-						break
-					}
-				}
 			}
 		})
 	}
@@ -894,16 +887,18 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 			"with text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`bond_denom: stake
+global_liquid_staking_cap: "1.000000000000000000"
 historical_entries: 10000
 max_entries: 7
 max_validators: 100
 min_commission_rate: "0.000000000000000000"
-unbonding_time: 1814400s`,
+unbonding_time: 1814400s
+validator_liquid_staking_cap: "1.000000000000000000"`,
 		},
 		{
 			"with json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake","min_commission_rate":"0.000000000000000000"}`,
+			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake","min_commission_rate":"0.000000000000000000","global_liquid_staking_cap":"1.000000000000000000","validator_liquid_staking_cap":"1.000000000000000000"}`,
 		},
 	}
 	for _, tc := range testCases {
@@ -1122,7 +1117,7 @@ func (s *IntegrationTestSuite) TestNewDelegateCmd() {
 			true, 0, nil,
 		},
 		{
-			"valid transaction of delegate",
+			"valid transaction of delegate not wrapped in an epoching message",
 			[]string{
 				val.ValAddress.String(),
 				sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(150)).String(),
@@ -1131,7 +1126,7 @@ func (s *IntegrationTestSuite) TestNewDelegateCmd() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 			},
-			false, 0, &sdk.TxResponse{},
+			false, epochingtypes.ErrUnwrappedMessage.ABCICode(), &sdk.TxResponse{},
 		},
 	}
 
@@ -1190,7 +1185,7 @@ func (s *IntegrationTestSuite) TestNewRedelegateCmd() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 			},
-			false, 3, &sdk.TxResponse{},
+			false, epochingtypes.ErrUnwrappedMessage.ABCICode(), &sdk.TxResponse{},
 		},
 		{
 			"with wrong destination validator address",
@@ -1203,10 +1198,10 @@ func (s *IntegrationTestSuite) TestNewRedelegateCmd() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 			},
-			false, 31, &sdk.TxResponse{},
+			false, epochingtypes.ErrUnwrappedMessage.ABCICode(), &sdk.TxResponse{},
 		},
 		{
-			"valid transaction of delegate",
+			"valid transaction of delegate not wrapped in an epoching message",
 			[]string{
 				val.ValAddress.String(),                                // src-validator-addr
 				val2.ValAddress.String(),                               // dst-validator-addr
@@ -1217,7 +1212,7 @@ func (s *IntegrationTestSuite) TestNewRedelegateCmd() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 			},
-			false, 0, &sdk.TxResponse{},
+			false, epochingtypes.ErrUnwrappedMessage.ABCICode(), &sdk.TxResponse{},
 		},
 	}
 
@@ -1275,7 +1270,7 @@ func (s *IntegrationTestSuite) TestNewUnbondCmd() {
 			true, 0, nil,
 		},
 		{
-			"valid transaction of unbond",
+			"valid transaction of unbond not wrapped in an epoching message",
 			[]string{
 				val.ValAddress.String(),
 				sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(150)).String(),
@@ -1284,7 +1279,7 @@ func (s *IntegrationTestSuite) TestNewUnbondCmd() {
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 			},
-			false, 0, &sdk.TxResponse{},
+			false, epochingtypes.ErrUnwrappedMessage.ABCICode(), &sdk.TxResponse{},
 		},
 	}
 
@@ -1456,7 +1451,7 @@ func (s *IntegrationTestSuite) TestBlockResults() {
 	// Use CLI to create a delegation from the new account to validator `val`.
 	delHeight, err := s.network.LatestHeight()
 	require.NoError(err)
-	cmd := cli.NewDelegateCmd()
+	cmd := epochingcli.NewDelegateCmd()
 	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, []string{
 		val.ValAddress.String(),
 		sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(150)).String(),
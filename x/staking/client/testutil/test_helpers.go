@@ -8,7 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	stakingcli "github.com/cosmos/cosmos-sdk/x/staking/client/cli"
+	epochingcli "github.com/cosmos/cosmos-sdk/x/epoching/client/cli"
 )
 
 var commonArgs = []string{
@@ -17,7 +17,7 @@ var commonArgs = []string{
 	fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))).String()),
 }
 
-// MsgRedelegateExec creates a redelegate message.
+// MsgRedelegateExec creates a redelegate message wrapped in an epoching message.
 func MsgRedelegateExec(clientCtx client.Context, from, src, dst, amount fmt.Stringer, extraArgs ...string) (testutil.BufferWriter, error) {
 	args := []string{
 		src.String(),
@@ -29,10 +29,10 @@ func MsgRedelegateExec(clientCtx client.Context, from, src, dst, amount fmt.Stri
 	args = append(args, extraArgs...)
 
 	args = append(args, commonArgs...)
-	return clitestutil.ExecTestCLICmd(clientCtx, stakingcli.NewRedelegateCmd(), args)
+	return clitestutil.ExecTestCLICmd(clientCtx, epochingcli.NewRedelegateCmd(), args)
 }
 
-// MsgUnbondExec creates a unbond message.
+// MsgUnbondExec creates a unbond message wrapped in an epoching message.
 func MsgUnbondExec(clientCtx client.Context, from fmt.Stringer, valAddress,
 	amount fmt.Stringer, extraArgs ...string,
 ) (testutil.BufferWriter, error) {
@@ -44,5 +44,5 @@ func MsgUnbondExec(clientCtx client.Context, from fmt.Stringer, valAddress,
 
 	args = append(args, commonArgs...)
 	args = append(args, extraArgs...)
-	return clitestutil.ExecTestCLICmd(clientCtx, stakingcli.NewUnbondCmd(), args)
+	return clitestutil.ExecTestCLICmd(clientCtx, epochingcli.NewUnbondCmd(), args)
 }
@@ -19,6 +19,8 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0].Tokens = sdk.OneInt()
 	genValidators1[0].DelegatorShares = sdk.OneDec()
 
+	record := types.NewTokenizeShareRecord(1, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()))
+
 	tests := []struct {
 		name    string
 		mutate  func(*types.GenesisState)
@@ -39,6 +41,27 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate genesis tokenize share records
+		{"tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record}
+			data.LastTokenizeShareRecordId = 1
+		}, false},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record, record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record id above last id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record}
+		}, true},
+		{"invalid tokenize share record module account", func(data *types.GenesisState) {
+			invalidRecord := record
+			invalidRecord.ModuleAccount = "tokenizeshare_2"
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{invalidRecord}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"negative total liquid staked tokens", func(data *types.GenesisState) {
+			data.TotalLiquidStakedTokens = sdk.NewInt(-1)
+		}, true},
 	}
 
 	for _, tt := range tests {
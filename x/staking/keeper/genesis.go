@@ -25,7 +25,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 	// genesis.json are in block 0.
 	ctx = ctx.WithBlockHeight(1 - sdk.ValidatorUpdateDelay)
 
-	k.SetParams(ctx, data.Params)
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
 	k.SetLastTotalPower(ctx, data.LastTotalPower)
 
 	for _, validator := range data.Validators {
@@ -96,6 +99,42 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+
+		// the liquid shares of a validator are the shares delegated by the
+		// module accounts of its tokenize share records
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+		if found {
+			k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(delegation.Shares))
+		}
+	}
+
+	k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+
+	// if not exported, the total liquid staked tokens are recomputed from the
+	// bonded validators, the validators bonded by the updates below being added
+	// as they are bonded
+	if data.Exported {
+		k.SetTotalLiquidStakedTokens(ctx, data.TotalLiquidStakedTokens)
+	} else {
+		total := sdk.ZeroInt()
+		k.IterateValidatorLiquidShares(ctx, func(valAddr sdk.ValAddress, _ sdk.Dec) bool {
+			if validator, found := k.GetValidator(ctx, valAddr); found {
+				total = total.Add(k.bondedLiquidTokens(ctx, validator))
+			}
+			return false
+		})
+		k.SetTotalLiquidStakedTokens(ctx, total)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -197,5 +236,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+
+		TokenizeShareRecords:      k.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: k.GetLastTokenizeShareRecordID(ctx),
+		TotalLiquidStakedTokens:   k.GetTotalLiquidStakedTokens(ctx),
 	}
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -796,6 +798,7 @@ func createValidators(t *testing.T, ctx sdk.Context, app *simapp.SimApp, powers
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.GetSubspace(types.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	val1 := teststaking.NewValidator(t, valAddrs[0], pks[0])
@@ -819,3 +822,44 @@ func createValidators(t *testing.T, ctx sdk.Context, app *simapp.SimApp, powers
 
 	return addrs, valAddrs, vals
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryTokenizeShareRecords() {
+	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
+
+	records := []types.TokenizeShareRecord{
+		types.NewTokenizeShareRecord(1, addrs[0], vals[0].GetOperator()),
+		types.NewTokenizeShareRecord(2, addrs[0], vals[1].GetOperator()),
+		types.NewTokenizeShareRecord(3, addrs[1], vals[0].GetOperator()),
+	}
+	for _, record := range records {
+		suite.Require().NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+	}
+	app.StakingKeeper.SetLastTokenizeShareRecordID(ctx, 3)
+	app.StakingKeeper.SetValidatorLiquidShares(ctx, vals[0].GetOperator(), sdk.NewDec(100))
+	app.StakingKeeper.SetTotalLiquidStakedTokens(ctx, sdk.NewInt(100))
+
+	_, err := queryClient.TokenizeShareRecordById(gocontext.Background(), &types.QueryTokenizeShareRecordByIdRequest{Id: 4})
+	suite.Error(err)
+	byID, err := queryClient.TokenizeShareRecordById(gocontext.Background(), &types.QueryTokenizeShareRecordByIdRequest{Id: 2})
+	suite.NoError(err)
+	suite.Equal(records[1], byID.Record)
+
+	_, err = queryClient.TokenizeShareRecordByDenom(gocontext.Background(), &types.QueryTokenizeShareRecordByDenomRequest{})
+	suite.Error(err)
+	byDenom, err := queryClient.TokenizeShareRecordByDenom(gocontext.Background(), &types.QueryTokenizeShareRecordByDenomRequest{Denom: records[2].GetShareTokenDenom()})
+	suite.NoError(err)
+	suite.Equal(records[2], byDenom.Record)
+
+	owned, err := queryClient.TokenizeShareRecordsOwned(gocontext.Background(), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: addrs[0].String()})
+	suite.NoError(err)
+	suite.Equal(records[:2], owned.Records)
+
+	all, err := queryClient.AllTokenizeShareRecords(gocontext.Background(), &types.QueryAllTokenizeShareRecordsRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	suite.NoError(err)
+	suite.Equal(records[:2], all.Records)
+	suite.Equal(uint64(3), all.Pagination.Total)
+
+	total, err := queryClient.TotalLiquidStaked(gocontext.Background(), &types.QueryTotalLiquidStakedRequest{})
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt(100), total.Tokens)
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -144,3 +145,252 @@ func TestCancelUnbondingDelegation(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	authority := app.StakingKeeper.GetAuthority()
+
+	params := types.DefaultParams()
+	params.MaxValidators = 50
+
+	invalidParams := types.DefaultParams()
+	invalidParams.BondDenom = ""
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			req: &types.MsgUpdateParams{
+				Authority: sdk.AccAddress("invalid").String(),
+				Params:    params,
+			},
+			expErr:    true,
+			expErrMsg: "expected " + authority,
+		},
+		{
+			name: "invalid params",
+			req: &types.MsgUpdateParams{
+				Authority: authority,
+				Params:    invalidParams,
+			},
+			expErr:    true,
+			expErrMsg: "bond denom cannot be blank",
+		},
+		{
+			name: "all good",
+			req: &types.MsgUpdateParams{
+				Authority: authority,
+				Params:    params,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.UpdateParams(ctx, tc.req)
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.req.Params, app.StakingKeeper.GetParams(ctx))
+			}
+		})
+	}
+}
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 1))
+	delegatorAddr, ownerAddr := addrs[0], addrs[1]
+
+	// the genesis validator shares are worth too many tokens to be tokenized
+	validatorAddr := sdk.ValAddress(addrs[2])
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(validatorAddr, PKs[0], 1, true)
+	ctx = tstaking.TurnBlockTimeDiff(time.Second)
+	tstaking.CheckValidator(validatorAddr, types.Bonded, false)
+
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delegatorAddr, validatorAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+	require.NoError(t, err)
+	bondedPoolBalance := app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetBondedPool(ctx).GetAddress(), bondDenom)
+
+	// the delegator cannot tokenize more than it delegated nor another denom
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewInt64Coin(bondDenom, 1001), ownerAddr))
+	require.Error(t, err)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewInt64Coin("foo", 500), ownerAddr))
+	require.Error(t, err)
+
+	res, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewInt64Coin(bondDenom, 500), ownerAddr))
+	require.NoError(t, err)
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, ownerAddr.String(), record.Owner)
+	require.Equal(t, validatorAddr.String(), record.Validator)
+	require.Equal(t, uint64(1), app.StakingKeeper.GetLastTokenizeShareRecordID(ctx))
+
+	shareDenom := record.GetShareTokenDenom()
+	require.Equal(t, sdk.NewInt64Coin(shareDenom, 500), res.Amount)
+	require.Equal(t, res.Amount, app.BankKeeper.GetBalance(ctx, delegatorAddr, shareDenom))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(500), delegation.Shares)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(500), delegation.Shares)
+
+	require.Equal(t, sdk.NewDec(500), app.StakingKeeper.GetValidatorLiquidShares(ctx, validatorAddr))
+	require.Equal(t, sdk.NewInt(500), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(t, bondedPoolBalance, app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetBondedPool(ctx).GetAddress(), bondDenom))
+
+	// only the owner can transfer the record
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferTokenizeShareRecord(1, delegatorAddr, delegatorAddr))
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferTokenizeShareRecord(1, ownerAddr, delegatorAddr))
+	require.NoError(t, err)
+	require.Len(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr), 0)
+	require.Len(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, delegatorAddr), 1)
+
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(delegatorAddr, sdk.NewInt64Coin(shareDenom, 200)))
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(700), delegation.Shares)
+	require.Equal(t, sdk.NewDec(300), app.StakingKeeper.GetValidatorLiquidShares(ctx, validatorAddr))
+	require.Equal(t, sdk.NewInt(300), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+
+	// redeeming the last share tokens removes the record
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(delegatorAddr, sdk.NewInt64Coin(shareDenom, 300)))
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(1000), delegation.Shares)
+	require.True(t, app.BankKeeper.GetBalance(ctx, delegatorAddr, shareDenom).IsZero())
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, validatorAddr).IsZero())
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, shareDenom)
+	require.False(t, found)
+	require.Len(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, delegatorAddr), 0)
+
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(delegatorAddr, sdk.NewInt64Coin(shareDenom, 1)))
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+}
+
+func TestTokenizeSharesLiquidStakingCaps(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 1))
+	delegatorAddr, validatorAddr, unbondedValidatorAddr := addrs[0], sdk.ValAddress(addrs[1]), sdk.ValAddress(addrs[2])
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(validatorAddr, PKs[0], 1, true)
+	tstaking.CreateValidator(unbondedValidatorAddr, PKs[1], sdk.NewInt(1000), true)
+	ctx = tstaking.TurnBlockTimeDiff(time.Second)
+	tstaking.CheckValidator(validatorAddr, types.Bonded, false)
+	tstaking.CheckValidator(unbondedValidatorAddr, types.Unbonded, false)
+
+	for _, valAddr := range []sdk.ValAddress{validatorAddr, unbondedValidatorAddr} {
+		_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delegatorAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		name         string
+		globalCap    sdk.Dec
+		validatorCap sdk.Dec
+		expErr       error
+	}{
+		{"validator cap exceeded", sdk.OneDec(), sdk.ZeroDec(), types.ErrValidatorLiquidStakingCapExceeded},
+		{"global cap exceeded", sdk.ZeroDec(), sdk.OneDec(), types.ErrGlobalLiquidStakingCapExceeded},
+		{"within caps", sdk.OneDec(), sdk.OneDec(), nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := app.StakingKeeper.GetParams(ctx)
+			params.GlobalLiquidStakingCap = tc.globalCap
+			params.ValidatorLiquidStakingCap = tc.validatorCap
+			require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+
+			_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewInt64Coin(bondDenom, 100), delegatorAddr))
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// the global cap only applies to the bonded validators
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.ZeroDec()
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegatorAddr, unbondedValidatorAddr, sdk.NewInt64Coin(bondDenom, 100), delegatorAddr))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(100), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+}
+
+func TestTotalLiquidStakedTokens(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+	delegatorAddr, validatorAddr := addrs[0], sdk.ValAddress(addrs[1])
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(validatorAddr, PKs[0], 10, true)
+	ctx = tstaking.TurnBlockTimeDiff(time.Second)
+	tstaking.CheckValidator(validatorAddr, types.Bonded, false)
+
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delegatorAddr, validatorAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+	require.NoError(t, err)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewInt64Coin(bondDenom, 500), delegatorAddr))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(500), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// slashing the validator slashes its liquid staked tokens
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	power := validator.GetConsensusPower(app.StakingKeeper.PowerReduction(ctx))
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, sdk.NewDecWithPrec(1, 1))
+	require.Equal(t, sdk.NewInt(450), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// the liquid staked tokens of the validator no longer count once it is
+	// unbonding, and count again once it is bonded
+	app.StakingKeeper.Jail(ctx, consAddr)
+	ctx = tstaking.TurnBlockTimeDiff(time.Second)
+	tstaking.CheckValidator(validatorAddr, types.Unbonding, true)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+
+	app.StakingKeeper.Unjail(ctx, consAddr)
+	ctx = tstaking.TurnBlockTimeDiff(time.Second)
+	tstaking.CheckValidator(validatorAddr, types.Bonded, false)
+	require.Equal(t, sdk.NewInt(450), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(t, sdk.NewInt(450), app.StakingKeeper.ExportGenesis(ctx).TotalLiquidStakedTokens)
+}
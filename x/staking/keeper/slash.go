@@ -127,7 +127,9 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
+	liquidTokens := k.bondedLiquidTokens(ctx, validator)
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
+	k.updateTotalLiquidStakedTokens(ctx, liquidTokens, k.bondedLiquidTokens(ctx, validator))
 
 	switch validator.GetStatus() {
 	case types.Bonded:
@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordKey(id))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of the
+// share token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return record, false
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records of owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetTokenizeShareRecordIDsByOwnerPrefix(owner)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, found := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(iterator.Key()[len(prefix):]))
+		if !found {
+			panic("tokenize share record of owner index not found")
+		}
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// IterateTokenizeShareRecords iterates through the tokenize share records by id.
+func (k Keeper) IterateTokenizeShareRecords(ctx sdk.Context, cb func(record types.TokenizeShareRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
+
+// AddTokenizeShareRecord adds a new tokenize share record with its indexes.
+func (k Keeper) AddTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	if _, found := k.GetTokenizeShareRecord(ctx, record.Id); found {
+		return types.ErrTokenizeShareRecordAlreadyExists
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordKey(record.Id), k.cdc.MustMarshal(&record))
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), sdk.Uint64ToBigEndian(record.Id))
+	k.setTokenizeShareRecordWithOwner(ctx, sdk.MustAccAddressFromBech32(record.Owner), record.Id)
+
+	return nil
+}
+
+// DeleteTokenizeShareRecord removes the tokenize share record with id and its
+// indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, id uint64) error {
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return types.ErrTokenizeShareRecordNotExists
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordKey(id))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+	k.deleteTokenizeShareRecordWithOwner(ctx, sdk.MustAccAddressFromBech32(record.Owner), id)
+
+	return nil
+}
+
+// TransferTokenizeShareRecord sets the owner of the tokenize share record with
+// id to newOwner.
+func (k Keeper) TransferTokenizeShareRecord(ctx sdk.Context, id uint64, newOwner sdk.AccAddress) error {
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return types.ErrTokenizeShareRecordNotExists
+	}
+
+	k.deleteTokenizeShareRecordWithOwner(ctx, sdk.MustAccAddressFromBech32(record.Owner), id)
+	record.Owner = newOwner.String()
+	k.setTokenizeShareRecordWithOwner(ctx, newOwner, id)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordKey(id), k.cdc.MustMarshal(&record))
+
+	return nil
+}
+
+func (k Keeper) setTokenizeShareRecordWithOwner(ctx sdk.Context, owner sdk.AccAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, id), []byte{})
+}
+
+func (k Keeper) deleteTokenizeShareRecordWithOwner(ctx sdk.Context, owner sdk.AccAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, id))
+}
+
+// GetValidatorLiquidShares returns the tokenized shares of the validator.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshal(bz, &dp)
+
+	return dp.Dec
+}
+
+// SetValidatorLiquidShares sets the tokenized shares of the validator, the
+// entry is removed when they are zero.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if !shares.IsPositive() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), k.cdc.MustMarshal(&sdk.DecProto{Dec: shares}))
+}
+
+// IterateValidatorLiquidShares iterates through the validators with tokenized
+// shares.
+func (k Keeper) IterateValidatorLiquidShares(ctx sdk.Context, cb func(valAddr sdk.ValAddress, shares sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorLiquidSharesKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(types.AddressFromValidatorLiquidSharesKey(iterator.Key()))
+
+		dp := sdk.DecProto{}
+		k.cdc.MustUnmarshal(iterator.Value(), &dp)
+
+		if cb(valAddr, dp.Dec) {
+			break
+		}
+	}
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens of the tokenized
+// shares of the bonded validators.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+
+	return ip.Int
+}
+
+// SetTotalLiquidStakedTokens sets the amount of tokens of the tokenized shares
+// of the bonded validators.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TotalLiquidStakedTokensKey, k.cdc.MustMarshal(&sdk.IntProto{Int: tokens}))
+}
+
+// bondedLiquidTokens returns the tokens of the tokenized shares of validator
+// counted in the total liquid staked tokens, none unless it is bonded.
+func (k Keeper) bondedLiquidTokens(ctx sdk.Context, validator types.Validator) math.Int {
+	if !validator.IsBonded() {
+		return sdk.ZeroInt()
+	}
+
+	shares := k.GetValidatorLiquidShares(ctx, validator.GetOperator())
+	if !shares.IsPositive() || !validator.DelegatorShares.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	return validator.TokensFromShares(shares).TruncateInt()
+}
+
+// updateTotalLiquidStakedTokens replaces the before tokens of a validator in
+// the total liquid staked tokens with the after ones. The total is kept
+// non-negative, as the tokens of the shares may be rounded differently over
+// time.
+func (k Keeper) updateTotalLiquidStakedTokens(ctx sdk.Context, before, after math.Int) {
+	total := k.GetTotalLiquidStakedTokens(ctx).Sub(before).Add(after)
+	k.SetTotalLiquidStakedTokens(ctx, sdk.MaxInt(total, sdk.ZeroInt()))
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing shares worth tokens of
+// validator would exceed the global or the validator liquid staking cap. The
+// global cap only applies to the bonded validators.
+func (k Keeper) checkLiquidStakingCaps(ctx sdk.Context, validator types.Validator, shares sdk.Dec, tokens math.Int) error {
+	params := k.GetParams(ctx)
+
+	liquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator()).Add(shares)
+	if liquidShares.GT(validator.DelegatorShares.Mul(params.ValidatorLiquidStakingCap)) {
+		return types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	// only the tokens of the bonded validators count towards the global cap,
+	// as they are compared to the bonded tokens
+	if !validator.IsBonded() {
+		return nil
+	}
+
+	liquidTokens := sdk.NewDecFromInt(k.GetTotalLiquidStakedTokens(ctx).Add(tokens))
+	if liquidTokens.GT(sdk.NewDecFromInt(k.TotalBondedTokens(ctx)).Mul(params.GlobalLiquidStakingCap)) {
+		return types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	return nil
+}
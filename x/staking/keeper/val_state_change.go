@@ -289,6 +289,9 @@ func (k Keeper) bondValidator(ctx sdk.Context, validator types.Validator) (types
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByPowerIndex(ctx, validator)
 
+	// the tokenized shares of the validator now count as liquid staked
+	k.updateTotalLiquidStakedTokens(ctx, sdk.ZeroInt(), k.bondedLiquidTokens(ctx, validator))
+
 	// delete from queue if present
 	k.DeleteValidatorQueue(ctx, validator)
 
@@ -314,6 +317,9 @@ func (k Keeper) beginUnbondingValidator(ctx sdk.Context, validator types.Validat
 		panic(fmt.Sprintf("should not already be unbonded or unbonding, validator: %v\n", validator))
 	}
 
+	// the tokenized shares of the validator no longer count as liquid staked
+	k.updateTotalLiquidStakedTokens(ctx, k.bondedLiquidTokens(ctx, validator), sdk.ZeroInt())
+
 	validator = validator.UpdateStatus(types.Unbonding)
 
 	// set the unbonding completion time and completion height appropriately
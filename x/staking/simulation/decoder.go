@@ -57,6 +57,40 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &redB)
 
 			return fmt.Sprintf("%v\n%v", redA, redB)
+		case bytes.Equal(kvA.Key[:1], types.ParamsKey):
+			var paramsA, paramsB types.Params
+
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordPrefix):
+			var recordA, recordB types.TokenizeShareRecord
+
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordIDByDenomPrefix),
+			bytes.Equal(kvA.Key[:1], types.LastTokenizeShareRecordIDKey):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordIDByOwnerPrefix):
+			// the record id is the suffix of the key
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Key[len(kvA.Key)-8:]), sdk.BigEndianToUint64(kvB.Key[len(kvB.Key)-8:]))
+		case bytes.Equal(kvA.Key[:1], types.ValidatorLiquidSharesKey):
+			var sharesA, sharesB sdk.DecProto
+
+			cdc.MustUnmarshal(kvA.Value, &sharesA)
+			cdc.MustUnmarshal(kvB.Value, &sharesB)
+
+			return fmt.Sprintf("%v\n%v", sharesA, sharesB)
+		case bytes.Equal(kvA.Key[:1], types.TotalLiquidStakedTokensKey):
+			var tokensA, tokensB sdk.IntProto
+
+			cdc.MustUnmarshal(kvA.Value, &tokensA)
+			cdc.MustUnmarshal(kvB.Value, &tokensB)
+
+			return fmt.Sprintf("%v\n%v", tokensA, tokensB)
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
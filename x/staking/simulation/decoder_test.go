@@ -41,6 +41,7 @@ func TestDecodeStore(t *testing.T) {
 	del := types.NewDelegation(delAddr1, valAddr1, sdk.OneDec())
 	ubd := types.NewUnbondingDelegation(delAddr1, valAddr1, 15, bondTime, sdk.OneInt())
 	red := types.NewRedelegation(delAddr1, valAddr1, valAddr1, 12, bondTime, sdk.OneInt(), sdk.OneDec())
+	record := types.NewTokenizeShareRecord(1, delAddr1, valAddr1)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -50,6 +51,11 @@ func TestDecodeStore(t *testing.T) {
 			{Key: types.GetDelegationKey(delAddr1, valAddr1), Value: cdc.MustMarshal(&del)},
 			{Key: types.GetUBDKey(delAddr1, valAddr1), Value: cdc.MustMarshal(&ubd)},
 			{Key: types.GetREDKey(delAddr1, valAddr1, valAddr1), Value: cdc.MustMarshal(&red)},
+			{Key: types.GetTokenizeShareRecordKey(1), Value: cdc.MustMarshal(&record)},
+			{Key: types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), Value: sdk.Uint64ToBigEndian(1)},
+			{Key: types.GetTokenizeShareRecordIDByOwnerAndIDKey(delAddr1, 1), Value: []byte{}},
+			{Key: types.GetValidatorLiquidSharesKey(valAddr1), Value: cdc.MustMarshal(&sdk.DecProto{Dec: sdk.OneDec()})},
+			{Key: types.TotalLiquidStakedTokensKey, Value: cdc.MustMarshal(&sdk.IntProto{Int: sdk.OneInt()})},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -64,6 +70,11 @@ func TestDecodeStore(t *testing.T) {
 		{"Delegation", fmt.Sprintf("%v\n%v", del, del)},
 		{"UnbondingDelegation", fmt.Sprintf("%v\n%v", ubd, ubd)},
 		{"Redelegation", fmt.Sprintf("%v\n%v", red, red)},
+		{"TokenizeShareRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"TokenizeShareRecordIDByDenom", "1\n1"},
+		{"TokenizeShareRecordIDByOwner", "1\n1"},
+		{"ValidatorLiquidShares", fmt.Sprintf("%v\n%v", sdk.DecProto{Dec: sdk.OneDec()}, sdk.DecProto{Dec: sdk.OneDec()})},
+		{"TotalLiquidStakedTokens", fmt.Sprintf("%v\n%v", sdk.IntProto{Int: sdk.OneInt()}, sdk.IntProto{Int: sdk.OneInt()})},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -19,6 +19,9 @@ const (
 	unbondingTime     = "unbonding_time"
 	maxValidators     = "max_validators"
 	historicalEntries = "historical_entries"
+
+	globalLiquidStakingCap    = "global_liquid_staking_cap"
+	validatorLiquidStakingCap = "validator_liquid_staking_cap"
 )
 
 // genUnbondingTime returns randomized UnbondingTime
@@ -36,6 +39,11 @@ func getHistEntries(r *rand.Rand) uint32 {
 	return uint32(r.Intn(int(types.DefaultHistoricalEntries + 1)))
 }
 
+// genLiquidStakingCap returns randomized liquid staking cap between 0-100%.
+func genLiquidStakingCap(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
@@ -44,6 +52,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 		maxVals           uint32
 		histEntries       uint32
 		minCommissionRate sdk.Dec
+		globalCap         sdk.Dec
+		validatorCap      sdk.Dec
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -61,10 +71,20 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { histEntries = getHistEntries(r) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, globalLiquidStakingCap, &globalCap, simState.Rand,
+		func(r *rand.Rand) { globalCap = genLiquidStakingCap(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, validatorLiquidStakingCap, &validatorCap, simState.Rand,
+		func(r *rand.Rand) { validatorCap = genLiquidStakingCap(r) },
+	)
+
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate)
+	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate, globalCap, validatorCap)
 
 	// validators & delegations
 	var (
@@ -21,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/simulation"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
@@ -66,8 +67,8 @@ func TestWeightedOperations(t *testing.T) {
 	}
 }
 
-// TestSimulateMsgCreateValidator tests the normal scenario of a valid message of type TypeMsgCreateValidator.
-// Abonormal scenarios, where the message are created by an errors are not tested here.
+// TestSimulateMsgCreateValidator tests that a valid message of type TypeMsgCreateValidator is
+// rejected by SimApp as it is not wrapped in an epoching message.
 func TestSimulateMsgCreateValidator(t *testing.T) {
 	s := rand.NewSource(1)
 	r := rand.New(s)
@@ -79,19 +80,11 @@ func TestSimulateMsgCreateValidator(t *testing.T) {
 	// execute operation
 	op := simulation.SimulateMsgCreateValidator(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
 	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
-	require.NoError(t, err)
-
-	var msg types.MsgCreateValidator
-	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
-
-	require.True(t, operationMsg.OK)
-	require.Equal(t, "0.080000000000000000", msg.Commission.MaxChangeRate.String())
-	require.Equal(t, "0.080000000000000000", msg.Commission.MaxRate.String())
-	require.Equal(t, "0.019527679037870745", msg.Commission.Rate.String())
-	require.Equal(t, types.TypeMsgCreateValidator, msg.Type())
-	require.Equal(t, []byte{0xa, 0x20, 0x51, 0xde, 0xbd, 0xe8, 0xfa, 0xdf, 0x4e, 0xfc, 0x33, 0xa5, 0x16, 0x94, 0xf6, 0xee, 0xd3, 0x69, 0x7a, 0x7a, 0x1c, 0x2d, 0x50, 0xb6, 0x2, 0xf7, 0x16, 0x4e, 0x66, 0x9f, 0xff, 0x38, 0x91, 0x9b}, msg.Pubkey.Value)
-	require.Equal(t, "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r", msg.DelegatorAddress)
-	require.Equal(t, "cosmosvaloper1ghekyjucln7y67ntx7cf27m9dpuxxemnsvnaes", msg.ValidatorAddress)
+	// SimApp rejects the staking messages which are not queued by the epoching
+	// module, their wrapped counterparts are simulated by x/epoching
+	require.ErrorIs(t, err, epochingtypes.ErrUnwrappedMessage)
+	require.False(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgCreateValidator, operationMsg.Name)
 	require.Len(t, futureOperations, 0)
 }
 
@@ -182,8 +175,8 @@ func TestSimulateMsgEditValidator(t *testing.T) {
 	require.Len(t, futureOperations, 0)
 }
 
-// TestSimulateMsgDelegate tests the normal scenario of a valid message of type TypeMsgDelegate.
-// Abonormal scenarios, where the message is created by an errors are not tested here.
+// TestSimulateMsgDelegate tests that a valid message of type TypeMsgDelegate is
+// rejected by SimApp as it is not wrapped in an epoching message.
 func TestSimulateMsgDelegate(t *testing.T) {
 	s := rand.NewSource(1)
 	r := rand.New(s)
@@ -195,22 +188,16 @@ func TestSimulateMsgDelegate(t *testing.T) {
 	// execute operation
 	op := simulation.SimulateMsgDelegate(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
 	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
-	require.NoError(t, err)
-
-	var msg types.MsgDelegate
-	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
-
-	require.True(t, operationMsg.OK)
-	require.Equal(t, "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r", msg.DelegatorAddress)
-	require.Equal(t, "98100858108421259236", msg.Amount.Amount.String())
-	require.Equal(t, "stake", msg.Amount.Denom)
-	require.Equal(t, types.TypeMsgDelegate, msg.Type())
-	require.Equal(t, "cosmosvaloper1tnh2q55v8wyygtt9srz5safamzdengsn9dsd7z", msg.ValidatorAddress)
+	// SimApp rejects the staking messages which are not queued by the epoching
+	// module, their wrapped counterparts are simulated by x/epoching
+	require.ErrorIs(t, err, epochingtypes.ErrUnwrappedMessage)
+	require.False(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgDelegate, operationMsg.Name)
 	require.Len(t, futureOperations, 0)
 }
 
-// TestSimulateMsgUndelegate tests the normal scenario of a valid message of type TypeMsgUndelegate.
-// Abonormal scenarios, where the message is created by an errors are not tested here.
+// TestSimulateMsgUndelegate tests that a valid message of type TypeMsgUndelegate is
+// rejected by SimApp as it is not wrapped in an epoching message.
 func TestSimulateMsgUndelegate(t *testing.T) {
 	s := rand.NewSource(1)
 	r := rand.New(s)
@@ -241,22 +228,16 @@ func TestSimulateMsgUndelegate(t *testing.T) {
 	// execute operation
 	op := simulation.SimulateMsgUndelegate(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
 	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
-	require.NoError(t, err)
-
-	var msg types.MsgUndelegate
-	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
-
-	require.True(t, operationMsg.OK)
-	require.Equal(t, "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r", msg.DelegatorAddress)
-	require.Equal(t, "280623462081924937", msg.Amount.Amount.String())
-	require.Equal(t, "stake", msg.Amount.Denom)
-	require.Equal(t, types.TypeMsgUndelegate, msg.Type())
-	require.Equal(t, "cosmosvaloper1p8wcgrjr4pjju90xg6u9cgq55dxwq8j7epjs3u", msg.ValidatorAddress)
+	// SimApp rejects the staking messages which are not queued by the epoching
+	// module, their wrapped counterparts are simulated by x/epoching
+	require.ErrorIs(t, err, epochingtypes.ErrUnwrappedMessage)
+	require.False(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgUndelegate, operationMsg.Name)
 	require.Len(t, futureOperations, 0)
 }
 
-// TestSimulateMsgBeginRedelegate tests the normal scenario of a valid message of type TypeMsgBeginRedelegate.
-// Abonormal scenarios, where the message is created by an errors, are not tested here.
+// TestSimulateMsgBeginRedelegate tests that a valid message of type TypeMsgBeginRedelegate is
+// rejected by SimApp as it is not wrapped in an epoching message.
 func TestSimulateMsgBeginRedelegate(t *testing.T) {
 	s := rand.NewSource(12)
 	r := rand.New(s)
@@ -290,18 +271,11 @@ func TestSimulateMsgBeginRedelegate(t *testing.T) {
 	// execute operation
 	op := simulation.SimulateMsgBeginRedelegate(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
 	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
-	require.NoError(t, err)
-
-	var msg types.MsgBeginRedelegate
-	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
-
-	require.True(t, operationMsg.OK)
-	require.Equal(t, "cosmos1092v0qgulpejj8y8hs6dmlw82x9gv8f7jfc7jl", msg.DelegatorAddress)
-	require.Equal(t, "1883752832348281252", msg.Amount.Amount.String())
-	require.Equal(t, "stake", msg.Amount.Denom)
-	require.Equal(t, types.TypeMsgBeginRedelegate, msg.Type())
-	require.Equal(t, "cosmosvaloper1gnkw3uqzflagcqn6ekjwpjanlne928qhruemah", msg.ValidatorDstAddress)
-	require.Equal(t, "cosmosvaloper1kk653svg7ksj9fmu85x9ygj4jzwlyrgs89nnn2", msg.ValidatorSrcAddress)
+	// SimApp rejects the staking messages which are not queued by the epoching
+	// module, their wrapped counterparts are simulated by x/epoching
+	require.ErrorIs(t, err, epochingtypes.ErrUnwrappedMessage)
+	require.False(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgBeginRedelegate, operationMsg.Name)
 	require.Len(t, futureOperations, 0)
 }
 
@@ -10,7 +10,7 @@ import (
 // Tracking issues https://github.com/cosmos/cosmos-sdk/issues/9054, https://github.com/cosmos/cosmos-sdk/discussions/9072
 const gasCostPerIteration = uint64(10)
 
-var _ authz.Authorization = &StakeAuthorization{}
+var _ authz.SpendLimitAuthorization = &StakeAuthorization{}
 
 // NewStakeAuthorization creates a new StakeAuthorization object.
 func NewStakeAuthorization(allowed []sdk.ValAddress, denied []sdk.ValAddress, authzType AuthorizationType, amount *sdk.Coin) (*StakeAuthorization, error) {
@@ -115,6 +115,25 @@ func (a StakeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRe
 	}, nil
 }
 
+// SpentCoins implements SpendLimitAuthorization.SpentCoins, it returns the
+// tokens consumed from MaxTokens, if any.
+func (a StakeAuthorization) SpentCoins(msg sdk.Msg) sdk.Coins {
+	if a.MaxTokens == nil {
+		return nil
+	}
+
+	switch msg := msg.(type) {
+	case *MsgDelegate:
+		return sdk.NewCoins(msg.Amount)
+	case *MsgUndelegate:
+		return sdk.NewCoins(msg.Amount)
+	case *MsgBeginRedelegate:
+		return sdk.NewCoins(msg.Amount)
+	default:
+		return nil
+	}
+}
+
 func validateAllowAndDenyValidators(allowed []sdk.ValAddress, denied []sdk.ValAddress) ([]string, []string, error) {
 	if len(allowed) == 0 && len(denied) == 0 {
 		return nil, nil, sdkerrors.ErrInvalidRequest.Wrap("both allowed & deny list cannot be empty")
@@ -5,7 +5,6 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -44,6 +43,13 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records active at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last tokenize share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// total_liquid_staked_tokens tracks the tokens of the tokenized shares of the
+	// bonded validators, it is only used if exported is true.
+	TotalLiquidStakedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=total_liquid_staked_tokens,json=totalLiquidStakedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_liquid_staked_tokens"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -128,6 +134,20 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -179,38 +199,42 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0x6d, 0x92, 0xa6, 0x61, 0x52, 0x10, 0x1a, 0x52, 0x64, 0xb2, 0x70, 0x42, 0x54, 0xa1,
-	0x08, 0xa8, 0xad, 0x86, 0x1d, 0x62, 0x43, 0x84, 0xa8, 0x40, 0x2c, 0x22, 0x17, 0x10, 0x62, 0x13,
-	0x4d, 0xea, 0xc1, 0xb5, 0xea, 0x78, 0xac, 0x79, 0x2f, 0xa5, 0xdc, 0x80, 0x25, 0x47, 0xe8, 0x21,
-	0x38, 0x44, 0x97, 0x15, 0x2b, 0xc4, 0xa2, 0x42, 0xc9, 0x86, 0x1b, 0xb0, 0x45, 0x9e, 0x99, 0x18,
-	0xa3, 0x60, 0x56, 0xc9, 0xe8, 0x7d, 0xff, 0x37, 0xbf, 0xe4, 0x37, 0x64, 0xe7, 0x50, 0xc0, 0x4c,
-	0x80, 0x0f, 0xc8, 0x8e, 0xe3, 0x34, 0xf2, 0x4f, 0xf6, 0xa6, 0x1c, 0xd9, 0x9e, 0x1f, 0xf1, 0x94,
-	0x43, 0x0c, 0x5e, 0x26, 0x05, 0x0a, 0x7a, 0x4b, 0x53, 0x9e, 0xa1, 0x3c, 0x43, 0x75, 0xda, 0x91,
-	0x88, 0x84, 0x42, 0xfc, 0xfc, 0x9f, 0xa6, 0x3b, 0x55, 0xce, 0x55, 0x5a, 0x53, 0xb7, 0x35, 0x35,
-	0xd1, 0x71, 0x73, 0x81, 0x3a, 0xf4, 0x7f, 0xd5, 0xc9, 0xd6, 0xbe, 0x2e, 0x70, 0x80, 0x0c, 0x39,
-	0x7d, 0x4c, 0x1a, 0x19, 0x93, 0x6c, 0x06, 0x8e, 0xdd, 0xb3, 0x07, 0xad, 0xa1, 0xeb, 0xfd, 0xbb,
-	0x90, 0x37, 0x56, 0xd4, 0xa8, 0x7e, 0x7e, 0xd9, 0xb5, 0x02, 0x93, 0xa1, 0x6f, 0xc9, 0x8d, 0x84,
-	0x01, 0x4e, 0x50, 0x20, 0x4b, 0x26, 0x99, 0xf8, 0xc0, 0xa5, 0x73, 0xa5, 0x67, 0x0f, 0xb6, 0x46,
-	0x5e, 0xce, 0x7d, 0xbf, 0xec, 0xde, 0x8d, 0x62, 0x3c, 0x9a, 0x4f, 0xbd, 0x43, 0x31, 0x33, 0x4d,
-	0xcc, 0xcf, 0x2e, 0x84, 0xc7, 0x3e, 0x7e, 0xcc, 0x38, 0x78, 0xcf, 0x53, 0x0c, 0xae, 0xe7, 0x9e,
-	0x57, 0xb9, 0x66, 0x9c, 0x5b, 0x68, 0x48, 0xb6, 0x95, 0xf9, 0x84, 0x25, 0x71, 0xc8, 0x50, 0x48,
-	0x6d, 0x07, 0xa7, 0xd6, 0xab, 0x0d, 0x5a, 0xc3, 0x7b, 0x55, 0x35, 0x5f, 0x32, 0xc0, 0x37, 0xab,
-	0x8c, 0x52, 0x99, 0xca, 0x37, 0x93, 0xb5, 0x09, 0xd0, 0x7d, 0x42, 0x8a, 0x0b, 0xc0, 0xa9, 0x2b,
-	0xf5, 0x9d, 0x2a, 0x75, 0x11, 0x36, 0xc6, 0x52, 0x94, 0xbe, 0x20, 0xad, 0x90, 0x27, 0x3c, 0x62,
-	0x18, 0x8b, 0x14, 0x9c, 0x0d, 0x65, 0xea, 0x57, 0x99, 0x9e, 0x16, 0xa8, 0x51, 0x95, 0xc3, 0xf4,
-	0x3d, 0xd9, 0x9e, 0xa7, 0x53, 0x91, 0x86, 0x71, 0x1a, 0x4d, 0xca, 0xd6, 0x86, 0xb2, 0xde, 0xaf,
-	0xb2, 0xbe, 0x5e, 0x85, 0xd6, 0xf4, 0xed, 0xf9, 0xfa, 0x08, 0xe8, 0x98, 0x5c, 0x93, 0xbc, 0xec,
-	0xdf, 0x54, 0xfe, 0x9d, 0x2a, 0x7f, 0x50, 0x82, 0x8d, 0xf8, 0x6f, 0x01, 0xed, 0x90, 0x26, 0x3f,
-	0xcd, 0x84, 0x44, 0x1e, 0x3a, 0xcd, 0x9e, 0x3d, 0x68, 0x06, 0xc5, 0xb9, 0x7f, 0x44, 0xe8, 0xfa,
-	0xb7, 0xa1, 0x43, 0xb2, 0xc9, 0xc2, 0x50, 0x72, 0xd0, 0xfb, 0x77, 0x75, 0xe4, 0x7c, 0xfd, 0xb2,
-	0xdb, 0x36, 0x05, 0x9e, 0xe8, 0xc9, 0x01, 0xca, 0x38, 0x8d, 0x82, 0x15, 0x48, 0xdb, 0x64, 0xe3,
-	0xcf, 0xa6, 0xd5, 0x02, 0x7d, 0x78, 0xd4, 0xfc, 0x74, 0xd6, 0xb5, 0x7e, 0x9e, 0x75, 0xad, 0xd1,
-	0xb3, 0xf3, 0x85, 0x6b, 0x5f, 0x2c, 0x5c, 0xfb, 0xc7, 0xc2, 0xb5, 0x3f, 0x2f, 0x5d, 0xeb, 0x62,
-	0xe9, 0x5a, 0xdf, 0x96, 0xae, 0xf5, 0xee, 0xc1, 0x7f, 0x97, 0xf1, 0xb4, 0x78, 0x56, 0x6a, 0x2d,
-	0xa7, 0x0d, 0xf5, 0x64, 0x1e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x73, 0xbd, 0x93, 0xb3, 0xc9,
-	0x03, 0x00, 0x00,
+	// 549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xed, 0x2f, 0x69, 0x92, 0x4e, 0xfa, 0x21, 0x34, 0xa4, 0xc5, 0x44, 0xc2, 0x09, 0x51,
+	0x85, 0x22, 0xfe, 0xd8, 0x6a, 0xd9, 0x21, 0x16, 0x28, 0x42, 0x54, 0x45, 0x15, 0x8a, 0x9c, 0x82,
+	0x10, 0x1b, 0x6b, 0x92, 0x19, 0x5c, 0x2b, 0x8e, 0x27, 0xcc, 0x9d, 0x94, 0xc2, 0x13, 0xb0, 0x83,
+	0x47, 0xe8, 0xe3, 0x74, 0xd9, 0x25, 0x62, 0x51, 0xa1, 0x64, 0xc3, 0x63, 0x20, 0xcf, 0x38, 0x26,
+	0xe0, 0xba, 0x0b, 0x56, 0xf6, 0xd5, 0x3d, 0xe7, 0x77, 0xcf, 0x48, 0x77, 0x06, 0x6d, 0x8f, 0x38,
+	0x4c, 0x38, 0xb8, 0x20, 0xc9, 0x38, 0x8c, 0x03, 0xf7, 0x78, 0x67, 0xc8, 0x24, 0xd9, 0x71, 0x03,
+	0x16, 0x33, 0x08, 0xc1, 0x99, 0x0a, 0x2e, 0x39, 0xde, 0xd2, 0x2a, 0x27, 0x55, 0x39, 0xa9, 0xaa,
+	0xd9, 0x08, 0x78, 0xc0, 0x95, 0xc4, 0x4d, 0xfe, 0xb4, 0xba, 0x59, 0xc4, 0x5c, 0xba, 0x95, 0xaa,
+	0xf3, 0xa5, 0x8a, 0x36, 0xf6, 0xf4, 0x94, 0x81, 0x24, 0x92, 0xe1, 0x27, 0xa8, 0x32, 0x25, 0x82,
+	0x4c, 0xc0, 0x32, 0xdb, 0x66, 0xb7, 0xbe, 0x6b, 0x3b, 0x97, 0x4f, 0x75, 0xfa, 0x4a, 0xd5, 0x2b,
+	0x9f, 0x5d, 0xb4, 0x0c, 0x2f, 0xf5, 0xe0, 0x37, 0xe8, 0x7a, 0x44, 0x40, 0xfa, 0x92, 0x4b, 0x12,
+	0xf9, 0x53, 0xfe, 0x81, 0x09, 0xeb, 0xbf, 0xb6, 0xd9, 0xdd, 0xe8, 0x39, 0x89, 0xee, 0xfb, 0x45,
+	0xeb, 0x6e, 0x10, 0xca, 0xa3, 0xd9, 0xd0, 0x19, 0xf1, 0x89, 0x9b, 0x26, 0xd4, 0x9f, 0x87, 0x40,
+	0xc7, 0xae, 0xfc, 0x38, 0x65, 0xe0, 0xec, 0xc7, 0xd2, 0xbb, 0x96, 0x70, 0x0e, 0x13, 0x4c, 0x3f,
+	0xa1, 0x60, 0x8a, 0x36, 0x15, 0xf9, 0x98, 0x44, 0x21, 0x25, 0x92, 0x0b, 0x4d, 0x07, 0xab, 0xd4,
+	0x2e, 0x75, 0xeb, 0xbb, 0xf7, 0x8a, 0x62, 0x1e, 0x10, 0x90, 0xaf, 0x97, 0x1e, 0x85, 0x4a, 0x23,
+	0xdf, 0x88, 0x72, 0x1d, 0xc0, 0x7b, 0x08, 0x65, 0x03, 0xc0, 0x2a, 0x2b, 0xf4, 0x9d, 0x22, 0x74,
+	0x66, 0x4e, 0x89, 0x2b, 0x56, 0xfc, 0x02, 0xd5, 0x29, 0x8b, 0x58, 0x40, 0x64, 0xc8, 0x63, 0xb0,
+	0xd6, 0x14, 0xa9, 0x53, 0x44, 0x7a, 0x96, 0x49, 0x53, 0xd4, 0xaa, 0x19, 0xbf, 0x43, 0x9b, 0xb3,
+	0x78, 0xc8, 0x63, 0x1a, 0xc6, 0x81, 0xbf, 0x4a, 0xad, 0x28, 0xea, 0xfd, 0x22, 0xea, 0xab, 0xa5,
+	0x29, 0x87, 0x6f, 0xcc, 0xf2, 0x2d, 0xc0, 0x7d, 0xf4, 0xbf, 0x60, 0xab, 0xfc, 0xaa, 0xe2, 0x6f,
+	0x17, 0xf1, 0x3d, 0x46, 0xff, 0x06, 0xff, 0x09, 0xc0, 0x4d, 0x54, 0x63, 0x27, 0x53, 0x2e, 0x24,
+	0xa3, 0x56, 0xad, 0x6d, 0x76, 0x6b, 0x5e, 0x56, 0xe3, 0x00, 0x6d, 0x49, 0x3e, 0x66, 0x71, 0xf8,
+	0x89, 0xf9, 0x70, 0x44, 0x04, 0xf3, 0x05, 0x1b, 0x71, 0x41, 0xc1, 0x5a, 0xbf, 0xfa, 0x58, 0x87,
+	0xa9, 0x6b, 0x90, 0x98, 0x3c, 0xe5, 0x59, 0x1e, 0x4b, 0xe6, 0x5b, 0x80, 0x9f, 0xa2, 0xdb, 0xe9,
+	0x4e, 0x5e, 0x32, 0xcd, 0x0f, 0xa9, 0x85, 0xda, 0x66, 0xb7, 0xec, 0xdd, 0xd2, 0x0b, 0x97, 0x03,
+	0xec, 0x53, 0x3c, 0x46, 0x4d, 0xbd, 0xd0, 0x51, 0xf8, 0x7e, 0x16, 0x52, 0x3f, 0x49, 0xc4, 0xa8,
+	0x06, 0x82, 0x55, 0xff, 0xa7, 0xfd, 0xbe, 0xa9, 0x88, 0x07, 0x0a, 0x38, 0x50, 0x3c, 0x35, 0x1b,
+	0x3a, 0x2f, 0x11, 0xce, 0xef, 0x2c, 0xb6, 0x50, 0x95, 0x50, 0x2a, 0x18, 0xe8, 0x7b, 0xb9, 0xee,
+	0x2d, 0x4b, 0xdc, 0x40, 0x6b, 0xbf, 0xef, 0x59, 0xc9, 0xd3, 0xc5, 0xe3, 0xda, 0xe7, 0xd3, 0x96,
+	0xf1, 0xf3, 0xb4, 0x65, 0xf4, 0x9e, 0x9f, 0xcd, 0x6d, 0xf3, 0x7c, 0x6e, 0x9b, 0x3f, 0xe6, 0xb6,
+	0xf9, 0x75, 0x61, 0x1b, 0xe7, 0x0b, 0xdb, 0xf8, 0xb6, 0xb0, 0x8d, 0xb7, 0x0f, 0xae, 0x8c, 0x7a,
+	0x92, 0xbd, 0x1c, 0x2a, 0xf4, 0xb0, 0xa2, 0x1e, 0x8c, 0x47, 0xbf, 0x06, 0x00, 0xec, 0x8f, 0x59,
+	0xbe, 0xac, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -233,6 +257,35 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TotalLiquidStakedTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -425,6 +478,17 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	l = m.TotalLiquidStakedTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -735,6 +799,92 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedTokens", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
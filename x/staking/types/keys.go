@@ -49,6 +49,15 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
+
+	TokenizeShareRecordPrefix          = []byte{0x61} // key for a tokenize share record
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x62} // prefix for each key for a tokenize share record, by owner
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x63} // key for the id of a tokenize share record, by share token denom
+	LastTokenizeShareRecordIDKey       = []byte{0x64} // key for the id of the last tokenize share record
+	ValidatorLiquidSharesKey           = []byte{0x65} // prefix for the tokenized shares of each validator
+	TotalLiquidStakedTokensKey         = []byte{0x66} // key for the total liquid staked tokens of the bonded validators
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -348,3 +357,42 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordKey creates the key for the tokenize share record with id
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix creates the prefix of the index of
+// the tokenize share records of owner
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey creates the key of the index of the
+// tokenize share record with id by owner
+// VALUE: none (key rearrangement used)
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey creates the key of the index of the
+// tokenize share record of the share token denom
+// VALUE: record id (8 bytes)
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetValidatorLiquidSharesKey creates the key for the tokenized shares of the
+// validator with address
+// VALUE: sdk.DecProto
+func GetValidatorLiquidSharesKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// AddressFromValidatorLiquidSharesKey creates the validator operator address from ValidatorLiquidSharesKey
+func AddressFromValidatorLiquidSharesKey(key []byte) []byte {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
@@ -0,0 +1,317 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// FlagEnableForceTransfer is the flag enabling the force transfers of a new
+// denom.
+const FlagEnableForceTransfer = "enable-force-transfer"
+
+// NewTxCmd returns a root CLI command handler for all x/tokenfactory transaction commands.
+func NewTxCmd() *cobra.Command {
+	tokenfactoryTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Tokenfactory transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	tokenfactoryTxCmd.AddCommand(
+		NewCreateDenomCmd(),
+		NewMintCmd(),
+		NewBurnCmd(),
+		NewForceTransferCmd(),
+		NewChangeAdminCmd(),
+		NewSetDenomMetadataCmd(),
+	)
+
+	return tokenfactoryTxCmd
+}
+
+// NewCreateDenomCmd returns a CLI command handler for creating a MsgCreateDenom transaction.
+func NewCreateDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom [subdenom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create the denom factory/{sender}/{subdenom}",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create the denom factory/{sender}/{subdenom}, with the sender as admin. The
+sender pays the denom creation fee to the community pool. With the --%s
+flag, the admin can burn and transfer the denom from any account.
+
+Example:
+$ %s tx tokenfactory create-denom mytoken --from mykey
+`,
+				FlagEnableForceTransfer, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enableForceTransfer, err := cmd.Flags().GetBool(FlagEnableForceTransfer)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDenom(clientCtx.GetFromAddress(), args[0], enableForceTransfer)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagEnableForceTransfer, false, "Allow the admin to burn and transfer the denom from any account")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMintCmd returns a CLI command handler for creating a MsgMint transaction.
+func NewMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [amount] [mint-to-address]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Mint coins of a denom, as its admin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mint coins of a denom created by the tokenfactory, to the sender or to the
+given address. Only the admin of the denom can mint it.
+
+Example:
+$ %s tx tokenfactory mint 1000factory/cosmos1.../mytoken --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			var mintTo sdk.AccAddress
+			if len(args) > 1 {
+				mintTo, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgMint(clientCtx.GetFromAddress(), amount, mintTo)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewBurnCmd returns a CLI command handler for creating a MsgBurn transaction.
+func NewBurnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [amount] [burn-from-address]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Burn coins of a denom, as its admin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn coins of a denom created by the tokenfactory, from the sender or, if the
+force transfers of the denom are enabled, from the given address. Only the admin of the
+denom can burn it.
+
+Example:
+$ %s tx tokenfactory burn 1000factory/cosmos1.../mytoken --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			var burnFrom sdk.AccAddress
+			if len(args) > 1 {
+				burnFrom, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress(), amount, burnFrom)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewForceTransferCmd returns a CLI command handler for creating a MsgForceTransfer transaction.
+func NewForceTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-transfer [amount] [transfer-from-address] [transfer-to-address]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Transfer coins of a denom from any account, as its admin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer coins of a denom created by the tokenfactory between two accounts.
+Only the admin of the denom can transfer it, when the force transfers of the
+denom are enabled.
+
+Example:
+$ %s tx tokenfactory force-transfer 1000factory/cosmos1.../mytoken cosmos1... cosmos1... --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			from, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			to, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgForceTransfer(clientCtx.GetFromAddress(), amount, from, to)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewChangeAdminCmd returns a CLI command handler for creating a MsgChangeAdmin transaction.
+func NewChangeAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-admin [denom] [new-admin-address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Change the admin of a denom, as its admin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Change the admin of a denom created by the tokenfactory. An empty new admin
+removes the admin, making the supply of the denom immutable.
+
+Example:
+$ %s tx tokenfactory change-admin factory/cosmos1.../mytoken cosmos1... --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var newAdmin sdk.AccAddress
+			if args[1] != "" {
+				newAdmin, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgChangeAdmin(clientCtx.GetFromAddress(), args[0], newAdmin)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetDenomMetadataCmd returns a CLI command handler for creating a MsgSetDenomMetadata transaction.
+func NewSetDenomMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Set the bank metadata of a denom, as its admin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the bank metadata of a denom created by the tokenfactory, read from a
+JSON file. The base of the metadata is the denom.
+
+Example:
+$ %s tx tokenfactory set-denom-metadata metadata.json --from mykey
+
+Where metadata.json contains:
+
+{
+  "description": "My token",
+  "denom_units": [
+    {"denom": "factory/cosmos1.../mytoken", "exponent": 0},
+    {"denom": "mytoken", "exponent": 6}
+  ],
+  "base": "factory/cosmos1.../mytoken",
+  "display": "mytoken",
+  "name": "My Token",
+  "symbol": "MTK"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomMetadata(clientCtx.GetFromAddress(), metadata)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// ValidateAdmin returns an error if the denom was not created by the module,
+// or if admin is not its admin.
+func (k Keeper) ValidateAdmin(ctx sdk.Context, denom string, admin sdk.AccAddress) error {
+	metadata, found := k.GetAuthorityMetadata(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrDenomDoesNotExist, "denom %s", denom)
+	}
+
+	if metadata.Admin == "" || metadata.Admin != admin.String() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", admin, denom)
+	}
+
+	return nil
+}
+
+// ValidateForceTransfer returns an error if the denom was not created by the
+// module, or if its admin cannot burn and transfer it from any account.
+func (k Keeper) ValidateForceTransfer(ctx sdk.Context, denom string) error {
+	metadata, found := k.GetAuthorityMetadata(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrDenomDoesNotExist, "denom %s", denom)
+	}
+
+	if !metadata.EnableForceTransfer {
+		return sdkerrors.Wrapf(types.ErrForceTransferDisabled, "denom %s", denom)
+	}
+
+	return nil
+}
+
+// SetAdmin sets the admin of a denom, an empty admin removes it.
+func (k Keeper) SetAdmin(ctx sdk.Context, denom string, newAdmin sdk.AccAddress) error {
+	metadata, found := k.GetAuthorityMetadata(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrDenomDoesNotExist, "denom %s", denom)
+	}
+
+	metadata.Admin = ""
+	if !newAdmin.Empty() {
+		metadata.Admin = newAdmin.String()
+	}
+
+	return k.SetAuthorityMetadata(ctx, denom, metadata)
+}
+
+// MintTo mints coins of a denom created by the module to an account.
+func (k Keeper) MintTo(ctx sdk.Context, amount sdk.Coin, mintTo sdk.AccAddress) error {
+	if _, found := k.GetAuthorityMetadata(ctx, amount.Denom); !found {
+		return sdkerrors.Wrapf(types.ErrDenomDoesNotExist, "denom %s", amount.Denom)
+	}
+
+	if k.bankKeeper.BlockedAddr(mintTo) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", mintTo)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mintTo, coins)
+}
+
+// BurnFrom burns coins of a denom created by the module from an account.
+func (k Keeper) BurnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom sdk.AccAddress) error {
+	if _, found := k.GetAuthorityMetadata(ctx, amount.Denom); !found {
+		return sdkerrors.Wrapf(types.ErrDenomDoesNotExist, "denom %s", amount.Denom)
+	}
+
+	if err := k.validateHolder(ctx, burnFrom); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, burnFrom, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+// TransferFrom transfers coins of a denom created by the module between two
+// accounts.
+func (k Keeper) TransferFrom(ctx sdk.Context, amount sdk.Coin, from, to sdk.AccAddress) error {
+	if _, found := k.GetAuthorityMetadata(ctx, amount.Denom); !found {
+		return sdkerrors.Wrapf(types.ErrDenomDoesNotExist, "denom %s", amount.Denom)
+	}
+
+	if err := k.validateHolder(ctx, from); err != nil {
+		return err
+	}
+
+	if k.bankKeeper.BlockedAddr(to) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", to)
+	}
+
+	return k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(amount))
+}
+
+// validateHolder returns an error if the coins of an account cannot be burnt
+// or transferred by an admin. Module accounts and blocked addresses hold coins
+// on behalf of the protocol, e.g. the bonded or escrowed coins.
+func (k Keeper) validateHolder(ctx sdk.Context, addr sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(addr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot take the funds of the blocked address %s", addr)
+	}
+
+	if _, ok := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI); ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot take the funds of the module account %s", addr)
+	}
+
+	return nil
+}
+
+// SetBankMetadata sets the bank metadata of a denom created by the module.
+func (k Keeper) SetBankMetadata(ctx sdk.Context, metadata banktypes.Metadata) error {
+	if _, found := k.GetAuthorityMetadata(ctx, metadata.Base); !found {
+		return sdkerrors.Wrapf(types.ErrDenomDoesNotExist, "denom %s", metadata.Base)
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidDenom, err.Error())
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	return nil
+}
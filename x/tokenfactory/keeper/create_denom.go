@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// CreateDenom creates the denom factory/{creator}/{subdenom}, with creator as
+// admin, and charges the creator the denom creation fee. The force transfers
+// of the denom can only be enabled if the EnableForceTransfer param is true.
+// It returns the new denom.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string, enableForceTransfer bool) (string, error) {
+	denom, err := types.GetTokenDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	params := k.GetParams(ctx)
+	if enableForceTransfer && !params.EnableForceTransfer {
+		return "", sdkerrors.Wrap(types.ErrForceTransferDisabled, "cannot create a denom with force transfers")
+	}
+
+	if _, found := k.GetAuthorityMetadata(ctx, denom); found {
+		return "", sdkerrors.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return "", sdkerrors.Wrapf(types.ErrDenomExists, "denom %s has a bank metadata", denom)
+	}
+
+	fee := params.DenomCreationFee
+	if !fee.IsZero() {
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, fee, creator); err != nil {
+			return "", sdkerrors.Wrap(err, "unable to pay the denom creation fee")
+		}
+	}
+
+	if err := k.createDenomAfterValidation(ctx, creator, denom, types.DenomAuthorityMetadata{
+		Admin:               creator.String(),
+		EnableForceTransfer: enableForceTransfer,
+	}); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// createDenomAfterValidation stores a new denom with its authorities, and sets
+// a default bank metadata if it has none.
+func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creator sdk.AccAddress, denom string, metadata types.DenomAuthorityMetadata) error {
+	if err := k.SetAuthorityMetadata(ctx, denom, metadata); err != nil {
+		return err
+	}
+	k.addDenomFromCreator(ctx, creator, denom)
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); !found {
+		k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+			Base:       denom,
+			Display:    denom,
+			Name:       denom,
+			Symbol:     denom,
+		})
+	}
+
+	return nil
+}
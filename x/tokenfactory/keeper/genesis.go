@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// InitGenesis initializes the tokenfactory module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
+	for _, denom := range genState.FactoryDenoms {
+		creator, _, err := types.DeconstructDenom(denom.Denom)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.createDenomAfterValidation(ctx, creator, denom.Denom, denom.AuthorityMetadata); err != nil {
+			panic(fmt.Errorf("error on creating denom %s: %w", denom.Denom, err))
+		}
+	}
+}
+
+// ExportGenesis returns the tokenfactory module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	denoms := []types.GenesisDenom{}
+	k.IterateAuthorityMetadata(ctx, func(denom string, metadata types.DenomAuthorityMetadata) bool {
+		denoms = append(denoms, types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), denoms)
+}
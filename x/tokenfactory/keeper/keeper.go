@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// storedValue is the value of the creator index, which only matters by its
+// key.
+var storedValue = []byte{0x01}
+
+// Keeper of the tokenfactory store
+type Keeper struct {
+	storeKey            storetypes.StoreKey
+	cdc                 codec.BinaryCodec
+	accountKeeper       types.AccountKeeper
+	bankKeeper          types.BankKeeper
+	communityPoolKeeper types.CommunityPoolKeeper
+
+	// the address capable of executing a MsgUpdateParams message, usually the
+	// gov module account
+	authority string
+}
+
+// NewKeeper creates a new tokenfactory Keeper instance. The denom creation
+// fees are paid to the community pool through the community pool keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, ak types.AccountKeeper, bk types.BankKeeper,
+	cpk types.CommunityPoolKeeper, authority string,
+) Keeper {
+	// ensure the tokenfactory module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	return Keeper{
+		storeKey:            key,
+		cdc:                 cdc,
+		accountKeeper:       ak,
+		bankKeeper:          bk,
+		communityPoolKeeper: cpk,
+		authority:           authority,
+	}
+}
+
+// GetAuthority returns the address of the tokenfactory module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of tokenfactory parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the total set of tokenfactory parameters. It returns an error
+// if the parameters are invalid.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+
+	return nil
+}
+
+// GetAuthorityMetadata returns the authorities of a denom, and false if the
+// denom was not created by the module.
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DenomAuthorityMetadataKey(denom))
+	if bz == nil {
+		return types.DenomAuthorityMetadata{}, false
+	}
+
+	var metadata types.DenomAuthorityMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+
+	return metadata, true
+}
+
+// SetAuthorityMetadata sets the authorities of a denom.
+func (k Keeper) SetAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) error {
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DenomAuthorityMetadataKey(denom), k.cdc.MustMarshal(&metadata))
+
+	return nil
+}
+
+// IterateAuthorityMetadata iterates over the denoms created by the module with
+// their authorities. The iteration stops if the callback returns true.
+func (k Keeper) IterateAuthorityMetadata(ctx sdk.Context, cb func(denom string, metadata types.DenomAuthorityMetadata) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomAuthorityMetadataPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.DenomAuthorityMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+
+		denom := string(iterator.Key()[len(types.DenomAuthorityMetadataPrefix):])
+		if cb(denom, metadata) {
+			break
+		}
+	}
+}
+
+// GetDenomsFromCreator returns the denoms created by an account.
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator sdk.AccAddress) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreatorPrefixKey(creator))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+
+	return denoms
+}
+
+func (k Keeper) addDenomFromCreator(ctx sdk.Context, creator sdk.AccAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreatorDenomKey(creator, denom), storedValue)
+}
@@ -0,0 +1,312 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	authority   sdk.AccAddress
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.TokenFactoryKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+	s.msgServer = keeper.NewMsgServerImpl(app.TokenFactoryKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// createDenom creates the denom factory/{addrs[0]}/{subdenom}.
+func (s *KeeperTestSuite) createDenom(subdenom string) string {
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.ctx), types.NewMsgCreateDenom(s.addrs[0], subdenom, false))
+	s.Require().NoError(err)
+	return res.NewTokenDenom
+}
+
+// createForceTransferDenom sets the EnableForceTransfer param and creates the
+// denom factory/{addrs[0]}/{subdenom} with the force transfers enabled.
+func (s *KeeperTestSuite) createForceTransferDenom(subdenom string) string {
+	params := s.app.TokenFactoryKeeper.GetParams(s.ctx)
+	params.EnableForceTransfer = true
+	s.Require().NoError(s.app.TokenFactoryKeeper.SetParams(s.ctx, params))
+
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.ctx), types.NewMsgCreateDenom(s.addrs[0], subdenom, true))
+	s.Require().NoError(err)
+	return res.NewTokenDenom
+}
+
+func (s *KeeperTestSuite) TestCreateDenom() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	fee := s.app.TokenFactoryKeeper.GetParams(s.ctx).DenomCreationFee
+	balance := s.app.BankKeeper.GetAllBalances(s.ctx, s.addrs[0])
+	pool := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx)
+
+	denom := s.createDenom("bitcoin")
+	require.Equal("factory/"+s.addrs[0].String()+"/bitcoin", denom)
+
+	// the creation fee is paid to the community pool
+	require.Equal(balance.Sub(fee...), s.app.BankKeeper.GetAllBalances(s.ctx, s.addrs[0]))
+	require.Equal(pool.Add(sdk.NewDecCoinsFromCoins(fee...)...), s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx))
+
+	// the denom has a default bank metadata
+	metadata, found := s.app.BankKeeper.GetDenomMetaData(s.ctx, denom)
+	require.True(found)
+	require.Equal(denom, metadata.Base)
+
+	// a denom cannot be created twice
+	_, err := s.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(s.addrs[0], "bitcoin", false))
+	require.ErrorIs(err, types.ErrDenomExists)
+
+	// the same subdenom is available to other creators
+	_, err = s.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(s.addrs[1], "bitcoin", false))
+	require.NoError(err)
+
+	// the force transfers must be allowed by the params
+	_, err = s.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(s.addrs[0], "ethereum", true))
+	require.ErrorIs(err, types.ErrForceTransferDisabled)
+
+	// the fee must be affordable
+	params := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000)), false)
+	require.NoError(s.app.TokenFactoryKeeper.SetParams(s.ctx, params))
+	_, err = s.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(s.addrs[0], "litecoin", false))
+	require.Error(err)
+
+	// denoms are free without a creation fee
+	require.NoError(s.app.TokenFactoryKeeper.SetParams(s.ctx, types.NewParams(sdk.NewCoins(), false)))
+	balance = s.app.BankKeeper.GetAllBalances(s.ctx, s.addrs[0])
+	s.createDenom("litecoin")
+	require.Equal(balance, s.app.BankKeeper.GetAllBalances(s.ctx, s.addrs[0]))
+
+	res, err := s.queryClient.DenomsFromCreator(goCtx, &types.QueryDenomsFromCreatorRequest{Creator: s.addrs[0].String()})
+	require.NoError(err)
+	require.Equal([]string{denom, "factory/" + s.addrs[0].String() + "/litecoin"}, res.Denoms)
+}
+
+func (s *KeeperTestSuite) TestMintAndBurn() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	denom := s.createDenom("bitcoin")
+	coin := sdk.NewInt64Coin(denom, 100)
+
+	// only the admin mints
+	_, err := s.msgServer.Mint(goCtx, types.NewMsgMint(s.addrs[1], coin, nil))
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(s.addrs[0], sdk.NewInt64Coin("stake", 100), nil))
+	require.ErrorIs(err, types.ErrDenomDoesNotExist)
+
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(s.addrs[0], coin, nil))
+	require.NoError(err)
+	require.Equal(coin, s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], denom))
+
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(s.addrs[0], coin, s.addrs[1]))
+	require.NoError(err)
+	require.Equal(coin, s.app.BankKeeper.GetBalance(s.ctx, s.addrs[1], denom))
+
+	// module accounts cannot receive the minted coins
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(s.addrs[0], coin, authtypes.NewModuleAddress(distrtypes.ModuleName)))
+	require.Error(err)
+
+	// the admin burns its own coins
+	_, err = s.msgServer.Burn(goCtx, types.NewMsgBurn(s.addrs[0], sdk.NewInt64Coin(denom, 40), nil))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 60), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], denom))
+	require.Equal(sdk.NewInt64Coin(denom, 160), s.app.BankKeeper.GetSupply(s.ctx, denom))
+
+	// burning from another account requires the force transfers of the denom
+	_, err = s.msgServer.Burn(goCtx, types.NewMsgBurn(s.addrs[0], sdk.NewInt64Coin(denom, 40), s.addrs[1]))
+	require.ErrorIs(err, types.ErrForceTransferDisabled)
+
+	// enabling the param doesn't enable the force transfers of existing denoms
+	forceDenom := s.createForceTransferDenom("ethereum")
+	_, err = s.msgServer.Burn(goCtx, types.NewMsgBurn(s.addrs[0], sdk.NewInt64Coin(denom, 40), s.addrs[1]))
+	require.ErrorIs(err, types.ErrForceTransferDisabled)
+
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(s.addrs[0], sdk.NewInt64Coin(forceDenom, 100), s.addrs[1]))
+	require.NoError(err)
+	_, err = s.msgServer.Burn(goCtx, types.NewMsgBurn(s.addrs[0], sdk.NewInt64Coin(forceDenom, 40), s.addrs[1]))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(forceDenom, 60), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[1], forceDenom))
+
+	// the coins of module accounts cannot be burnt
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	require.NoError(s.app.BankKeeper.SendCoins(s.ctx, s.addrs[1], moduleAddr, sdk.NewCoins(sdk.NewInt64Coin(forceDenom, 10))))
+	_, err = s.msgServer.Burn(goCtx, types.NewMsgBurn(s.addrs[0], sdk.NewInt64Coin(forceDenom, 10), moduleAddr))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	require.Equal(sdk.NewInt64Coin(forceDenom, 10), s.app.BankKeeper.GetBalance(s.ctx, moduleAddr, forceDenom))
+}
+
+func (s *KeeperTestSuite) TestForceTransfer() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	lockedDenom := s.createDenom("litecoin")
+	lockedCoin := sdk.NewInt64Coin(lockedDenom, 100)
+
+	_, err := s.msgServer.Mint(goCtx, types.NewMsgMint(s.addrs[0], lockedCoin, s.addrs[1]))
+	require.NoError(err)
+
+	_, err = s.msgServer.ForceTransfer(goCtx, types.NewMsgForceTransfer(s.addrs[0], lockedCoin, s.addrs[1], s.addrs[2]))
+	require.ErrorIs(err, types.ErrForceTransferDisabled)
+
+	denom := s.createForceTransferDenom("bitcoin")
+	coin := sdk.NewInt64Coin(denom, 100)
+
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(s.addrs[0], coin, s.addrs[1]))
+	require.NoError(err)
+
+	_, err = s.msgServer.ForceTransfer(goCtx, types.NewMsgForceTransfer(s.addrs[1], coin, s.addrs[1], s.addrs[2]))
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	// denoms not created by the module cannot be transferred
+	_, err = s.msgServer.ForceTransfer(goCtx, types.NewMsgForceTransfer(s.addrs[0], sdk.NewInt64Coin("stake", 100), s.addrs[1], s.addrs[2]))
+	require.ErrorIs(err, types.ErrDenomDoesNotExist)
+
+	_, err = s.msgServer.ForceTransfer(goCtx, types.NewMsgForceTransfer(s.addrs[0], coin, s.addrs[1], s.addrs[2]))
+	require.NoError(err)
+	require.True(s.app.BankKeeper.GetBalance(s.ctx, s.addrs[1], denom).IsZero())
+	require.Equal(coin, s.app.BankKeeper.GetBalance(s.ctx, s.addrs[2], denom))
+
+	// the coins of module accounts and blocked addresses cannot be transferred
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	blockedAddr := authtypes.NewModuleAddress(distrtypes.ModuleName)
+	for _, from := range []sdk.AccAddress{moduleAddr, blockedAddr} {
+		require.NoError(s.app.BankKeeper.SendCoins(s.ctx, s.addrs[2], from, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+		_, err = s.msgServer.ForceTransfer(goCtx, types.NewMsgForceTransfer(s.addrs[0], sdk.NewInt64Coin(denom, 10), from, s.addrs[2]))
+		require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	}
+}
+
+func (s *KeeperTestSuite) TestChangeAdmin() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	denom := s.createDenom("bitcoin")
+	coin := sdk.NewInt64Coin(denom, 100)
+
+	_, err := s.msgServer.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(s.addrs[1], denom, s.addrs[1]))
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(s.addrs[0], denom, s.addrs[1]))
+	require.NoError(err)
+
+	res, err := s.queryClient.DenomAuthorityMetadata(goCtx, &types.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	require.NoError(err)
+	require.Equal(s.addrs[1].String(), res.AuthorityMetadata.Admin)
+
+	// the previous admin lost its rights
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(s.addrs[0], coin, nil))
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(s.addrs[1], coin, nil))
+	require.NoError(err)
+
+	// without an admin, the denom can no longer be managed
+	_, err = s.msgServer.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(s.addrs[1], denom, nil))
+	require.NoError(err)
+
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(s.addrs[1], coin, nil))
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	// the creator still lists the denom
+	require.Equal([]string{denom}, s.app.TokenFactoryKeeper.GetDenomsFromCreator(s.ctx, s.addrs[0]))
+}
+
+func (s *KeeperTestSuite) TestSetDenomMetadata() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	denom := s.createDenom("bitcoin")
+
+	metadata := banktypes.Metadata{
+		Description: "a factory bitcoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "fbtc", Exponent: 8},
+		},
+		Base:    denom,
+		Display: "fbtc",
+		Name:    "Factory Bitcoin",
+		Symbol:  "FBTC",
+	}
+
+	_, err := s.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(s.addrs[1], metadata))
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(s.addrs[0], metadata))
+	require.NoError(err)
+
+	stored, found := s.app.BankKeeper.GetDenomMetaData(s.ctx, denom)
+	require.True(found)
+	require.Equal(metadata, stored)
+
+	metadata.Display = "unknown"
+	_, err = s.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(s.addrs[0], metadata))
+	require.ErrorIs(err, types.ErrInvalidDenom)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	require := s.Require()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	params := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)), true)
+
+	_, err := s.msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(s.addrs[0].String(), params))
+	require.ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(s.authority.String(), params))
+	require.NoError(err)
+
+	res, err := s.queryClient.Params(goCtx, &types.QueryParamsRequest{})
+	require.NoError(err)
+	require.Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	require := s.Require()
+	denom := s.createDenom("bitcoin")
+	s.createDenom("litecoin")
+
+	_, err := s.msgServer.ChangeAdmin(sdk.WrapSDKContext(s.ctx), types.NewMsgChangeAdmin(s.addrs[0], denom, s.addrs[1]))
+	require.NoError(err)
+
+	genState := s.app.TokenFactoryKeeper.ExportGenesis(s.ctx)
+	require.NoError(types.ValidateGenesis(*genState))
+	require.Len(genState.FactoryDenoms, 2)
+
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	app.TokenFactoryKeeper.InitGenesis(ctx, genState)
+
+	require.Equal(genState, app.TokenFactoryKeeper.ExportGenesis(ctx))
+	require.Equal(s.app.TokenFactoryKeeper.GetDenomsFromCreator(s.ctx, s.addrs[0]), app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, s.addrs[0]))
+}
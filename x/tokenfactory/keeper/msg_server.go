@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the tokenfactory MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{Keeper: k}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// CreateDenom implements the Msg/CreateDenom Msg service.
+func (k msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	denom, err := k.Keeper.CreateDenom(ctx, sender, msg.Subdenom, msg.EnableForceTransfer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateDenom,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyNewTokenDenom, denom),
+		),
+	)
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+// Mint implements the Msg/Mint Msg service.
+func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ValidateAdmin(ctx, msg.Amount.Denom, sender); err != nil {
+		return nil, err
+	}
+
+	mintTo := sender
+	if msg.MintToAddress != "" {
+		mintTo, err = sdk.AccAddressFromBech32(msg.MintToAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.MintTo(ctx, msg.Amount, mintTo); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeKeyMintToAddress, mintTo.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgMintResponse{}, nil
+}
+
+// Burn implements the Msg/Burn Msg service.
+func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ValidateAdmin(ctx, msg.Amount.Denom, sender); err != nil {
+		return nil, err
+	}
+
+	burnFrom := sender
+	if msg.BurnFromAddress != "" && msg.BurnFromAddress != msg.Sender {
+		if err := k.ValidateForceTransfer(ctx, msg.Amount.Denom); err != nil {
+			return nil, sdkerrors.Wrap(err, "cannot burn from another account")
+		}
+
+		burnFrom, err = sdk.AccAddressFromBech32(msg.BurnFromAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.BurnFrom(ctx, msg.Amount, burnFrom); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			sdk.NewAttribute(types.AttributeKeyBurnFromAddress, burnFrom.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// ForceTransfer implements the Msg/ForceTransfer Msg service.
+func (k msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ValidateAdmin(ctx, msg.Amount.Denom, sender); err != nil {
+		return nil, err
+	}
+
+	if err := k.ValidateForceTransfer(ctx, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.TransferFromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.TransferToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.TransferFrom(ctx, msg.Amount, from, to); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForceTransfer,
+			sdk.NewAttribute(types.AttributeKeyTransferFrom, msg.TransferFromAddress),
+			sdk.NewAttribute(types.AttributeKeyTransferTo, msg.TransferToAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgForceTransferResponse{}, nil
+}
+
+// ChangeAdmin implements the Msg/ChangeAdmin Msg service.
+func (k msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ValidateAdmin(ctx, msg.Denom, sender); err != nil {
+		return nil, err
+	}
+
+	var newAdmin sdk.AccAddress
+	if msg.NewAdmin != "" {
+		newAdmin, err = sdk.AccAddressFromBech32(msg.NewAdmin)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.SetAdmin(ctx, msg.Denom, newAdmin); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNewAdmin, msg.NewAdmin),
+		),
+	)
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+// SetDenomMetadata implements the Msg/SetDenomMetadata Msg service.
+func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ValidateAdmin(ctx, msg.Metadata.Base, sender); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetBankMetadata(ctx, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Metadata.Base),
+		),
+	)
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+// UpdateParams implements the Msg/UpdateParams Msg service.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
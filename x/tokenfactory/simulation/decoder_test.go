@@ -0,0 +1,63 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	dec := simulation.NewDecodeStore(cdc)
+
+	creator := sdk.AccAddress("creator_____________")
+	denom := "factory/" + creator.String() + "/bitcoin"
+
+	params := types.DefaultParams()
+	paramsBz, err := cdc.Marshal(&params)
+	require.NoError(t, err)
+
+	metadata := types.DenomAuthorityMetadata{Admin: creator.String()}
+	metadataBz, err := cdc.Marshal(&metadata)
+	require.NoError(t, err)
+
+	creatorValue := []byte{0x01}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamsKey, Value: paramsBz},
+			{Key: types.DenomAuthorityMetadataKey(denom), Value: metadataBz},
+			{Key: types.CreatorDenomKey(creator, denom), Value: creatorValue},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectErr   bool
+		expectedLog string
+	}{
+		{"Params", false, fmt.Sprintf("%v\n%v", params, params)},
+		{"DenomAuthorityMetadata", false, fmt.Sprintf("%v\n%v", metadata, metadata)},
+		{"Creator", false, fmt.Sprintf("%v\n%v", creatorValue, creatorValue)},
+		{"other", true, ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectErr {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
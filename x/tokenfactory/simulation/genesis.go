@@ -0,0 +1,38 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// Simulation parameter constants
+const (
+	DenomCreationFee    = "denom_creation_fee"
+	EnableForceTransfer = "enable_force_transfer"
+)
+
+// genDenomCreationFee returns a random denom creation fee, possibly zero.
+func genDenomCreationFee(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, r.Int63n(1000)))
+}
+
+// RandomizedGenState generates a random GenesisState for tokenfactory.
+func RandomizedGenState(simState *module.SimulationState) {
+	var fee sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DenomCreationFee, &fee, simState.Rand,
+		func(r *rand.Rand) { fee = genDenomCreationFee(r) },
+	)
+
+	var enableForceTransfer bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EnableForceTransfer, &enableForceTransfer, simState.Rand,
+		func(r *rand.Rand) { enableForceTransfer = r.Intn(2) == 0 },
+	)
+
+	tokenfactoryGenesis := types.NewGenesisState(types.NewParams(fee, enableForceTransfer), []types.GenesisDenom{})
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(tokenfactoryGenesis)
+}
@@ -0,0 +1,318 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+const (
+	// OpWeightMsgCreateDenom Simulation operation weights constants
+	OpWeightMsgCreateDenom   = "op_weight_msg_create_denom"   //nolint:gosec
+	OpWeightMsgMint          = "op_weight_msg_tf_mint"        //nolint:gosec
+	OpWeightMsgBurn          = "op_weight_msg_tf_burn"        //nolint:gosec
+	OpWeightMsgForceTransfer = "op_weight_msg_force_transfer" //nolint:gosec
+	OpWeightMsgChangeAdmin   = "op_weight_msg_change_admin"   //nolint:gosec
+)
+
+const (
+	// WeightCreateDenom tokenfactory operations weights
+	WeightCreateDenom   = 20
+	WeightMint          = 50
+	WeightBurn          = 20
+	WeightForceTransfer = 10
+	WeightChangeAdmin   = 10
+)
+
+var (
+	TypeMsgCreateDenom   = sdk.MsgTypeURL(&types.MsgCreateDenom{})
+	TypeMsgMint          = sdk.MsgTypeURL(&types.MsgMint{})
+	TypeMsgBurn          = sdk.MsgTypeURL(&types.MsgBurn{})
+	TypeMsgForceTransfer = sdk.MsgTypeURL(&types.MsgForceTransfer{})
+	TypeMsgChangeAdmin   = sdk.MsgTypeURL(&types.MsgChangeAdmin{})
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	registry cdctypes.InterfaceRegistry,
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateDenom   int
+		weightMsgMint          int
+		weightMsgBurn          int
+		weightMsgForceTransfer int
+		weightMsgChangeAdmin   int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateDenom, &weightMsgCreateDenom, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateDenom = WeightCreateDenom
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMint, &weightMsgMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgMint = WeightMint
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurn = WeightBurn
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgForceTransfer, &weightMsgForceTransfer, nil,
+		func(_ *rand.Rand) {
+			weightMsgForceTransfer = WeightForceTransfer
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgChangeAdmin, &weightMsgChangeAdmin, nil,
+		func(_ *rand.Rand) {
+			weightMsgChangeAdmin = WeightChangeAdmin
+		},
+	)
+
+	protoCdc := codec.NewProtoCodec(registry)
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateDenom,
+			SimulateMsgCreateDenom(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMint,
+			SimulateMsgMint(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurn,
+			SimulateMsgBurn(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgForceTransfer,
+			SimulateMsgForceTransfer(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgChangeAdmin,
+			SimulateMsgChangeAdmin(protoCdc, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateDenom generates a MsgCreateDenom with random values.
+func SimulateMsgCreateDenom(
+	cdc *codec.ProtoCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		creator, _ := simtypes.RandomAcc(r, accs)
+
+		params := k.GetParams(ctx)
+		fee := params.DenomCreationFee
+		if !bk.SpendableCoins(ctx, creator.Address).IsAllGTE(fee) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreateDenom, "insufficient funds to pay the denom creation fee"), nil, nil
+		}
+
+		enableForceTransfer := params.EnableForceTransfer && r.Intn(2) == 0
+		msg := types.NewMsgCreateDenom(creator.Address, simtypes.RandStringOfLength(r, 10), enableForceTransfer)
+		denom, err := types.GetTokenDenom(msg.Sender, msg.Subdenom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreateDenom, err.Error()), nil, err
+		}
+		if _, found := k.GetAuthorityMetadata(ctx, denom); found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreateDenom, "denom already exists"), nil, nil
+		}
+
+		return deliverTx(r, app, ctx, cdc, ak, bk, creator, msg, TypeMsgCreateDenom, fee)
+	}
+}
+
+// SimulateMsgMint generates a MsgMint with random values.
+func SimulateMsgMint(
+	cdc *codec.ProtoCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randAdminDenom(ctx, r, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgMint, "no denom administered by the accounts"), nil, nil
+		}
+
+		mintTo, _ := simtypes.RandomAcc(r, accs)
+		amount := sdk.NewInt64Coin(denom, int64(simtypes.RandIntBetween(r, 1, 1_000_000)))
+		msg := types.NewMsgMint(admin.Address, amount, mintTo.Address)
+
+		return deliverTx(r, app, ctx, cdc, ak, bk, admin, msg, TypeMsgMint, sdk.NewCoins())
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn with random values.
+func SimulateMsgBurn(
+	cdc *codec.ProtoCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randAdminDenom(ctx, r, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurn, "no denom administered by the accounts"), nil, nil
+		}
+
+		balance := bk.SpendableCoins(ctx, admin.Address).AmountOf(denom)
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurn, "admin has no balance to burn"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurn, err.Error()), nil, err
+		}
+
+		coin := sdk.NewCoin(denom, amount)
+		msg := types.NewMsgBurn(admin.Address, coin, nil)
+
+		return deliverTx(r, app, ctx, cdc, ak, bk, admin, msg, TypeMsgBurn, sdk.NewCoins(coin))
+	}
+}
+
+// SimulateMsgForceTransfer generates a MsgForceTransfer with random values.
+func SimulateMsgForceTransfer(
+	cdc *codec.ProtoCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randAdminDenom(ctx, r, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgForceTransfer, "no denom administered by the accounts"), nil, nil
+		}
+
+		if err := k.ValidateForceTransfer(ctx, denom); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgForceTransfer, "force transfers of the denom are disabled"), nil, nil
+		}
+
+		from, _ := simtypes.RandomAcc(r, accs)
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		balance := bk.SpendableCoins(ctx, from.Address).AmountOf(denom)
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgForceTransfer, "account has no balance to transfer"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgForceTransfer, err.Error()), nil, err
+		}
+
+		coin := sdk.NewCoin(denom, amount)
+		msg := types.NewMsgForceTransfer(admin.Address, coin, from.Address, to.Address)
+
+		// the fees must not be paid with the transferred coins
+		coinsSpent := sdk.NewCoins()
+		if from.Address.Equals(admin.Address) {
+			coinsSpent = sdk.NewCoins(coin)
+		}
+
+		return deliverTx(r, app, ctx, cdc, ak, bk, admin, msg, TypeMsgForceTransfer, coinsSpent)
+	}
+}
+
+// SimulateMsgChangeAdmin generates a MsgChangeAdmin with random values.
+func SimulateMsgChangeAdmin(
+	cdc *codec.ProtoCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randAdminDenom(ctx, r, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgChangeAdmin, "no denom administered by the accounts"), nil, nil
+		}
+
+		newAdmin, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgChangeAdmin(admin.Address, denom, newAdmin.Address)
+
+		return deliverTx(r, app, ctx, cdc, ak, bk, admin, msg, TypeMsgChangeAdmin, sdk.NewCoins())
+	}
+}
+
+// randAdminDenom returns a random denom administered by one of the accounts,
+// with its admin.
+func randAdminDenom(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, accs []simtypes.Account) (string, simtypes.Account, bool) {
+	var (
+		denoms []string
+		admins []simtypes.Account
+	)
+	k.IterateAuthorityMetadata(ctx, func(denom string, metadata types.DenomAuthorityMetadata) bool {
+		if metadata.Admin == "" {
+			return false
+		}
+
+		admin, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(metadata.Admin))
+		if found {
+			denoms = append(denoms, denom)
+			admins = append(admins, admin)
+		}
+		return false
+	})
+
+	if len(denoms) == 0 {
+		return "", simtypes.Account{}, false
+	}
+
+	i := r.Intn(len(denoms))
+	return denoms[i], admins[i], true
+}
+
+func deliverTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, cdc *codec.ProtoCodec,
+	ak types.AccountKeeper, bk types.BankKeeper, signer simtypes.Account, msg sdk.Msg, msgType string,
+	coinsSpent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             cdc,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      signer,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: coinsSpent,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ModuleDenomPrefix is the prefix of the denoms created by the module.
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+	// MaxHrpLength is the maximum length of the bech32 prefix of a creator.
+	MaxHrpLength = 16
+	// MaxCreatorLength is the maximum length of a creator address, 59 being
+	// the length of a 32 bytes address without its prefix.
+	MaxCreatorLength = 59 + MaxHrpLength
+)
+
+// GetTokenDenom returns the denom factory/{creator}/{subdenom}, or an error if
+// it is not a valid denom.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if subdenom == "" {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, "subdenom cannot be empty")
+	}
+	if len(subdenom) > MaxSubdenomLength {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+	if len(creator) > MaxCreatorLength {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "creator too long, max length is %d bytes", MaxCreatorLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "creator %s cannot contain /", creator)
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator and the subdenom of a denom created by
+// the module, or an error if the denom was not created by the module.
+func DeconstructDenom(denom string) (creator sdk.AccAddress, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != ModuleDenomPrefix {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "denom must be of the form %s/{creator}/{subdenom}: %s", ModuleDenomPrefix, denom)
+	}
+
+	creator, err = sdk.AccAddressFromBech32(parts[1])
+	if err != nil {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator address %s: %s", parts[1], err)
+	}
+
+	if _, err := GetTokenDenom(parts[1], parts[2]); err != nil {
+		return nil, "", err
+	}
+
+	return creator, parts[2], nil
+}
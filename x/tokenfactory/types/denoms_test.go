@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	testCases := []struct {
+		name      string
+		creator   string
+		subdenom  string
+		expDenom  string
+		expectErr bool
+	}{
+		{"valid", creator, "bitcoin", "factory/" + creator + "/bitcoin", false},
+		{"subdenom with slashes", creator, "bit/coin", "factory/" + creator + "/bit/coin", false},
+		{"subdenom with dots", creator, "bit.coin", "factory/" + creator + "/bit.coin", false},
+		{"empty subdenom", creator, "", "", true},
+		{"subdenom too long", creator, strings.Repeat("a", types.MaxSubdenomLength+1), "", true},
+		{"creator too long", strings.Repeat("a", types.MaxCreatorLength+1), "bitcoin", "", true},
+		{"creator with a slash", "cosmos/creator", "bitcoin", "", true},
+		{"invalid character", creator, "bit coin", "", true},
+	}
+
+	for _, tc := range testCases {
+		denom, err := types.GetTokenDenom(tc.creator, tc.subdenom)
+		if tc.expectErr {
+			require.ErrorIs(t, err, types.ErrInvalidDenom, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expDenom, denom, tc.name)
+		}
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________")
+
+	testCases := []struct {
+		name        string
+		denom       string
+		expSubdenom string
+		expectErr   bool
+	}{
+		{"valid", "factory/" + creator.String() + "/bitcoin", "bitcoin", false},
+		{"subdenom with slashes", "factory/" + creator.String() + "/bit/coin", "bit/coin", false},
+		{"empty subdenom", "factory/" + creator.String() + "/", "", true},
+		{"missing subdenom", "factory/" + creator.String(), "", true},
+		{"invalid prefix", "tokens/" + creator.String() + "/bitcoin", "", true},
+		{"invalid creator", "factory/creator/bitcoin", "", true},
+		{"not a factory denom", "stake", "", true},
+	}
+
+	for _, tc := range testCases {
+		addr, subdenom, err := types.DeconstructDenom(tc.denom)
+		if tc.expectErr {
+			require.ErrorIs(t, err, types.ErrInvalidDenom, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, creator, addr, tc.name)
+			require.Equal(t, tc.expSubdenom, subdenom, tc.name)
+		}
+	}
+}
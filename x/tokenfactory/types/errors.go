@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrInvalidDenom          = sdkerrors.Register(ModuleName, 2, "invalid denom")
+	ErrDenomExists           = sdkerrors.Register(ModuleName, 3, "denom already exists")
+	ErrDenomDoesNotExist     = sdkerrors.Register(ModuleName, 4, "denom does not exist")
+	ErrUnauthorized          = sdkerrors.Register(ModuleName, 5, "unauthorized account")
+	ErrForceTransferDisabled = sdkerrors.Register(ModuleName, 6, "force transfers are disabled")
+)
@@ -0,0 +1,20 @@
+package types
+
+// tokenfactory module event types
+const (
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeMint             = "tf_mint"
+	EventTypeBurn             = "tf_burn"
+	EventTypeForceTransfer    = "force_transfer"
+	EventTypeChangeAdmin      = "change_admin"
+	EventTypeSetDenomMetadata = "set_denom_metadata"
+
+	AttributeKeyCreator         = "creator"
+	AttributeKeyNewTokenDenom   = "new_token_denom"
+	AttributeKeyMintToAddress   = "mint_to_address"
+	AttributeKeyBurnFromAddress = "burn_from_address"
+	AttributeKeyTransferFrom    = "transfer_from_address"
+	AttributeKeyTransferTo      = "transfer_to_address"
+	AttributeKeyDenom           = "denom"
+	AttributeKeyNewAdmin        = "new_admin"
+)
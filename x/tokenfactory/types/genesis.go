@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, denoms []GenesisDenom) *GenesisState {
+	return &GenesisState{
+		Params:        params,
+		FactoryDenoms: denoms,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []GenesisDenom{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(data.FactoryDenoms))
+	for _, denom := range data.FactoryDenoms {
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+
+		if seen[denom.Denom] {
+			return fmt.Errorf("duplicate denom %s", denom.Denom)
+		}
+		seen[denom.Denom] = true
+
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return fmt.Errorf("invalid authority metadata of denom %s: %w", denom.Denom, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the denom authorities.
+func (m DenomAuthorityMetadata) Validate() error {
+	if m.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+			return fmt.Errorf("invalid admin address: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+	denom := "factory/" + creator + "/bitcoin"
+
+	testCases := []struct {
+		name      string
+		genState  *types.GenesisState
+		expectErr bool
+	}{
+		{"default", types.DefaultGenesisState(), false},
+		{
+			"valid denoms",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator}},
+				{Denom: "factory/" + creator + "/litecoin"},
+			}),
+			false,
+		},
+		{
+			"invalid fee",
+			types.NewGenesisState(types.NewParams(sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, false), nil),
+			true,
+		},
+		{
+			"invalid denom",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{{Denom: "stake"}}),
+			true,
+		},
+		{
+			"duplicate denom",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{{Denom: denom}, {Denom: denom}}),
+			true,
+		},
+		{
+			"invalid admin",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: "admin"}},
+			}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateGenesis(*tc.genState)
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
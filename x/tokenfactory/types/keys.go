@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the tokenfactory module
+	ModuleName = "tokenfactory"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the tokenfactory module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the tokenfactory module
+	QuerierRoute = ModuleName
+)
+
+// Keys for tokenfactory store
+// Items are stored with the following key: values
+//
+// - 0x01: Params
+//
+// - 0x02<denom_Bytes>: DenomAuthorityMetadata
+//
+// - 0x03<creatorAddressLen (1 Byte)><creatorAddress_Bytes><denom_Bytes>: []byte{0x01}
+var (
+	ParamsKey                    = []byte{0x01}
+	DenomAuthorityMetadataPrefix = []byte{0x02}
+	CreatorPrefix                = []byte{0x03}
+)
+
+// DenomAuthorityMetadataKey returns the store key of the authorities of a
+// denom.
+func DenomAuthorityMetadataKey(denom string) []byte {
+	return append(DenomAuthorityMetadataPrefix, denom...)
+}
+
+// CreatorPrefixKey returns the store prefix of the denoms created by an
+// account.
+func CreatorPrefixKey(creator sdk.AccAddress) []byte {
+	return append(CreatorPrefix, address.MustLengthPrefix(creator)...)
+}
+
+// CreatorDenomKey returns the store key of a denom created by an account.
+func CreatorDenomKey(creator sdk.AccAddress, denom string) []byte {
+	return append(CreatorPrefixKey(creator), denom...)
+}
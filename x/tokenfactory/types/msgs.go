@@ -0,0 +1,317 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// tokenfactory message types
+const (
+	TypeMsgCreateDenom      = "create_denom"
+	TypeMsgMint             = "tf_mint"
+	TypeMsgBurn             = "tf_burn"
+	TypeMsgForceTransfer    = "force_transfer"
+	TypeMsgChangeAdmin      = "change_admin"
+	TypeMsgSetDenomMetadata = "set_denom_metadata"
+	TypeMsgUpdateParams     = "update_params"
+)
+
+var (
+	_ sdk.Msg            = &MsgCreateDenom{}
+	_ sdk.Msg            = &MsgMint{}
+	_ sdk.Msg            = &MsgBurn{}
+	_ sdk.Msg            = &MsgForceTransfer{}
+	_ sdk.Msg            = &MsgChangeAdmin{}
+	_ sdk.Msg            = &MsgSetDenomMetadata{}
+	_ sdk.Msg            = &MsgUpdateParams{}
+	_ legacytx.LegacyMsg = &MsgCreateDenom{}
+	_ legacytx.LegacyMsg = &MsgMint{}
+	_ legacytx.LegacyMsg = &MsgBurn{}
+	_ legacytx.LegacyMsg = &MsgForceTransfer{}
+	_ legacytx.LegacyMsg = &MsgChangeAdmin{}
+	_ legacytx.LegacyMsg = &MsgSetDenomMetadata{}
+	_ legacytx.LegacyMsg = &MsgUpdateParams{}
+)
+
+// NewMsgCreateDenom creates a new MsgCreateDenom instance
+func NewMsgCreateDenom(sender sdk.AccAddress, subdenom string, enableForceTransfer bool) *MsgCreateDenom {
+	return &MsgCreateDenom{Sender: sender.String(), Subdenom: subdenom, EnableForceTransfer: enableForceTransfer}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgCreateDenom) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgCreateDenom) Type() string { return TypeMsgCreateDenom }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgCreateDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	_, err := GetTokenDenom(msg.Sender, msg.Subdenom)
+	return err
+}
+
+// NewMsgMint creates a new MsgMint instance, minting to the sender if
+// mintTo is empty.
+func NewMsgMint(sender sdk.AccAddress, amount sdk.Coin, mintTo sdk.AccAddress) *MsgMint {
+	msg := &MsgMint{Sender: sender.String(), Amount: amount}
+	if !mintTo.Empty() {
+		msg.MintToAddress = mintTo.String()
+	}
+	return msg
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgMint) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgMint) Type() string { return TypeMsgMint }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgMint) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if msg.MintToAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.MintToAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid mint to address: %s", err)
+		}
+	}
+
+	return validateFactoryCoin(msg.Amount)
+}
+
+// NewMsgBurn creates a new MsgBurn instance, burning from the sender if
+// burnFrom is empty.
+func NewMsgBurn(sender sdk.AccAddress, amount sdk.Coin, burnFrom sdk.AccAddress) *MsgBurn {
+	msg := &MsgBurn{Sender: sender.String(), Amount: amount}
+	if !burnFrom.Empty() {
+		msg.BurnFromAddress = burnFrom.String()
+	}
+	return msg
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgBurn) Type() string { return TypeMsgBurn }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if msg.BurnFromAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.BurnFromAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid burn from address: %s", err)
+		}
+	}
+
+	return validateFactoryCoin(msg.Amount)
+}
+
+// NewMsgForceTransfer creates a new MsgForceTransfer instance
+func NewMsgForceTransfer(sender sdk.AccAddress, amount sdk.Coin, from, to sdk.AccAddress) *MsgForceTransfer {
+	return &MsgForceTransfer{
+		Sender:              sender.String(),
+		Amount:              amount,
+		TransferFromAddress: from.String(),
+		TransferToAddress:   to.String(),
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgForceTransfer) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgForceTransfer) Type() string { return TypeMsgForceTransfer }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgForceTransfer) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgForceTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgForceTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.TransferFromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid transfer from address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.TransferToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid transfer to address: %s", err)
+	}
+
+	return validateFactoryCoin(msg.Amount)
+}
+
+// NewMsgChangeAdmin creates a new MsgChangeAdmin instance, removing the admin
+// if newAdmin is empty.
+func NewMsgChangeAdmin(sender sdk.AccAddress, denom string, newAdmin sdk.AccAddress) *MsgChangeAdmin {
+	msg := &MsgChangeAdmin{Sender: sender.String(), Denom: denom}
+	if !newAdmin.Empty() {
+		msg.NewAdmin = newAdmin.String()
+	}
+	return msg
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgChangeAdmin) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgChangeAdmin) Type() string { return TypeMsgChangeAdmin }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgChangeAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgChangeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgChangeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if msg.NewAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+		}
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	return err
+}
+
+// NewMsgSetDenomMetadata creates a new MsgSetDenomMetadata instance
+func NewMsgSetDenomMetadata(sender sdk.AccAddress, metadata banktypes.Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{Sender: sender.String(), Metadata: metadata}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgSetDenomMetadata) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgSetDenomMetadata) Type() string { return TypeMsgSetDenomMetadata }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetDenomMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	_, _, err := DeconstructDenom(msg.Metadata.Base)
+	return err
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{Authority: authority, Params: params}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// validateFactoryCoin returns an error if coin is not a positive amount of a
+// denom created by the module.
+func validateFactoryCoin(coin sdk.Coin) error {
+	if !coin.IsValid() || coin.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, coin.String())
+	}
+
+	_, _, err := DeconstructDenom(coin.Denom)
+	return err
+}
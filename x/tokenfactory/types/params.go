@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultDenomCreationFee is the default fee to create a denom.
+var DefaultDenomCreationFee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000))
+
+// NewParams creates a new Params instance.
+func NewParams(denomCreationFee sdk.Coins, enableForceTransfer bool) Params {
+	return Params{
+		DenomCreationFee:    denomCreationFee,
+		EnableForceTransfer: enableForceTransfer,
+	}
+}
+
+// DefaultParams returns the default tokenfactory parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultDenomCreationFee, false)
+}
+
+// Validate performs a stateless validation of the params.
+func (p Params) Validate() error {
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+
+	return nil
+}